@@ -0,0 +1,44 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/kedr891/cs-parser/config"
+	"github.com/kedr891/cs-parser/internal/bootstrap"
+)
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprintln(os.Stderr, "usage: admin <command> [flags]")
+		fmt.Fprintln(os.Stderr, "commands:")
+		fmt.Fprintln(os.Stderr, "  redrive-dlq           вернуть сообщения из DLQ цен или уведомлений в исходный топик")
+		fmt.Fprintln(os.Stderr, "  export                выгрузить каталог и историю цен в CSV, NDJSON или Parquet")
+		fmt.Fprintln(os.Stderr, "  rebuild-autocomplete  перестроить индекс подсказок поиска в Redis")
+		os.Exit(2)
+	}
+
+	cfg, err := config.LoadConfig(os.Getenv("configPath"))
+	if err != nil {
+		panic(fmt.Sprintf("ошибка парсинга конфига, %v", err))
+	}
+
+	logger := bootstrap.InitLogger(cfg)
+
+	switch os.Args[1] {
+	case "redrive-dlq":
+		err = bootstrap.RunRedriveDLQ(cfg, os.Args[2:], logger)
+	case "export":
+		err = bootstrap.RunExport(cfg, os.Args[2:], logger)
+	case "rebuild-autocomplete":
+		err = bootstrap.RunRebuildAutocomplete(cfg, os.Args[2:], logger)
+	default:
+		fmt.Fprintf(os.Stderr, "unknown command %q\n", os.Args[1])
+		os.Exit(2)
+	}
+
+	if err != nil {
+		logger.Error("Command failed", "command", os.Args[1], "error", err)
+		os.Exit(1)
+	}
+}
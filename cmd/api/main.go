@@ -20,12 +20,48 @@ func main() {
 	cache, closeCache := bootstrap.InitCache(cfg)
 
 	skinService := bootstrap.InitSkinService(storage, cache, logger)
-	analyticsService := bootstrap.InitAnalyticsService(storage, logger)
+	priceAlertProducer := bootstrap.InitPriceAlertProducer(cfg)
+	defer priceAlertProducer.Close()
 
-	priceUpdateProcessor := bootstrap.InitPriceUpdateProcessor(analyticsService)
-	priceUpdateConsumer := bootstrap.InitPriceUpdateConsumer(cfg, priceUpdateProcessor)
+	analyticsService := bootstrap.InitAnalyticsService(storage, cache, priceAlertProducer, logger)
+	priceStatsJob := bootstrap.InitPriceStatsJob(cfg, analyticsService)
 
-	skinsAPI := bootstrap.InitSkinsServiceAPI(skinService, analyticsService)
+	priceStreamHub, priceStreamBroker := bootstrap.InitPriceStream(cfg, cache, logger)
 
-	bootstrap.AppRun(*skinsAPI, priceUpdateConsumer, storage, closeCache, logger)
+	priceUpdateProcessor := bootstrap.InitPriceUpdateProcessor(analyticsService, priceStreamBroker)
+	deadLetterProducer := bootstrap.InitDeadLetterProducer(cfg)
+	defer deadLetterProducer.Close()
+	priceUpdateConsumer := bootstrap.InitPriceUpdateConsumer(cfg, priceUpdateProcessor, deadLetterProducer)
+
+	skinDiscoveredProcessor := bootstrap.InitSkinDiscoveredProcessor(skinService)
+	skinDiscoveredConsumer := bootstrap.InitSkinDiscoveredConsumer(cfg, skinDiscoveredProcessor)
+
+	notifierService := bootstrap.InitNotifierService(cfg, storage, logger)
+	priceAlertProcessor := bootstrap.InitPriceAlertProcessor(notifierService, priceStreamBroker)
+	priceAlertDeadLetterProducer := bootstrap.InitPriceAlertDeadLetterProducer(cfg)
+	defer priceAlertDeadLetterProducer.Close()
+	priceAlertConsumer := bootstrap.InitPriceAlertConsumer(cfg, priceAlertProcessor, priceAlertDeadLetterProducer)
+
+	exportService := bootstrap.InitExportService(storage, logger)
+
+	skinsAPI := bootstrap.InitSkinsServiceAPI(skinService, analyticsService, notifierService, priceStreamHub, exportService)
+	feedAPI := bootstrap.InitMarketFeedAPI(cfg, priceStreamHub, logger)
+	exportAPI := bootstrap.InitExportAPI(exportService, logger)
+	trendingWatcher := bootstrap.InitTrendingWatcher(cfg, analyticsService, priceStreamHub, logger)
+
+	bootstrap.AppRun(
+		*skinsAPI,
+		feedAPI,
+		exportAPI,
+		priceUpdateConsumer,
+		skinDiscoveredConsumer,
+		priceAlertConsumer,
+		notifierService,
+		priceStatsJob,
+		priceStreamBroker,
+		trendingWatcher,
+		storage,
+		closeCache,
+		logger,
+	)
 }
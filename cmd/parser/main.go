@@ -0,0 +1,35 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/kedr891/cs-parser/config"
+	"github.com/kedr891/cs-parser/internal/bootstrap"
+)
+
+func main() {
+	cfg, err := config.LoadConfig(os.Getenv("configPath"))
+	if err != nil {
+		panic(fmt.Sprintf("ошибка парсинга конфига, %v", err))
+	}
+
+	logger := bootstrap.InitLogger(cfg)
+
+	storage := bootstrap.InitPGStorage(cfg)
+
+	priceProviders := bootstrap.InitPriceProviders(cfg, logger)
+	priceUpdateProducer := bootstrap.InitPriceUpdateProducer(cfg)
+	skinDiscoveredProducer := bootstrap.InitSkinDiscoveredProducer(cfg)
+
+	parserService := bootstrap.InitParserService(
+		cfg,
+		storage,
+		priceProviders,
+		priceUpdateProducer,
+		skinDiscoveredProducer,
+		logger,
+	)
+
+	bootstrap.ParserRun(parserService, priceUpdateProducer, skinDiscoveredProducer, storage, logger)
+}
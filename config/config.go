@@ -4,17 +4,22 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	"gopkg.in/yaml.v3"
 )
 
 type Config struct {
-	Database DatabaseConfig `yaml:"database"`
-	Shard    ShardConfig    `yaml:"shard"`
-	Redis    RedisConfig    `yaml:"redis"`
-	Kafka    KafkaConfig    `yaml:"kafka"`
-	GRPC     GRPCConfig     `yaml:"grpc"`
-	Gateway  GatewayConfig  `yaml:"gateway"`
+	Database    DatabaseConfig    `yaml:"database"`
+	Shard       ShardConfig       `yaml:"shard"`
+	Redis       RedisConfig       `yaml:"redis"`
+	Kafka       KafkaConfig       `yaml:"kafka"`
+	GRPC        GRPCConfig        `yaml:"grpc"`
+	Gateway     GatewayConfig     `yaml:"gateway"`
+	Parser      ParserConfig      `yaml:"parser"`
+	Analytics   AnalyticsConfig   `yaml:"analytics"`
+	Notifier    NotifierConfig    `yaml:"notifier"`
+	PriceStream PriceStreamConfig `yaml:"priceStream"`
 }
 
 type DatabaseConfig struct {
@@ -39,12 +44,31 @@ type RedisConfig struct {
 }
 
 type KafkaConfig struct {
-	Host                string `yaml:"host"`
-	Port                int    `yaml:"port"`
-	TopicPriceUpdated   string `yaml:"topicPriceUpdated"`
-	TopicSkinDiscovered string `yaml:"topicSkinDiscovered"`
-	TopicPriceAlert     string `yaml:"topicPriceAlert"`
-	GroupPriceConsumer  string `yaml:"groupPriceConsumer"`
+	Host                        string              `yaml:"host"`
+	Port                        int                 `yaml:"port"`
+	TopicPriceUpdated           string              `yaml:"topicPriceUpdated"`
+	TopicSkinDiscovered         string              `yaml:"topicSkinDiscovered"`
+	TopicPriceAlert             string              `yaml:"topicPriceAlert"`
+	TopicPriceUpdatedDLQ        string              `yaml:"topicPriceUpdatedDLQ"`
+	TopicPriceAlertDLQ          string              `yaml:"topicPriceAlertDLQ"`
+	GroupPriceConsumer          string              `yaml:"groupPriceConsumer"`
+	GroupSkinDiscoveredConsumer string              `yaml:"groupSkinDiscoveredConsumer"`
+	GroupDLQRedrive             string              `yaml:"groupDLQRedrive"`
+	GroupPriceAlertConsumer     string              `yaml:"groupPriceAlertConsumer"`
+	Retry                       RetryConfig         `yaml:"retry"`
+	PriceConsumer               PriceConsumerConfig `yaml:"priceConsumer"`
+}
+
+type PriceConsumerConfig struct {
+	Workers             int `yaml:"workers"`
+	QueueDepth          int `yaml:"queueDepth"`
+	DrainTimeoutSeconds int `yaml:"drainTimeoutSeconds"`
+}
+
+type RetryConfig struct {
+	MaxAttempts      int `yaml:"maxAttempts"`
+	InitialBackoffMs int `yaml:"initialBackoffMs"`
+	MaxBackoffMs     int `yaml:"maxBackoffMs"`
 }
 
 type GRPCConfig struct {
@@ -56,6 +80,45 @@ type GatewayConfig struct {
 	SwaggerPath string `yaml:"swaggerPath"`
 }
 
+type ParserConfig struct {
+	IntervalMinutes int                       `yaml:"intervalMinutes"`
+	CurrencyRates   map[string]float64        `yaml:"currencyRates"`
+	Providers       map[string]ProviderConfig `yaml:"providers"`
+	Discovery       DiscoveryConfig           `yaml:"discovery"`
+}
+
+type DiscoveryConfig struct {
+	Enabled bool `yaml:"enabled"`
+	Pages   int  `yaml:"pages"`
+}
+
+type ProviderConfig struct {
+	Enabled            bool   `yaml:"enabled"`
+	BaseURL            string `yaml:"baseURL"`
+	RateLimitPerMinute int    `yaml:"rateLimitPerMinute"`
+	Currency           string `yaml:"currency"`
+}
+
+type AnalyticsConfig struct {
+	StatsIntervalMinutes int `yaml:"statsIntervalMinutes"`
+}
+
+type NotifierConfig struct {
+	TimeoutSeconds      int         `yaml:"timeoutSeconds"`
+	QueueDepth          int         `yaml:"queueDepth"`
+	PollIntervalSeconds int         `yaml:"pollIntervalSeconds"`
+	WorkerIdleSeconds   int         `yaml:"workerIdleSeconds"`
+	Retry               RetryConfig `yaml:"retry"`
+}
+
+type PriceStreamConfig struct {
+	Channel                 string `yaml:"channel"`
+	HistorySize             int    `yaml:"historySize"`
+	SubscriberBuffer        int    `yaml:"subscriberBuffer"`
+	HeartbeatSeconds        int    `yaml:"heartbeatSeconds"`
+	TrendingIntervalSeconds int    `yaml:"trendingIntervalSeconds"`
+}
+
 func LoadConfig(filename string) (*Config, error) {
 	if strings.TrimSpace(filename) == "" {
 		return nil, fmt.Errorf("config filename is required")
@@ -88,3 +151,31 @@ func (c *Config) DatabaseURL() string {
 		c.Database.SSLMode,
 	)
 }
+
+func (c *Config) ParserInterval() time.Duration {
+	if c.Parser.IntervalMinutes <= 0 {
+		return 15 * time.Minute
+	}
+	return time.Duration(c.Parser.IntervalMinutes) * time.Minute
+}
+
+func (c *Config) StatsRecomputeInterval() time.Duration {
+	if c.Analytics.StatsIntervalMinutes <= 0 {
+		return 10 * time.Minute
+	}
+	return time.Duration(c.Analytics.StatsIntervalMinutes) * time.Minute
+}
+
+func (c *Config) FeedHeartbeatInterval() time.Duration {
+	if c.PriceStream.HeartbeatSeconds <= 0 {
+		return 15 * time.Second
+	}
+	return time.Duration(c.PriceStream.HeartbeatSeconds) * time.Second
+}
+
+func (c *Config) TrendingCheckInterval() time.Duration {
+	if c.PriceStream.TrendingIntervalSeconds <= 0 {
+		return time.Minute
+	}
+	return time.Duration(c.PriceStream.TrendingIntervalSeconds) * time.Second
+}
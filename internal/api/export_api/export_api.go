@@ -0,0 +1,66 @@
+package export_api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/kedr891/cs-parser/internal/models"
+)
+
+type exportService interface {
+	Export(ctx context.Context, filter *models.ExportFilter, w io.Writer) (int, error)
+}
+
+// ExportAPI отдает выгрузки файлом по HTTP. Маршрут регистрируется на роутере gateway напрямую:
+// grpc-gateway разделяет сообщения потока переводом строки, что портит бинарный Parquet.
+type ExportAPI struct {
+	exportService exportService
+	log           *slog.Logger
+}
+
+func NewExportAPI(exportService exportService, log *slog.Logger) *ExportAPI {
+	return &ExportAPI{
+		exportService: exportService,
+		log:           log,
+	}
+}
+
+func (a *ExportAPI) Routes(r chi.Router) {
+	r.Get("/api/v1/export/{dataset}", a.ServeExport)
+}
+
+// ServeExport - GET /api/v1/export/{dataset}?format=&weapon=&source=&from=&to=
+func (a *ExportAPI) ServeExport(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	filter, err := models.NewExportFilter(
+		chi.URLParam(r, "dataset"),
+		query.Get("format"),
+		query.Get("weapon"),
+		query.Get("source"),
+		query.Get("from"),
+		query.Get("to"),
+	)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	filename := fmt.Sprintf("%s_%s.%s", filter.Dataset, time.Now().UTC().Format("20060102T150405Z"), filter.Format.Extension())
+	w.Header().Set("Content-Type", filter.Format.ContentType())
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	w.WriteHeader(http.StatusOK)
+
+	if _, err := a.exportService.Export(r.Context(), filter, w); err != nil {
+		if r.Context().Err() == nil && !errors.Is(err, models.ErrInvalidExport) {
+			a.log.Error("Export failed", "dataset", filter.Dataset, "format", filter.Format, "error", err)
+		}
+		// статус уже отправлен: обрываем соединение, чтобы клиент не принял обрезанный файл за целый
+		panic(http.ErrAbortHandler)
+	}
+}
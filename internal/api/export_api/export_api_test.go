@@ -0,0 +1,74 @@
+package export_api
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/kedr891/cs-parser/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type exportStub struct {
+	filter *models.ExportFilter
+	body   string
+	err    error
+}
+
+func (s *exportStub) Export(ctx context.Context, filter *models.ExportFilter, w io.Writer) (int, error) {
+	s.filter = filter
+	if _, err := io.WriteString(w, s.body); err != nil {
+		return 0, err
+	}
+	return 1, s.err
+}
+
+func newRouter(stub *exportStub) chi.Router {
+	r := chi.NewRouter()
+	NewExportAPI(stub, slog.New(slog.DiscardHandler)).Routes(r)
+	return r
+}
+
+func TestServeExport(t *testing.T) {
+	stub := &exportStub{body: "id,slug\n"}
+	rec := httptest.NewRecorder()
+
+	newRouter(stub).ServeHTTP(rec, httptest.NewRequest(http.MethodGet,
+		"/api/v1/export/price_history?format=ndjson&weapon=AWP&source=steam&from=2024-01-01T00:00:00Z", nil))
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "application/x-ndjson", rec.Header().Get("Content-Type"))
+	assert.Contains(t, rec.Header().Get("Content-Disposition"), `attachment; filename="price_history_`)
+	assert.Contains(t, rec.Header().Get("Content-Disposition"), `.ndjson"`)
+	assert.Equal(t, "id,slug\n", rec.Body.String())
+
+	require.NotNil(t, stub.filter)
+	assert.Equal(t, models.ExportPriceHistory, stub.filter.Dataset)
+	assert.Equal(t, "AWP", stub.filter.Weapon)
+	assert.Equal(t, "steam", stub.filter.Source)
+}
+
+func TestServeExport_InvalidFilter(t *testing.T) {
+	stub := &exportStub{}
+	rec := httptest.NewRecorder()
+
+	newRouter(stub).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/v1/export/orders?format=xml", nil))
+
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Nil(t, stub.filter)
+}
+
+func TestServeExport_AbortsOnStreamError(t *testing.T) {
+	stub := &exportStub{body: "partial", err: errors.New("shard down")}
+	rec := httptest.NewRecorder()
+
+	assert.PanicsWithValue(t, http.ErrAbortHandler, func() {
+		newRouter(stub).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/v1/export/skins", nil))
+	})
+}
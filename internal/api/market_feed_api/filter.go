@@ -0,0 +1,69 @@
+package market_feed_api
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/kedr891/cs-parser/internal/models"
+)
+
+// parseFeedRequest читает подписку из query string:
+//
+//	types=price,alert,trending  - типы событий, по умолчанию все
+//	slugs=...&weapons=...       - фильтр цен и правил, списки через запятую или повтором параметра
+//	all=true                    - все скины; без slugs и weapons подразумевается
+//	last_event_id=...           - продолжить после события (то же, что заголовок Last-Event-ID)
+func parseFeedRequest(r *http.Request) (*models.FeedFilter, uint64, error) {
+	query := r.URL.Query()
+
+	types := splitList(query["types"])
+	if len(types) == 0 {
+		types = []string{models.FeedEventPrice, models.FeedEventAlert, models.FeedEventTrending}
+	}
+	slugs := splitList(query["slugs"])
+	weapons := splitList(query["weapons"])
+
+	all := len(slugs) == 0 && len(weapons) == 0
+	if value := query.Get("all"); value != "" {
+		parsed, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, 0, fmt.Errorf("%w: all must be a boolean", models.ErrInvalidFeedFilter)
+		}
+		all = parsed
+	}
+
+	filter, err := models.NewFeedFilter(types, all, slugs, weapons)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// браузер сам отправляет Last-Event-ID при переподключении EventSource
+	lastEventID := r.Header.Get("Last-Event-ID")
+	if lastEventID == "" {
+		lastEventID = query.Get("last_event_id")
+	}
+
+	var after uint64
+	if lastEventID != "" {
+		after, err = models.ParseFeedEventID(lastEventID)
+		if err != nil {
+			return nil, 0, err
+		}
+	}
+
+	return filter, after, nil
+}
+
+func splitList(values []string) []string {
+	var result []string
+	for _, value := range values {
+		for _, item := range strings.Split(value, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				result = append(result, item)
+			}
+		}
+	}
+	return result
+}
@@ -0,0 +1,60 @@
+package market_feed_api
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/kedr891/cs-parser/internal/models"
+)
+
+type feedHub interface {
+	Watch(ctx context.Context, filter *models.FeedFilter, after uint64, send func(*models.FeedEvent) error) error
+}
+
+// MarketFeedAPI - живая лента рынка для браузеров: те же события, что и WatchPrices,
+// плюс сработавшие правила и тренды, через Server-Sent Events и WebSocket на gateway.
+type MarketFeedAPI struct {
+	hub       feedHub
+	heartbeat time.Duration
+	log       *slog.Logger
+}
+
+func NewMarketFeedAPI(hub feedHub, heartbeat time.Duration, log *slog.Logger) *MarketFeedAPI {
+	return &MarketFeedAPI{
+		hub:       hub,
+		heartbeat: heartbeat,
+		log:       log,
+	}
+}
+
+func (a *MarketFeedAPI) Routes(r chi.Router) {
+	r.Get("/api/v1/stream/prices", a.ServeSSE)
+	r.Get("/api/v1/stream/ws", a.ServeWebSocket)
+}
+
+// feedMessage - JSON события в ленте. id совпадает с id события SSE,
+// клиент WebSocket передает его в last_event_id при переподключении.
+type feedMessage struct {
+	ID string `json:"id,omitempty"`
+	*models.FeedEvent
+	Error string `json:"error,omitempty"`
+}
+
+const (
+	messageHeartbeat = "heartbeat"
+	messageError     = "error"
+)
+
+func eventMessage(event *models.FeedEvent) feedMessage {
+	return feedMessage{ID: event.ID(), FeedEvent: event}
+}
+
+func heartbeatMessage() feedMessage {
+	return feedMessage{FeedEvent: &models.FeedEvent{Type: messageHeartbeat, Timestamp: time.Now()}}
+}
+
+func errorMessage(err error) feedMessage {
+	return feedMessage{FeedEvent: &models.FeedEvent{Type: messageError, Timestamp: time.Now()}, Error: err.Error()}
+}
@@ -0,0 +1,134 @@
+package market_feed_api
+
+import (
+	"bufio"
+	"context"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kedr891/cs-parser/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/websocket"
+)
+
+type hubStub struct {
+	events []*models.FeedEvent
+	filter *models.FeedFilter
+	after  uint64
+}
+
+func (h *hubStub) Watch(ctx context.Context, filter *models.FeedFilter, after uint64, send func(*models.FeedEvent) error) error {
+	h.filter, h.after = filter, after
+	for _, event := range h.events {
+		if err := send(event); err != nil {
+			return err
+		}
+	}
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func TestParseFeedRequest(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "/api/v1/stream/prices?types=price,alert&slugs=a,b&slugs=c&weapons=AWP", nil)
+	r.Header.Set("Last-Event-ID", "42")
+
+	filter, after, err := parseFeedRequest(r)
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]bool{"price": true, "alert": true}, filter.Types)
+	assert.Equal(t, map[string]bool{"a": true, "b": true, "c": true}, filter.Slugs)
+	assert.Equal(t, map[string]bool{"AWP": true}, filter.Weapons)
+	assert.False(t, filter.All)
+	assert.Equal(t, uint64(42), after)
+}
+
+func TestParseFeedRequest_DefaultsToEverything(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "/api/v1/stream/prices", nil)
+
+	filter, after, err := parseFeedRequest(r)
+	require.NoError(t, err)
+
+	assert.True(t, filter.All)
+	assert.Len(t, filter.Types, 3)
+	assert.Zero(t, after)
+}
+
+func TestParseFeedRequest_Invalid(t *testing.T) {
+	for _, query := range []string{"types=volume", "all=maybe", "last_event_id=abc", "all=false"} {
+		r := httptest.NewRequest(http.MethodGet, "/api/v1/stream/prices?"+query, nil)
+		_, _, err := parseFeedRequest(r)
+		assert.ErrorIs(t, err, models.ErrInvalidFeedFilter, query)
+	}
+}
+
+func TestServeSSE(t *testing.T) {
+	tick := models.NewPriceFeedEvent(&models.PriceUpdateEvent{Slug: "awp_asiimov_ft", NewPrice: 50, Timestamp: time.Now()})
+	tick.Seq = 42
+	// у трендов нет номера, id не отправляется, чтобы браузер не сбросил Last-Event-ID
+	trending := models.NewTrendingFeedEvent(nil)
+	hub := &hubStub{events: []*models.FeedEvent{tick, trending}}
+	api := NewMarketFeedAPI(hub, 10*time.Millisecond, slog.Default())
+
+	server := httptest.NewServer(http.HandlerFunc(api.ServeSSE))
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+"?last_event_id=5", nil)
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+	reader := bufio.NewReader(resp.Body)
+	var lines []string
+	for len(lines) < 9 {
+		line, err := reader.ReadString('\n')
+		require.NoError(t, err)
+		lines = append(lines, strings.TrimSuffix(line, "\n"))
+	}
+
+	// событие уже отправлено, значит Watch вызван
+	assert.Equal(t, uint64(5), hub.after)
+	assert.Equal(t, "retry: 3000", lines[0])
+	assert.Equal(t, "id: 42", lines[2])
+	assert.Equal(t, "event: price", lines[3])
+	assert.Contains(t, lines[4], `"id":"42"`)
+	assert.Contains(t, lines[4], `"slug":"awp_asiimov_ft"`)
+	assert.Equal(t, "event: trending", lines[6])
+	assert.NotContains(t, lines[7], `"id"`)
+
+	line, err := reader.ReadString('\n')
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(line, ": heartbeat"), line)
+}
+
+func TestServeWebSocket(t *testing.T) {
+	alert := models.NewAlertFeedEvent(&models.PriceAlertEvent{Slug: "awp_asiimov_ft", Price: 40, TriggeredAt: time.Now()})
+	alert.Seq = 7
+	hub := &hubStub{events: []*models.FeedEvent{alert}}
+	api := NewMarketFeedAPI(hub, 10*time.Millisecond, slog.Default())
+
+	server := httptest.NewServer(http.HandlerFunc(api.ServeWebSocket))
+	defer server.Close()
+
+	conn, err := websocket.Dial("ws"+strings.TrimPrefix(server.URL, "http")+"?types=alert&slugs=awp_asiimov_ft", "", server.URL)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	var event map[string]interface{}
+	require.NoError(t, websocket.JSON.Receive(conn, &event))
+	assert.Equal(t, "alert", event["type"])
+	assert.Equal(t, "7", event["id"])
+
+	var heartbeat map[string]interface{}
+	require.NoError(t, websocket.JSON.Receive(conn, &heartbeat))
+	assert.Equal(t, "heartbeat", heartbeat["type"])
+	assert.Equal(t, map[string]bool{"alert": true}, hub.filter.Types)
+}
@@ -0,0 +1,126 @@
+package market_feed_api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/kedr891/cs-parser/internal/models"
+)
+
+// sseRetry - через сколько миллисекунд EventSource переподключается после обрыва
+const sseRetry = 3000
+
+// ServeSSE отдает ленту в формате Server-Sent Events. Heartbeat отправляется комментарием,
+// чтобы прокси не закрывали простаивающее соединение.
+func (a *MarketFeedAPI) ServeSSE(w http.ResponseWriter, r *http.Request) {
+	filter, after, err := parseFeedRequest(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	rc := http.NewResponseController(w)
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	// события пишет Watch, heartbeat - отдельная горутина
+	var mu sync.Mutex
+	write := func(fn func() error) error {
+		mu.Lock()
+		defer mu.Unlock()
+		if err := fn(); err != nil {
+			return err
+		}
+		return rc.Flush()
+	}
+
+	if err := write(func() error {
+		_, err := fmt.Fprintf(w, "retry: %d\n\n", sseRetry)
+		return err
+	}); err != nil {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(r.Context())
+	// после выхода из обработчика писать в w нельзя, поэтому ждем остановки heartbeat
+	stopHeartbeat := a.startHeartbeat(ctx, cancel, func() error {
+		return write(func() error {
+			_, err := fmt.Fprintf(w, ": heartbeat %d\n\n", time.Now().Unix())
+			return err
+		})
+	})
+	defer stopHeartbeat()
+
+	err = a.hub.Watch(ctx, filter, after, func(event *models.FeedEvent) error {
+		return write(func() error {
+			return writeSSEEvent(w, event.ID(), event.Type, eventMessage(event))
+		})
+	})
+
+	if errors.Is(err, models.ErrSlowSubscriber) {
+		// клиент переподключится с Last-Event-ID и получит пропущенное из истории
+		_ = write(func() error {
+			return writeSSEEvent(w, "", messageError, errorMessage(err))
+		})
+		return
+	}
+	if err != nil && ctx.Err() == nil {
+		a.log.Warn("SSE stream failed", "error", err)
+	}
+}
+
+func writeSSEEvent(w io.Writer, id, event string, message feedMessage) error {
+	data, err := json.Marshal(message)
+	if err != nil {
+		return fmt.Errorf("marshal feed message: %w", err)
+	}
+
+	if id != "" {
+		if _, err := fmt.Fprintf(w, "id: %s\n", id); err != nil {
+			return err
+		}
+	}
+	_, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event, data)
+	return err
+}
+
+// startHeartbeat вызывает send с интервалом heartbeat, пока не отменен ctx. Ошибка записи
+// означает, что клиент отключился, поэтому поток отменяется. Возвращаемая функция отменяет
+// ctx и ждет завершения горутины.
+func (a *MarketFeedAPI) startHeartbeat(ctx context.Context, cancel context.CancelFunc, send func() error) func() {
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+
+		ticker := time.NewTicker(a.heartbeat)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := send(); err != nil {
+					cancel()
+					return
+				}
+			}
+		}
+	}()
+
+	return func() {
+		cancel()
+		<-done
+	}
+}
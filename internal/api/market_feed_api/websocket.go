@@ -0,0 +1,66 @@
+package market_feed_api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/kedr891/cs-parser/internal/models"
+	"golang.org/x/net/websocket"
+)
+
+// ServeWebSocket отдает ту же ленту через WebSocket: каждое событие - JSON-сообщение,
+// heartbeat - сообщение с type "heartbeat". Фильтр задается query string при подключении,
+// сообщения от клиента не читаются, кроме закрытия соединения.
+func (a *MarketFeedAPI) ServeWebSocket(w http.ResponseWriter, r *http.Request) {
+	filter, after, err := parseFeedRequest(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	server := websocket.Server{
+		// лента только на чтение и открыта так же, как SSE, поэтому Origin не проверяется
+		Handshake: func(*websocket.Config, *http.Request) error { return nil },
+		Handler: func(conn *websocket.Conn) {
+			a.serveWebSocketConn(conn, filter, after)
+		},
+	}
+	server.ServeHTTP(w, r)
+}
+
+func (a *MarketFeedAPI) serveWebSocketConn(conn *websocket.Conn, filter *models.FeedFilter, after uint64) {
+	defer conn.Close()
+
+	ctx, cancel := context.WithCancel(conn.Request().Context())
+	defer cancel()
+
+	// чтение нужно, чтобы заметить закрытие соединения клиентом
+	go func() {
+		defer cancel()
+		var discard []byte
+		for {
+			if err := websocket.Message.Receive(conn, &discard); err != nil {
+				return
+			}
+		}
+	}()
+
+	// websocket.Conn сериализует запись сам, поэтому heartbeat пишет без блокировки
+	stopHeartbeat := a.startHeartbeat(ctx, cancel, func() error {
+		return websocket.JSON.Send(conn, heartbeatMessage())
+	})
+	defer stopHeartbeat()
+
+	err := a.hub.Watch(ctx, filter, after, func(event *models.FeedEvent) error {
+		return websocket.JSON.Send(conn, eventMessage(event))
+	})
+
+	if errors.Is(err, models.ErrSlowSubscriber) {
+		_ = websocket.JSON.Send(conn, errorMessage(err))
+		return
+	}
+	if err != nil && ctx.Err() == nil {
+		a.log.Warn("WebSocket stream failed", "error", err)
+	}
+}
@@ -0,0 +1,84 @@
+package skins_service_api
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/kedr891/cs-parser/internal/models"
+	proto_models "github.com/kedr891/cs-parser/internal/pb/models"
+	"github.com/kedr891/cs-parser/internal/pb/skins_api"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *SkinsServiceAPI) CreateAlert(ctx context.Context, req *skins_api.CreateAlertRequest) (*skins_api.CreateAlertResponse, error) {
+	alert, err := s.analyticsService.CreateAlert(ctx, req.Slug, req.Condition, req.TargetPrice, req.Source)
+	if err != nil {
+		return nil, alertError(err)
+	}
+
+	return &skins_api.CreateAlertResponse{
+		Alert: mapAlertToProto(alert),
+	}, nil
+}
+
+func (s *SkinsServiceAPI) ListAlerts(ctx context.Context, req *skins_api.ListAlertsRequest) (*skins_api.ListAlertsResponse, error) {
+	alerts, err := s.analyticsService.ListAlerts(ctx, req.Slug)
+	if err != nil {
+		return nil, err
+	}
+
+	protoAlerts := make([]*proto_models.PriceAlertModel, len(alerts))
+	for i := range alerts {
+		protoAlerts[i] = mapAlertToProto(&alerts[i])
+	}
+
+	return &skins_api.ListAlertsResponse{
+		Alerts: protoAlerts,
+	}, nil
+}
+
+func (s *SkinsServiceAPI) DeleteAlert(ctx context.Context, req *skins_api.DeleteAlertRequest) (*skins_api.DeleteAlertResponse, error) {
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid alert id")
+	}
+
+	if err := s.analyticsService.DeleteAlert(ctx, id); err != nil {
+		return nil, alertError(err)
+	}
+
+	return &skins_api.DeleteAlertResponse{}, nil
+}
+
+func alertError(err error) error {
+	switch {
+	case errors.Is(err, models.ErrInvalidAlert):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, models.ErrAlertNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	default:
+		return err
+	}
+}
+
+func mapAlertToProto(alert *models.PriceAlert) *proto_models.PriceAlertModel {
+	var lastTriggeredAt string
+	if alert.LastTriggeredAt != nil {
+		lastTriggeredAt = alert.LastTriggeredAt.Format("2006-01-02T15:04:05Z")
+	}
+
+	return &proto_models.PriceAlertModel{
+		Id:              alert.ID.String(),
+		SkinId:          alert.SkinID.String(),
+		Slug:            alert.Slug,
+		Condition:       alert.Condition,
+		Source:          alert.Source,
+		TargetPrice:     alert.TargetPrice,
+		BasePrice:       alert.BasePrice,
+		Triggered:       alert.Triggered,
+		LastTriggeredAt: lastTriggeredAt,
+		CreatedAt:       alert.CreatedAt.Format("2006-01-02T15:04:05Z"),
+	}
+}
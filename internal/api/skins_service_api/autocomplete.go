@@ -0,0 +1,35 @@
+package skins_service_api
+
+import (
+	"context"
+
+	"github.com/kedr891/cs-parser/internal/models"
+	proto_models "github.com/kedr891/cs-parser/internal/pb/models"
+	"github.com/kedr891/cs-parser/internal/pb/skins_api"
+)
+
+func (s *SkinsServiceAPI) Autocomplete(ctx context.Context, req *skins_api.AutocompleteRequest) (*skins_api.AutocompleteResponse, error) {
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = models.DefaultAutocompleteLimit
+	}
+	limit = min(limit, models.MaxAutocompleteLimit)
+
+	suggestions, err := s.skinService.Autocomplete(ctx, req.Q, limit)
+	if err != nil {
+		return nil, skinError(err)
+	}
+
+	result := make([]*proto_models.AutocompleteSuggestionModel, len(suggestions))
+	for i, suggestion := range suggestions {
+		result[i] = &proto_models.AutocompleteSuggestionModel{
+			Slug:           suggestion.Slug,
+			MarketHashName: suggestion.MarketHashName,
+			Weapon:         suggestion.Weapon,
+			ImageUrl:       suggestion.ImageURL,
+			Score:          suggestion.Score,
+		}
+	}
+
+	return &skins_api.AutocompleteResponse{Suggestions: result}, nil
+}
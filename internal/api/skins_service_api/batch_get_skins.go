@@ -0,0 +1,36 @@
+package skins_service_api
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kedr891/cs-parser/internal/pb/skins_api"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const maxBatchGetSkins = 500
+
+func (s *SkinsServiceAPI) BatchGetSkins(ctx context.Context, req *skins_api.BatchGetSkinsRequest) (*skins_api.BatchGetSkinsResponse, error) {
+	if len(req.Keys) > maxBatchGetSkins {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("at most %d keys per request", maxBatchGetSkins))
+	}
+
+	lookups, err := s.skinService.BatchGetSkins(ctx, req.Keys)
+	if err != nil {
+		return nil, skinError(err)
+	}
+
+	results := make([]*skins_api.SkinLookup, len(lookups))
+	for i, lookup := range lookups {
+		results[i] = &skins_api.SkinLookup{Key: lookup.Key}
+		if lookup.Skin != nil {
+			results[i].Found = true
+			results[i].Skin = mapSkinToProto(lookup.Skin)
+		}
+	}
+
+	return &skins_api.BatchGetSkinsResponse{
+		Results: results,
+	}, nil
+}
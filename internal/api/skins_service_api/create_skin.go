@@ -0,0 +1,44 @@
+package skins_service_api
+
+import (
+	"context"
+	"errors"
+
+	"github.com/kedr891/cs-parser/internal/models"
+	"github.com/kedr891/cs-parser/internal/pb/skins_api"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *SkinsServiceAPI) CreateSkin(ctx context.Context, req *skins_api.CreateSkinRequest) (*skins_api.CreateSkinResponse, error) {
+	// marketHashName генерируется из weapon, name и quality, если не указан
+	skin := models.NewSkin(req.MarketHashName, req.Name, req.Weapon, req.Quality)
+	skin.Rarity = req.Rarity
+	skin.CurrentPrice = req.CurrentPrice
+	skin.Currency = req.Currency
+	skin.ImageURL = req.ImageUrl
+
+	if err := s.skinService.CreateSkin(ctx, skin); err != nil {
+		return nil, skinError(err)
+	}
+
+	return &skins_api.CreateSkinResponse{
+		Skin:    mapSkinToProto(skin),
+		Message: "Skin created successfully",
+	}, nil
+}
+
+func skinError(err error) error {
+	switch {
+	case errors.Is(err, models.ErrInvalidSkin), errors.Is(err, models.ErrInvalidPageToken),
+		errors.Is(err, models.ErrInvalidPriceBuckets), errors.Is(err, models.ErrInvalidSkinFilter),
+		errors.Is(err, models.ErrInvalidChartInterval):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, models.ErrSkinNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, models.ErrAutocompleteUnavailable):
+		return status.Error(codes.Unavailable, err.Error())
+	default:
+		return err
+	}
+}
@@ -0,0 +1,15 @@
+package skins_service_api
+
+import (
+	"context"
+
+	"github.com/kedr891/cs-parser/internal/pb/skins_api"
+)
+
+func (s *SkinsServiceAPI) DeleteSkin(ctx context.Context, req *skins_api.DeleteSkinRequest) (*skins_api.DeleteSkinResponse, error) {
+	if err := s.skinService.DeleteSkin(ctx, req.Slug); err != nil {
+		return nil, skinError(err)
+	}
+
+	return &skins_api.DeleteSkinResponse{}, nil
+}
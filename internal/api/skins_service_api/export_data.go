@@ -0,0 +1,46 @@
+package skins_service_api
+
+import (
+	"bufio"
+	"errors"
+
+	"github.com/kedr891/cs-parser/internal/models"
+	"github.com/kedr891/cs-parser/internal/pb/skins_api"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// exportChunkSize - размер куска выгрузки в одном сообщении потока.
+const exportChunkSize = 64 * 1024
+
+func (s *SkinsServiceAPI) ExportData(req *skins_api.ExportDataRequest, stream skins_api.SkinsService_ExportDataServer) error {
+	filter, err := models.NewExportFilter(req.Dataset, req.Format, req.Weapon, req.Source, req.From, req.To)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	w := bufio.NewWriterSize(exportChunkWriter{stream: stream}, exportChunkSize)
+	if _, err := s.exportService.Export(stream.Context(), filter, w); err != nil {
+		if errors.Is(err, models.ErrInvalidExport) {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+		if stream.Context().Err() != nil {
+			return status.FromContextError(stream.Context().Err()).Err()
+		}
+		return err
+	}
+
+	return w.Flush()
+}
+
+type exportChunkWriter struct {
+	stream skins_api.SkinsService_ExportDataServer
+}
+
+func (w exportChunkWriter) Write(p []byte) (int, error) {
+	// p - буфер bufio.Writer, а сообщение после Send менять нельзя, поэтому данные копируются
+	if err := w.stream.Send(&skins_api.ExportDataResponse{Data: append([]byte(nil), p...)}); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
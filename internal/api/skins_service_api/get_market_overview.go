@@ -3,6 +3,7 @@ package skins_service_api
 import (
 	"context"
 
+	"github.com/kedr891/cs-parser/internal/models"
 	proto_models "github.com/kedr891/cs-parser/internal/pb/models"
 	"github.com/kedr891/cs-parser/internal/pb/skins_api"
 )
@@ -18,6 +19,27 @@ func (s *SkinsServiceAPI) GetMarketOverview(ctx context.Context, req *skins_api.
 			TotalSkins:      int32(overview.TotalSkins),
 			AvgPrice:        overview.AvgPrice,
 			TotalVolume_24H: int32(overview.TotalVolume24h),
+			TopGainers:      mapSkinsToProto(overview.TopGainers),
+			TopLosers:       mapSkinsToProto(overview.TopLosers),
+			MostPopular:     mapSkinsToProto(overview.MostPopular),
+			RecentlyUpdated: mapSkinsToProto(overview.RecentlyUpdated),
+			ByCategory:      mapMarketSegmentsToProto(overview.ByCategory),
+			ByRarity:        mapMarketSegmentsToProto(overview.ByRarity),
+			ByShard:         mapMarketSegmentsToProto(overview.ByShard),
+			UpdatedAt:       overview.UpdatedAt.Format("2006-01-02T15:04:05Z"),
 		},
 	}, nil
 }
+
+func mapMarketSegmentsToProto(segments []models.MarketSegment) []*proto_models.MarketSegmentModel {
+	result := make([]*proto_models.MarketSegmentModel, len(segments))
+	for i, segment := range segments {
+		result[i] = &proto_models.MarketSegmentModel{
+			Name:            segment.Name,
+			SkinCount:       int32(segment.SkinCount),
+			AvgPrice:        segment.AvgPrice,
+			TotalVolume_24H: int32(segment.TotalVolume24h),
+		}
+	}
+	return result
+}
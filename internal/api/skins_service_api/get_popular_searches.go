@@ -0,0 +1,51 @@
+package skins_service_api
+
+import (
+	"context"
+
+	"github.com/kedr891/cs-parser/internal/models"
+	proto_models "github.com/kedr891/cs-parser/internal/pb/models"
+	"github.com/kedr891/cs-parser/internal/pb/skins_api"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *SkinsServiceAPI) GetPopularSearches(ctx context.Context, req *skins_api.GetPopularSearchesRequest) (*skins_api.GetPopularSearchesResponse, error) {
+	window := models.SearchWindowDay
+	if req.Window != "" {
+		var err error
+		if window, err = models.ParseSearchWindow(req.Window); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = models.DefaultPopularSearchesLimit
+	}
+	limit = min(limit, models.MaxPopularSearchesLimit)
+
+	searches, err := s.analyticsService.GetPopularSearches(ctx, window, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return &skins_api.GetPopularSearchesResponse{
+		Window:             string(searches.Window),
+		From:               searches.From.Format("2006-01-02T15:04:05Z"),
+		Searches:           mapPopularSearchesToProto(searches.Searches),
+		ZeroResultSearches: mapPopularSearchesToProto(searches.ZeroResultSearches),
+	}, nil
+}
+
+func mapPopularSearchesToProto(searches []models.PopularSearch) []*proto_models.PopularSearchModel {
+	result := make([]*proto_models.PopularSearchModel, len(searches))
+	for i, search := range searches {
+		result[i] = &proto_models.PopularSearchModel{
+			Query:       search.Query,
+			Count:       search.Count,
+			ZeroResults: search.ZeroResults,
+		}
+	}
+	return result
+}
@@ -6,6 +6,8 @@ import (
 	"github.com/kedr891/cs-parser/internal/models"
 	proto_models "github.com/kedr891/cs-parser/internal/pb/models"
 	"github.com/kedr891/cs-parser/internal/pb/skins_api"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func (s *SkinsServiceAPI) GetPriceChart(ctx context.Context, req *skins_api.GetPriceChartRequest) (*skins_api.GetPriceChartResponse, error) {
@@ -14,9 +16,23 @@ func (s *SkinsServiceAPI) GetPriceChart(ctx context.Context, req *skins_api.GetP
 		period = models.PriceStatsPeriod(req.Period)
 	}
 
-	chartData, err := s.skinService.GetPriceChart(ctx, req.Slug, period)
+	var interval models.ChartInterval
+	if req.Interval != "" {
+		var err error
+		interval, err = models.ParseChartInterval(req.Interval)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	source := models.PriceSource(req.Source)
+	if source != "" && !source.IsValid() {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid source %q", req.Source)
+	}
+
+	chartData, err := s.skinService.GetPriceChart(ctx, req.Slug, period, interval, source)
 	if err != nil {
-		return nil, err
+		return nil, skinError(err)
 	}
 
 	dataPoints := make([]*proto_models.PriceChartDataModel, len(chartData.DataPoints))
@@ -28,6 +44,20 @@ func (s *SkinsServiceAPI) GetPriceChart(ctx context.Context, req *skins_api.GetP
 		}
 	}
 
+	candles := make([]*proto_models.PriceCandleModel, len(chartData.Candles))
+	for i, c := range chartData.Candles {
+		candles[i] = &proto_models.PriceCandleModel{
+			Timestamp: c.Timestamp.Format("2006-01-02T15:04:05Z"),
+			Open:      c.Open,
+			High:      c.High,
+			Low:       c.Low,
+			Close:     c.Close,
+			AvgPrice:  c.AvgPrice,
+			Volume:    int32(c.Volume),
+			Count:     int32(c.Count),
+		}
+	}
+
 	return &skins_api.GetPriceChartResponse{
 		SkinId:      chartData.SkinID.String(),
 		Period:      chartData.Period,
@@ -36,5 +66,8 @@ func (s *SkinsServiceAPI) GetPriceChart(ctx context.Context, req *skins_api.GetP
 		MaxPrice:    chartData.MaxPrice,
 		AvgPrice:    chartData.AvgPrice,
 		TotalVolume: int32(chartData.TotalVolume),
+		Interval:    chartData.Interval,
+		Candles:     candles,
+		Source:      chartData.Source,
 	}, nil
 }
@@ -0,0 +1,46 @@
+package skins_service_api
+
+import (
+	"context"
+	"time"
+
+	"github.com/kedr891/cs-parser/internal/models"
+	proto_models "github.com/kedr891/cs-parser/internal/pb/models"
+	"github.com/kedr891/cs-parser/internal/pb/skins_api"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *SkinsServiceAPI) GetPriceComparison(ctx context.Context, req *skins_api.GetPriceComparisonRequest) (*skins_api.GetPriceComparisonResponse, error) {
+	if req.MaxAgeHours < 0 {
+		return nil, status.Error(codes.InvalidArgument, "max_age_hours must not be negative")
+	}
+
+	comparison, err := s.analyticsService.GetPriceComparison(ctx, req.Slug, time.Duration(req.MaxAgeHours)*time.Hour)
+	if err != nil {
+		return nil, skinError(err)
+	}
+
+	return &skins_api.GetPriceComparisonResponse{
+		Comparison: mapPriceComparisonToProto(comparison),
+	}, nil
+}
+
+func mapPriceComparisonToProto(comparison *models.PriceComparison) *proto_models.PriceComparisonModel {
+	model := &proto_models.PriceComparisonModel{
+		SkinId:         comparison.SkinID.String(),
+		Slug:           comparison.Slug,
+		MarketHashName: comparison.MarketHashName,
+		Prices:         comparison.Prices,
+		BestPrice:      comparison.BestPrice,
+		BestSource:     comparison.BestSource,
+		SellPrice:      comparison.SellPrice,
+		SellSource:     comparison.SellSource,
+		PriceDiff:      comparison.PriceDiff,
+		SpreadPercent:  comparison.SpreadPercent,
+	}
+	if !comparison.UpdatedAt.IsZero() {
+		model.UpdatedAt = comparison.UpdatedAt.Format("2006-01-02T15:04:05Z")
+	}
+	return model
+}
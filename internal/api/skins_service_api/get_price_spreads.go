@@ -0,0 +1,39 @@
+package skins_service_api
+
+import (
+	"context"
+	"time"
+
+	proto_models "github.com/kedr891/cs-parser/internal/pb/models"
+	"github.com/kedr891/cs-parser/internal/pb/skins_api"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *SkinsServiceAPI) GetPriceSpreads(ctx context.Context, req *skins_api.GetPriceSpreadsRequest) (*skins_api.GetPriceSpreadsResponse, error) {
+	if req.MaxAgeHours < 0 || req.MinPrice < 0 {
+		return nil, status.Error(codes.InvalidArgument, "max_age_hours and min_price must not be negative")
+	}
+
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = 20
+	}
+	if limit > 100 {
+		limit = 100
+	}
+
+	comparisons, err := s.analyticsService.GetPriceSpreads(ctx, time.Duration(req.MaxAgeHours)*time.Hour, req.MinPrice, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	protoComparisons := make([]*proto_models.PriceComparisonModel, len(comparisons))
+	for i := range comparisons {
+		protoComparisons[i] = mapPriceComparisonToProto(&comparisons[i])
+	}
+
+	return &skins_api.GetPriceSpreadsResponse{
+		Comparisons: protoComparisons,
+	}, nil
+}
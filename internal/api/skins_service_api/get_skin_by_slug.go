@@ -16,7 +16,7 @@ func (s *SkinsServiceAPI) GetSkinBySlug(ctx context.Context, req *skins_api.GetS
 
 	response, err := s.skinService.GetSkinBySlug(ctx, req.Slug, period)
 	if err != nil {
-		return nil, err
+		return nil, skinError(err)
 	}
 
 	return &skins_api.GetSkinBySlugResponse{
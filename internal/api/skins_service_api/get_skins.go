@@ -2,6 +2,8 @@ package skins_service_api
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/kedr891/cs-parser/internal/models"
 	proto_models "github.com/kedr891/cs-parser/internal/pb/models"
@@ -10,15 +12,39 @@ import (
 
 func (s *SkinsServiceAPI) GetSkins(ctx context.Context, req *skins_api.GetSkinsRequest) (*skins_api.GetSkinsResponse, error) {
 	filter := &models.SkinFilter{
-		Weapon:    req.Weapon,
-		Quality:   req.Quality,
-		MinPrice:  req.MinPrice,
-		MaxPrice:  req.MaxPrice,
-		Search:    req.Search,
-		SortBy:    req.SortBy,
-		SortOrder: req.SortOrder,
-		Limit:     int(req.PageSize),
-		Offset:    (int(req.Page) - 1) * int(req.PageSize),
+		Weapon:            req.Weapon,
+		Quality:           req.Quality,
+		Rarity:            req.Rarity,
+		MinPrice:          req.MinPrice,
+		MaxPrice:          req.MaxPrice,
+		Search:            req.Search,
+		SortBy:            req.SortBy,
+		SortOrder:         req.SortOrder,
+		Limit:             int(req.PageSize),
+		Offset:            (int(req.Page) - 1) * int(req.PageSize),
+		PageToken:         req.PageToken,
+		Weapons:           req.Weapons,
+		Qualities:         req.Qualities,
+		Rarities:          req.Rarities,
+		MinPriceChange24h: req.MinPriceChange_24H,
+		MaxPriceChange24h: req.MaxPriceChange_24H,
+		MinPriceChange7d:  req.MinPriceChange_7D,
+		MaxPriceChange7d:  req.MaxPriceChange_7D,
+		MinVolume24h:      optionalInt(req.MinVolume_24H),
+		MaxVolume24h:      optionalInt(req.MaxVolume_24H),
+		StatTrak:          req.Stattrak,
+		Souvenir:          req.Souvenir,
+	}
+	for _, category := range req.Categories {
+		filter.Categories = append(filter.Categories, models.SkinCategory(category))
+	}
+
+	var err error
+	if filter.UpdatedAfter, err = parseFilterTime("updated_after", req.UpdatedAfter); err != nil {
+		return nil, skinError(err)
+	}
+	if filter.UpdatedBefore, err = parseFilterTime("updated_before", req.UpdatedBefore); err != nil {
+		return nil, skinError(err)
 	}
 
 	if filter.Limit == 0 {
@@ -30,37 +56,102 @@ func (s *SkinsServiceAPI) GetSkins(ctx context.Context, req *skins_api.GetSkinsR
 
 	response, err := s.skinService.GetSkins(ctx, filter)
 	if err != nil {
-		return nil, err
+		return nil, skinError(err)
 	}
 
-	return &skins_api.GetSkinsResponse{
-		Skins:      mapSkinsToProto(response.Skins),
-		Total:      int32(response.Total),
-		Page:       int32(response.Page),
-		PageSize:   int32(response.PageSize),
-		TotalPages: int32(response.TotalPages),
-	}, nil
+	result := &skins_api.GetSkinsResponse{
+		Skins:         mapSkinsToProto(response.Skins),
+		Total:         int32(response.Total),
+		Page:          int32(response.Page),
+		PageSize:      int32(response.PageSize),
+		TotalPages:    int32(response.TotalPages),
+		NextPageToken: response.NextPageToken,
+	}
+
+	if req.IncludeFacets {
+		facets, err := s.skinService.GetSkinFacets(ctx, filter, req.PriceBuckets)
+		if err != nil {
+			return nil, skinError(err)
+		}
+		result.Facets = mapSkinFacetsToProto(facets)
+	}
+
+	return result, nil
+}
+
+func optionalInt(value *int32) *int {
+	if value == nil {
+		return nil
+	}
+	v := int(*value)
+	return &v
+}
+
+// parseFilterTime разбирает необязательную границу периода в RFC3339.
+func parseFilterTime(name, value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: %s must be RFC3339", models.ErrInvalidSkinFilter, name)
+	}
+	return t.UTC(), nil
 }
 
 func mapSkinsToProto(skins []models.Skin) []*proto_models.SkinModel {
 	result := make([]*proto_models.SkinModel, len(skins))
-	for i, skin := range skins {
-		result[i] = &proto_models.SkinModel{
-			Id:              skin.ID.String(),
-			MarketHashName:  skin.MarketHashName,
-			Name:            skin.Name,
-			Weapon:          skin.Weapon,
-			Quality:         skin.Quality,
-			Rarity:          skin.Rarity,
-			CurrentPrice:    skin.CurrentPrice,
-			Currency:        skin.Currency,
-			ImageUrl:        skin.ImageURL,
-			Volume_24H:      int32(skin.Volume24h),
-			PriceChange_24H: skin.PriceChange24h,
-			PriceChange_7D:  skin.PriceChange7d,
-			Slug:            skin.Slug,
-			CreatedAt:       skin.CreatedAt.Format("2006-01-02T15:04:05Z"),
-			UpdatedAt:       skin.UpdatedAt.Format("2006-01-02T15:04:05Z"),
+	for i := range skins {
+		result[i] = mapSkinToProto(&skins[i])
+	}
+	return result
+}
+
+func mapSkinToProto(skin *models.Skin) *proto_models.SkinModel {
+	return &proto_models.SkinModel{
+		Id:              skin.ID.String(),
+		MarketHashName:  skin.MarketHashName,
+		Name:            skin.Name,
+		Weapon:          skin.Weapon,
+		Quality:         skin.Quality,
+		Rarity:          skin.Rarity,
+		CurrentPrice:    skin.CurrentPrice,
+		Currency:        skin.Currency,
+		ImageUrl:        skin.ImageURL,
+		Volume_24H:      int32(skin.Volume24h),
+		PriceChange_24H: skin.PriceChange24h,
+		PriceChange_7D:  skin.PriceChange7d,
+		Slug:            skin.Slug,
+		CreatedAt:       skin.CreatedAt.Format("2006-01-02T15:04:05Z"),
+		UpdatedAt:       skin.UpdatedAt.Format("2006-01-02T15:04:05Z"),
+	}
+}
+
+func mapSkinFacetsToProto(facets *models.SkinFacets) *proto_models.SkinFacetsModel {
+	buckets := make([]*proto_models.PriceBucketModel, len(facets.PriceBuckets))
+	for i, bucket := range facets.PriceBuckets {
+		buckets[i] = &proto_models.PriceBucketModel{
+			Min:   bucket.Min,
+			Max:   bucket.Max,
+			Count: int32(bucket.Count),
+		}
+	}
+
+	return &proto_models.SkinFacetsModel{
+		Weapons:      mapFacetCountsToProto(facets.Weapons),
+		Qualities:    mapFacetCountsToProto(facets.Qualities),
+		Rarities:     mapFacetCountsToProto(facets.Rarities),
+		Categories:   mapFacetCountsToProto(facets.Categories),
+		PriceBuckets: buckets,
+	}
+}
+
+func mapFacetCountsToProto(counts []models.FacetCount) []*proto_models.FacetCountModel {
+	result := make([]*proto_models.FacetCountModel, len(counts))
+	for i, count := range counts {
+		result[i] = &proto_models.FacetCountModel{
+			Value: count.Value,
+			Count: int32(count.Count),
 		}
 	}
 	return result
@@ -0,0 +1,22 @@
+package skins_service_api
+
+import (
+	"context"
+	"time"
+
+	"github.com/kedr891/cs-parser/internal/pb/skins_api"
+)
+
+func (s *SkinsServiceAPI) RecomputePriceStats(ctx context.Context, req *skins_api.RecomputePriceStatsRequest) (*skins_api.RecomputePriceStatsResponse, error) {
+	started := time.Now()
+
+	updated, err := s.analyticsService.RecomputePriceStats(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &skins_api.RecomputePriceStatsResponse{
+		UpdatedSkins: int32(updated),
+		DurationMs:   time.Since(started).Milliseconds(),
+	}, nil
+}
@@ -3,6 +3,7 @@ package skins_service_api
 import (
 	"context"
 
+	"github.com/kedr891/cs-parser/internal/models"
 	"github.com/kedr891/cs-parser/internal/pb/skins_api"
 )
 
@@ -17,7 +18,17 @@ func (s *SkinsServiceAPI) SearchSkins(ctx context.Context, req *skins_api.Search
 		return nil, err
 	}
 
-	return &skins_api.SearchSkinsResponse{
+	result := &skins_api.SearchSkinsResponse{
 		Skins: mapSkinsToProto(skins),
-	}, nil
+	}
+
+	if req.IncludeFacets {
+		facets, err := s.skinService.GetSkinFacets(ctx, &models.SkinFilter{Search: req.Query}, req.PriceBuckets)
+		if err != nil {
+			return nil, skinError(err)
+		}
+		result.Facets = mapSkinFacetsToProto(facets)
+	}
+
+	return result, nil
 }
@@ -2,7 +2,10 @@ package skins_service_api
 
 import (
 	"context"
+	"io"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/kedr891/cs-parser/internal/models"
 	"github.com/kedr891/cs-parser/internal/pb/skins_api"
 )
@@ -10,28 +13,65 @@ import (
 type skinService interface {
 	GetSkins(ctx context.Context, filter *models.SkinFilter) (*models.SkinListResponse, error)
 	GetSkinBySlug(ctx context.Context, slug string, period models.PriceStatsPeriod) (*models.SkinDetailResponse, error)
+	BatchGetSkins(ctx context.Context, keys []string) ([]models.SkinLookup, error)
 	SearchSkins(ctx context.Context, query string, limit int) ([]models.Skin, error)
+	GetSkinFacets(ctx context.Context, filter *models.SkinFilter, bounds []float64) (*models.SkinFacets, error)
+	Autocomplete(ctx context.Context, query string, limit int) ([]models.AutocompleteSuggestion, error)
 	GetPopularSkins(ctx context.Context, limit int) ([]models.Skin, error)
-	GetPriceChart(ctx context.Context, slug string, period models.PriceStatsPeriod) (*models.PriceChartResponse, error)
+	GetPriceChart(ctx context.Context, slug string, period models.PriceStatsPeriod, interval models.ChartInterval, source models.PriceSource) (*models.PriceChartResponse, error)
 	CreateSkin(ctx context.Context, skin *models.Skin) error
+	UpdateSkin(ctx context.Context, slug string, patch *models.Skin, fields []string) (*models.Skin, error)
+	DeleteSkin(ctx context.Context, slug string) error
 }
 
 type analyticsService interface {
 	GetTrending(ctx context.Context, period string, limit int) ([]models.Skin, error)
 	GetMarketOverview(ctx context.Context) (*models.MarketOverview, error)
+	GetPopularSearches(ctx context.Context, window models.SearchWindow, limit int) (*models.PopularSearches, error)
 	GetTopGainers(ctx context.Context, limit int) ([]models.Skin, error)
 	GetTopLosers(ctx context.Context, limit int) ([]models.Skin, error)
+	GetPriceComparison(ctx context.Context, slug string, maxAge time.Duration) (*models.PriceComparison, error)
+	GetPriceSpreads(ctx context.Context, maxAge time.Duration, minPrice float64, limit int) ([]models.PriceComparison, error)
+	RecomputePriceStats(ctx context.Context) (int, error)
+	CreateAlert(ctx context.Context, slug, condition string, target float64, source string) (*models.PriceAlert, error)
+	ListAlerts(ctx context.Context, slug string) ([]models.PriceAlert, error)
+	DeleteAlert(ctx context.Context, id uuid.UUID) error
+}
+
+type notifierService interface {
+	RegisterWebhook(ctx context.Context, url, secret string) (*models.Webhook, error)
+	ListDeliveries(ctx context.Context, webhookID uuid.UUID, limit int) ([]models.WebhookDelivery, error)
+}
+
+type priceStream interface {
+	Watch(ctx context.Context, filter *models.FeedFilter, after uint64, send func(*models.FeedEvent) error) error
+}
+
+type exportService interface {
+	Export(ctx context.Context, filter *models.ExportFilter, w io.Writer) (int, error)
 }
 
 type SkinsServiceAPI struct {
 	skins_api.UnimplementedSkinsServiceServer
 	skinService      skinService
 	analyticsService analyticsService
+	notifierService  notifierService
+	priceStream      priceStream
+	exportService    exportService
 }
 
-func NewSkinsServiceAPI(skinService skinService, analyticsService analyticsService) *SkinsServiceAPI {
+func NewSkinsServiceAPI(
+	skinService skinService,
+	analyticsService analyticsService,
+	notifierService notifierService,
+	priceStream priceStream,
+	exportService exportService,
+) *SkinsServiceAPI {
 	return &SkinsServiceAPI{
 		skinService:      skinService,
 		analyticsService: analyticsService,
+		notifierService:  notifierService,
+		priceStream:      priceStream,
+		exportService:    exportService,
 	}
 }
@@ -0,0 +1,36 @@
+package skins_service_api
+
+import (
+	"context"
+
+	"github.com/kedr891/cs-parser/internal/models"
+	"github.com/kedr891/cs-parser/internal/pb/skins_api"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *SkinsServiceAPI) UpdateSkin(ctx context.Context, req *skins_api.UpdateSkinRequest) (*skins_api.UpdateSkinResponse, error) {
+	if req.Skin == nil {
+		return nil, status.Error(codes.InvalidArgument, "skin is required")
+	}
+
+	patch := &models.Skin{
+		MarketHashName: req.Skin.MarketHashName,
+		Name:           req.Skin.Name,
+		Weapon:         req.Skin.Weapon,
+		Quality:        req.Skin.Quality,
+		Rarity:         req.Skin.Rarity,
+		CurrentPrice:   req.Skin.CurrentPrice,
+		Currency:       req.Skin.Currency,
+		ImageURL:       req.Skin.ImageUrl,
+	}
+
+	skin, err := s.skinService.UpdateSkin(ctx, req.Slug, patch, req.GetUpdateMask().GetPaths())
+	if err != nil {
+		return nil, skinError(err)
+	}
+
+	return &skins_api.UpdateSkinResponse{
+		Skin: mapSkinToProto(skin),
+	}, nil
+}
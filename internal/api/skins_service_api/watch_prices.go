@@ -0,0 +1,52 @@
+package skins_service_api
+
+import (
+	"errors"
+	"time"
+
+	"github.com/kedr891/cs-parser/internal/models"
+	proto_models "github.com/kedr891/cs-parser/internal/pb/models"
+	"github.com/kedr891/cs-parser/internal/pb/skins_api"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *SkinsServiceAPI) WatchPrices(req *skins_api.WatchPricesRequest, stream skins_api.SkinsService_WatchPricesServer) error {
+	filter, err := models.NewFeedFilter([]string{models.FeedEventPrice}, req.All, req.Slugs, req.Weapons)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err = s.priceStream.Watch(stream.Context(), filter, req.AfterSeq, func(event *models.FeedEvent) error {
+		return stream.Send(&skins_api.WatchPricesResponse{
+			Tick: mapPriceTickToProto(event),
+		})
+	})
+
+	switch {
+	case errors.Is(err, models.ErrSlowSubscriber):
+		return status.Error(codes.ResourceExhausted, err.Error())
+	case stream.Context().Err() != nil:
+		return status.FromContextError(stream.Context().Err()).Err()
+	default:
+		return err
+	}
+}
+
+func mapPriceTickToProto(feedEvent *models.FeedEvent) *proto_models.PriceTickModel {
+	event := feedEvent.Price
+	return &proto_models.PriceTickModel{
+		SkinId:         event.SkinID.String(),
+		Slug:           event.Slug,
+		MarketHashName: event.MarketHashName,
+		Weapon:         event.Weapon,
+		Source:         event.Source,
+		OldPrice:       event.OldPrice,
+		NewPrice:       event.NewPrice,
+		Currency:       event.Currency,
+		Volume_24H:     int32(event.Volume24h),
+		PriceChange:    event.PriceChange,
+		Timestamp:      event.Timestamp.UTC().Format(time.RFC3339Nano),
+		Seq:            feedEvent.Seq,
+	}
+}
@@ -0,0 +1,74 @@
+package skins_service_api
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/kedr891/cs-parser/internal/models"
+	proto_models "github.com/kedr891/cs-parser/internal/pb/models"
+	"github.com/kedr891/cs-parser/internal/pb/skins_api"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *SkinsServiceAPI) RegisterWebhook(ctx context.Context, req *skins_api.RegisterWebhookRequest) (*skins_api.RegisterWebhookResponse, error) {
+	webhook, err := s.notifierService.RegisterWebhook(ctx, req.Url, req.Secret)
+	if err != nil {
+		if errors.Is(err, models.ErrInvalidWebhook) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, err
+	}
+
+	return &skins_api.RegisterWebhookResponse{
+		Webhook: &proto_models.WebhookModel{
+			Id:        webhook.ID.String(),
+			Url:       webhook.URL,
+			Active:    webhook.Active,
+			CreatedAt: webhook.CreatedAt.Format("2006-01-02T15:04:05Z"),
+		},
+		Secret: webhook.Secret,
+	}, nil
+}
+
+func (s *SkinsServiceAPI) ListDeliveries(ctx context.Context, req *skins_api.ListDeliveriesRequest) (*skins_api.ListDeliveriesResponse, error) {
+	var webhookID uuid.UUID
+	if req.WebhookId != "" {
+		id, err := uuid.Parse(req.WebhookId)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid webhook id")
+		}
+		webhookID = id
+	}
+
+	deliveries, err := s.notifierService.ListDeliveries(ctx, webhookID, int(req.Limit))
+	if err != nil {
+		return nil, err
+	}
+
+	protoDeliveries := make([]*proto_models.WebhookDeliveryModel, len(deliveries))
+	for i, d := range deliveries {
+		var deliveredAt string
+		if d.DeliveredAt != nil {
+			deliveredAt = d.DeliveredAt.Format("2006-01-02T15:04:05Z")
+		}
+
+		protoDeliveries[i] = &proto_models.WebhookDeliveryModel{
+			Id:           d.ID.String(),
+			WebhookId:    d.WebhookID.String(),
+			AlertId:      d.AlertID.String(),
+			Url:          d.URL,
+			Status:       d.Status,
+			Attempts:     int32(d.Attempts),
+			ResponseCode: int32(d.ResponseCode),
+			Error:        d.Error,
+			CreatedAt:    d.CreatedAt.Format("2006-01-02T15:04:05Z"),
+			DeliveredAt:  deliveredAt,
+		}
+	}
+
+	return &skins_api.ListDeliveriesResponse{
+		Deliveries: protoDeliveries,
+	}, nil
+}
@@ -0,0 +1,149 @@
+package bootstrap
+
+import (
+	"bufio"
+	"context"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+
+	"github.com/kedr891/cs-parser/config"
+	"github.com/kedr891/cs-parser/internal/models"
+	exportservice "github.com/kedr891/cs-parser/internal/services/exportService"
+)
+
+// RunRedriveDLQ возвращает сообщения из DLQ цен (-dlq prices) или уведомлений (-dlq alerts) в исходный топик.
+func RunRedriveDLQ(cfg *config.Config, args []string, log *slog.Logger) error {
+	fs := flag.NewFlagSet("redrive-dlq", flag.ContinueOnError)
+	dlq := fs.String("dlq", "prices", "очередь: prices или alerts")
+	limit := fs.Int("limit", 0, "максимум сообщений (0 - все)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	var dlqTopic, fallbackTopic string
+	switch *dlq {
+	case "prices":
+		dlqTopic, fallbackTopic = cfg.Kafka.TopicPriceUpdatedDLQ, cfg.Kafka.TopicPriceUpdated
+	case "alerts":
+		dlqTopic, fallbackTopic = cfg.Kafka.TopicPriceAlertDLQ, cfg.Kafka.TopicPriceAlert
+	default:
+		return fmt.Errorf("unknown dlq %q: expected prices or alerts", *dlq)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	redriven, err := InitDeadLetterConsumer(cfg, dlqTopic, fallbackTopic).Redrive(ctx, *limit)
+	log.Info("DLQ redrive done", "topic", dlqTopic, "redriven", redriven)
+
+	return err
+}
+
+// RunExport выгружает каталог и/или историю цен в файлы <dataset>.<ext> в каталоге --out-dir.
+func RunExport(cfg *config.Config, args []string, log *slog.Logger) error {
+	fs := flag.NewFlagSet("export", flag.ContinueOnError)
+	dataset := fs.String("dataset", "all", "набор данных: skins, price_history или all")
+	format := fs.String("format", string(models.ExportCSV), "формат: csv, ndjson или parquet")
+	weapon := fs.String("weapon", "", "только скины указанного оружия")
+	source := fs.String("source", "", "только цены указанного источника (price_history)")
+	from := fs.String("from", "", "начало периода, RFC3339")
+	to := fs.String("to", "", "конец периода, RFC3339")
+	outDir := fs.String("out-dir", ".", "каталог для файлов")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	datasets := []string{*dataset}
+	if *dataset == "all" {
+		datasets = []string{string(models.ExportSkins), string(models.ExportPriceHistory)}
+	}
+
+	// фильтры проверяем до подключения к БД, чтобы не создавать файлы по ошибочным флагам
+	filters := make([]*models.ExportFilter, 0, len(datasets))
+	for _, name := range datasets {
+		itemSource := *source
+		if name == string(models.ExportSkins) && *dataset == "all" {
+			itemSource = ""
+		}
+		filter, err := models.NewExportFilter(name, *format, *weapon, itemSource, *from, *to)
+		if err != nil {
+			return err
+		}
+		filters = append(filters, filter)
+	}
+
+	if err := os.MkdirAll(*outDir, 0o755); err != nil {
+		return fmt.Errorf("create out dir: %w", err)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	storage := InitPGStorage(cfg)
+	defer storage.Close()
+	service := InitExportService(storage, log)
+
+	for _, filter := range filters {
+		path := filepath.Join(*outDir, fmt.Sprintf("%s.%s", filter.Dataset, filter.Format.Extension()))
+		rows, err := exportToFile(ctx, service, filter, path)
+		if err != nil {
+			return fmt.Errorf("export %s: %w", filter.Dataset, err)
+		}
+		log.Info("Export done", "dataset", filter.Dataset, "format", filter.Format, "rows", rows, "path", path)
+	}
+
+	return nil
+}
+
+// exportToFile пишет выгрузку во временный файл и переименовывает его только после успеха,
+// чтобы прерванный экспорт не оставлял обрезанный файл под итоговым именем.
+func exportToFile(ctx context.Context, service *exportservice.Service, filter *models.ExportFilter, path string) (int, error) {
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return 0, err
+	}
+	defer os.Remove(tmp.Name())
+
+	w := bufio.NewWriterSize(tmp, 1<<20)
+	rows, err := service.Export(ctx, filter, w)
+	if err == nil {
+		err = w.Flush()
+	}
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return rows, err
+	}
+
+	return rows, os.Rename(tmp.Name(), path)
+}
+
+// RunRebuildAutocomplete заново строит индекс подсказок по скинам со всех шардов.
+func RunRebuildAutocomplete(cfg *config.Config, args []string, log *slog.Logger) error {
+	fs := flag.NewFlagSet("rebuild-autocomplete", flag.ContinueOnError)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	storage := InitPGStorage(cfg)
+	defer storage.Close()
+	cache, closeCache := InitCache(cfg)
+	defer closeCache()
+
+	indexed, err := InitSkinService(storage, cache, log).RebuildAutocomplete(ctx)
+	if err != nil {
+		return err
+	}
+	log.Info("Autocomplete index rebuilt", "skins", indexed)
+
+	return nil
+}
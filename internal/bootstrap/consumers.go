@@ -2,21 +2,86 @@ package bootstrap
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/kedr891/cs-parser/config"
+	deadletterconsumer "github.com/kedr891/cs-parser/internal/consumer/dead_letter_consumer"
+	pricealertconsumer "github.com/kedr891/cs-parser/internal/consumer/price_alert_consumer"
 	priceupdateconsumer "github.com/kedr891/cs-parser/internal/consumer/price_update_consumer"
+	skindiscoveredconsumer "github.com/kedr891/cs-parser/internal/consumer/skin_discovered_consumer"
+	deadletterproducer "github.com/kedr891/cs-parser/internal/producer/dead_letter_producer"
+	"github.com/kedr891/cs-parser/internal/retry"
+	pricealertprocessor "github.com/kedr891/cs-parser/internal/services/processors/price_alert_processor"
 	priceupdateprocessor "github.com/kedr891/cs-parser/internal/services/processors/price_update_processor"
+	skindiscoveredprocessor "github.com/kedr891/cs-parser/internal/services/processors/skin_discovered_processor"
 )
 
 func InitPriceUpdateConsumer(
 	cfg *config.Config,
 	processor *priceupdateprocessor.PriceUpdateProcessor,
+	deadLetters *deadletterproducer.DeadLetterProducer,
 ) *priceupdateconsumer.PriceUpdateConsumer {
 	kafkaBrokers := []string{fmt.Sprintf("%s:%d", cfg.Kafka.Host, cfg.Kafka.Port)}
+	pool := priceupdateconsumer.PoolConfig{
+		Workers:      cfg.Kafka.PriceConsumer.Workers,
+		QueueDepth:   cfg.Kafka.PriceConsumer.QueueDepth,
+		DrainTimeout: time.Duration(cfg.Kafka.PriceConsumer.DrainTimeoutSeconds) * time.Second,
+	}
 	return priceupdateconsumer.NewPriceUpdateConsumer(
 		processor,
+		deadLetters,
+		retryPolicy(cfg.Kafka.Retry),
+		pool,
 		kafkaBrokers,
 		cfg.Kafka.TopicPriceUpdated,
 		cfg.Kafka.GroupPriceConsumer,
 	)
 }
+
+func InitSkinDiscoveredConsumer(
+	cfg *config.Config,
+	processor *skindiscoveredprocessor.SkinDiscoveredProcessor,
+) *skindiscoveredconsumer.SkinDiscoveredConsumer {
+	kafkaBrokers := []string{fmt.Sprintf("%s:%d", cfg.Kafka.Host, cfg.Kafka.Port)}
+	return skindiscoveredconsumer.NewSkinDiscoveredConsumer(
+		processor,
+		kafkaBrokers,
+		cfg.Kafka.TopicSkinDiscovered,
+		cfg.Kafka.GroupSkinDiscoveredConsumer,
+	)
+}
+
+func InitPriceAlertConsumer(
+	cfg *config.Config,
+	processor *pricealertprocessor.PriceAlertProcessor,
+	deadLetters *deadletterproducer.DeadLetterProducer,
+) *pricealertconsumer.PriceAlertConsumer {
+	kafkaBrokers := []string{fmt.Sprintf("%s:%d", cfg.Kafka.Host, cfg.Kafka.Port)}
+	return pricealertconsumer.NewPriceAlertConsumer(
+		processor,
+		deadLetters,
+		retryPolicy(cfg.Kafka.Retry),
+		kafkaBrokers,
+		cfg.Kafka.TopicPriceAlert,
+		cfg.Kafka.GroupPriceAlertConsumer,
+	)
+}
+
+// InitDeadLetterConsumer читает DLQ dlqTopic; сообщения без заголовка исходного топика возвращаются в fallbackTopic.
+func InitDeadLetterConsumer(cfg *config.Config, dlqTopic, fallbackTopic string) *deadletterconsumer.DeadLetterConsumer {
+	kafkaBrokers := []string{fmt.Sprintf("%s:%d", cfg.Kafka.Host, cfg.Kafka.Port)}
+	return deadletterconsumer.NewDeadLetterConsumer(
+		kafkaBrokers,
+		dlqTopic,
+		cfg.Kafka.GroupDLQRedrive,
+		fallbackTopic,
+	)
+}
+
+func retryPolicy(cfg config.RetryConfig) retry.Policy {
+	return retry.Policy{
+		MaxAttempts:    cfg.MaxAttempts,
+		InitialBackoff: time.Duration(cfg.InitialBackoffMs) * time.Millisecond,
+		MaxBackoff:     time.Duration(cfg.MaxBackoffMs) * time.Millisecond,
+	}
+}
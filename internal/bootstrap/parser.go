@@ -0,0 +1,43 @@
+package bootstrap
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os/signal"
+	"syscall"
+
+	priceupdateproducer "github.com/kedr891/cs-parser/internal/producer/price_update_producer"
+	skindiscoveredproducer "github.com/kedr891/cs-parser/internal/producer/skin_discovered_producer"
+	parserservice "github.com/kedr891/cs-parser/internal/services/parserService"
+	"github.com/kedr891/cs-parser/internal/storage/pgstorage"
+)
+
+func ParserRun(
+	parser *parserservice.Service,
+	producer *priceupdateproducer.PriceUpdateProducer,
+	discoveredProducer *skindiscoveredproducer.SkinDiscoveredProducer,
+	storage *pgstorage.Storage,
+	log *slog.Logger,
+) {
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	log.Info("Parser started")
+
+	if err := parser.Run(ctx); err != nil && !errors.Is(err, context.Canceled) {
+		log.Error("Parser failed", "error", err)
+	}
+
+	log.Info("Shutting down parser...")
+
+	if err := producer.Close(); err != nil {
+		log.Warn("Failed to close price update producer", "error", err)
+	}
+	if err := discoveredProducer.Close(); err != nil {
+		log.Warn("Failed to close skin discovered producer", "error", err)
+	}
+	storage.Close()
+
+	log.Info("Parser stopped")
+}
@@ -0,0 +1,38 @@
+package bootstrap
+
+import (
+	"log/slog"
+
+	"github.com/kedr891/cs-parser/config"
+	market_feed_api "github.com/kedr891/cs-parser/internal/api/market_feed_api"
+	analyticsservice "github.com/kedr891/cs-parser/internal/services/analyticsService"
+	pricestreamservice "github.com/kedr891/cs-parser/internal/services/priceStreamService"
+	skinservice "github.com/kedr891/cs-parser/internal/services/skinService"
+)
+
+func InitPriceStream(cfg *config.Config, cache *skinservice.SkinsCache, log *slog.Logger) (*pricestreamservice.Hub, *pricestreamservice.RedisBroker) {
+	hub := pricestreamservice.NewHub(pricestreamservice.HubConfig{
+		HistorySize:      cfg.PriceStream.HistorySize,
+		SubscriberBuffer: cfg.PriceStream.SubscriberBuffer,
+	})
+
+	channel := cfg.PriceStream.Channel
+	if channel == "" {
+		channel = "prices:ticks"
+	}
+
+	return hub, pricestreamservice.NewRedisBroker(cache.Client(), channel, hub, log)
+}
+
+func InitTrendingWatcher(
+	cfg *config.Config,
+	analyticsService *analyticsservice.Service,
+	hub *pricestreamservice.Hub,
+	log *slog.Logger,
+) *pricestreamservice.TrendingWatcher {
+	return pricestreamservice.NewTrendingWatcher(analyticsService, hub, "24h", 10, cfg.TrendingCheckInterval(), log)
+}
+
+func InitMarketFeedAPI(cfg *config.Config, hub *pricestreamservice.Hub, log *slog.Logger) *market_feed_api.MarketFeedAPI {
+	return market_feed_api.NewMarketFeedAPI(hub, cfg.FeedHeartbeatInterval(), log)
+}
@@ -2,9 +2,28 @@ package bootstrap
 
 import (
 	analyticsservice "github.com/kedr891/cs-parser/internal/services/analyticsService"
+	notifierservice "github.com/kedr891/cs-parser/internal/services/notifierService"
+	pricestreamservice "github.com/kedr891/cs-parser/internal/services/priceStreamService"
+	pricealertprocessor "github.com/kedr891/cs-parser/internal/services/processors/price_alert_processor"
 	priceupdateprocessor "github.com/kedr891/cs-parser/internal/services/processors/price_update_processor"
+	skindiscoveredprocessor "github.com/kedr891/cs-parser/internal/services/processors/skin_discovered_processor"
+	skinservice "github.com/kedr891/cs-parser/internal/services/skinService"
 )
 
-func InitPriceUpdateProcessor(analyticsService *analyticsservice.Service) *priceupdateprocessor.PriceUpdateProcessor {
-	return priceupdateprocessor.NewPriceUpdateProcessor(analyticsService)
+func InitPriceUpdateProcessor(
+	analyticsService *analyticsservice.Service,
+	priceStreamBroker *pricestreamservice.RedisBroker,
+) *priceupdateprocessor.PriceUpdateProcessor {
+	return priceupdateprocessor.NewPriceUpdateProcessor(analyticsService, priceStreamBroker)
+}
+
+func InitSkinDiscoveredProcessor(skinService *skinservice.Service) *skindiscoveredprocessor.SkinDiscoveredProcessor {
+	return skindiscoveredprocessor.NewSkinDiscoveredProcessor(skinService)
+}
+
+func InitPriceAlertProcessor(
+	notifierService *notifierservice.Service,
+	priceStreamBroker *pricestreamservice.RedisBroker,
+) *pricealertprocessor.PriceAlertProcessor {
+	return pricealertprocessor.NewPriceAlertProcessor(notifierService, priceStreamBroker)
 }
@@ -0,0 +1,36 @@
+package bootstrap
+
+import (
+	"fmt"
+
+	"github.com/kedr891/cs-parser/config"
+	deadletterproducer "github.com/kedr891/cs-parser/internal/producer/dead_letter_producer"
+	pricealertproducer "github.com/kedr891/cs-parser/internal/producer/price_alert_producer"
+	priceupdateproducer "github.com/kedr891/cs-parser/internal/producer/price_update_producer"
+	skindiscoveredproducer "github.com/kedr891/cs-parser/internal/producer/skin_discovered_producer"
+)
+
+func InitPriceUpdateProducer(cfg *config.Config) *priceupdateproducer.PriceUpdateProducer {
+	kafkaBrokers := []string{fmt.Sprintf("%s:%d", cfg.Kafka.Host, cfg.Kafka.Port)}
+	return priceupdateproducer.NewPriceUpdateProducer(kafkaBrokers, cfg.Kafka.TopicPriceUpdated)
+}
+
+func InitSkinDiscoveredProducer(cfg *config.Config) *skindiscoveredproducer.SkinDiscoveredProducer {
+	kafkaBrokers := []string{fmt.Sprintf("%s:%d", cfg.Kafka.Host, cfg.Kafka.Port)}
+	return skindiscoveredproducer.NewSkinDiscoveredProducer(kafkaBrokers, cfg.Kafka.TopicSkinDiscovered)
+}
+
+func InitDeadLetterProducer(cfg *config.Config) *deadletterproducer.DeadLetterProducer {
+	kafkaBrokers := []string{fmt.Sprintf("%s:%d", cfg.Kafka.Host, cfg.Kafka.Port)}
+	return deadletterproducer.NewDeadLetterProducer(kafkaBrokers, cfg.Kafka.TopicPriceUpdatedDLQ)
+}
+
+func InitPriceAlertDeadLetterProducer(cfg *config.Config) *deadletterproducer.DeadLetterProducer {
+	kafkaBrokers := []string{fmt.Sprintf("%s:%d", cfg.Kafka.Host, cfg.Kafka.Port)}
+	return deadletterproducer.NewDeadLetterProducer(kafkaBrokers, cfg.Kafka.TopicPriceAlertDLQ)
+}
+
+func InitPriceAlertProducer(cfg *config.Config) *pricealertproducer.PriceAlertProducer {
+	kafkaBrokers := []string{fmt.Sprintf("%s:%d", cfg.Kafka.Host, cfg.Kafka.Port)}
+	return pricealertproducer.NewPriceAlertProducer(kafkaBrokers, cfg.Kafka.TopicPriceAlert)
+}
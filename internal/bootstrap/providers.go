@@ -0,0 +1,60 @@
+package bootstrap
+
+import (
+	"fmt"
+	"log/slog"
+
+	"github.com/kedr891/cs-parser/config"
+	"github.com/kedr891/cs-parser/internal/models"
+	"github.com/kedr891/cs-parser/internal/providers"
+	buffmarketprovider "github.com/kedr891/cs-parser/internal/providers/buff_market_provider"
+	csmoneyprovider "github.com/kedr891/cs-parser/internal/providers/csmoney_provider"
+	skinportprovider "github.com/kedr891/cs-parser/internal/providers/skinport_provider"
+	steammarketprovider "github.com/kedr891/cs-parser/internal/providers/steam_market_provider"
+	parserservice "github.com/kedr891/cs-parser/internal/services/parserService"
+)
+
+// InitPriceProviders создает адаптеры для источников, включенных в parser.providers.
+func InitPriceProviders(cfg *config.Config, log *slog.Logger) []parserservice.PriceProvider {
+	converter := providers.NewCurrencyConverter(cfg.Parser.CurrencyRates)
+
+	var result []parserservice.PriceProvider
+	for source, providerCfg := range cfg.Parser.Providers {
+		if !providerCfg.Enabled {
+			continue
+		}
+
+		provider, err := newPriceProvider(models.PriceSource(source), providerCfg, converter)
+		if err != nil {
+			panic(fmt.Sprintf("ошибка инициализации провайдера цен, %v", err))
+		}
+
+		log.Info("Price provider enabled", "source", source, "base_url", providerCfg.BaseURL)
+		result = append(result, provider)
+	}
+
+	if len(result) == 0 {
+		panic("не включен ни один провайдер цен в parser.providers")
+	}
+
+	return result
+}
+
+func newPriceProvider(
+	source models.PriceSource,
+	cfg config.ProviderConfig,
+	converter *providers.CurrencyConverter,
+) (parserservice.PriceProvider, error) {
+	switch source {
+	case models.SourceSteamMarket:
+		return steammarketprovider.NewSteamMarketProvider(cfg.BaseURL, cfg.RateLimitPerMinute, cfg.Currency, converter), nil
+	case models.SourceCSMoney:
+		return csmoneyprovider.NewCSMoneyProvider(cfg.BaseURL, cfg.RateLimitPerMinute, converter), nil
+	case models.SourceSkinport:
+		return skinportprovider.NewSkinportProvider(cfg.BaseURL, cfg.RateLimitPerMinute, cfg.Currency, converter), nil
+	case models.SourceBuffMarket:
+		return buffmarketprovider.NewBuffMarketProvider(cfg.BaseURL, cfg.RateLimitPerMinute, converter), nil
+	default:
+		return nil, fmt.Errorf("unknown price source %q", source)
+	}
+}
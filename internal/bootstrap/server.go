@@ -2,7 +2,6 @@ package bootstrap
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"log/slog"
 	"net"
@@ -15,8 +14,9 @@ import (
 
 	"github.com/go-chi/chi/v5"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	export_api "github.com/kedr891/cs-parser/internal/api/export_api"
+	market_feed_api "github.com/kedr891/cs-parser/internal/api/market_feed_api"
 	skins_service_api "github.com/kedr891/cs-parser/internal/api/skins_service_api"
-	"github.com/kedr891/cs-parser/internal/models"
 	"github.com/kedr891/cs-parser/internal/pb/skins_api"
 	pbswagger "github.com/kedr891/cs-parser/internal/pb/swagger"
 	"github.com/kedr891/cs-parser/internal/storage/pgstorage"
@@ -25,21 +25,29 @@ import (
 	"google.golang.org/grpc/credentials/insecure"
 )
 
-var globalStorage *pgstorage.Storage
-
 type ConsumerRunner interface {
 	Consume(ctx context.Context) error
 }
 
+type JobRunner interface {
+	Run(ctx context.Context) error
+}
+
 func AppRun(
 	api skins_service_api.SkinsServiceAPI,
+	feedAPI *market_feed_api.MarketFeedAPI,
+	exportAPI *export_api.ExportAPI,
 	priceUpdateConsumer ConsumerRunner,
+	skinDiscoveredConsumer ConsumerRunner,
+	priceAlertConsumer ConsumerRunner,
+	notifier JobRunner,
+	priceStatsJob JobRunner,
+	priceStreamBroker JobRunner,
+	trendingWatcher JobRunner,
 	storage *pgstorage.Storage,
 	closeCache func(),
 	log *slog.Logger,
 ) {
-	// Сохраняем storage для REST endpoints
-	globalStorage = storage
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
@@ -49,6 +57,42 @@ func AppRun(
 		}
 	}()
 
+	go func() {
+		if err := skinDiscoveredConsumer.Consume(ctx); err != nil && err != context.Canceled {
+			log.Error("Skin discovered consumer failed", "error", err)
+		}
+	}()
+
+	go func() {
+		if err := priceAlertConsumer.Consume(ctx); err != nil && err != context.Canceled {
+			log.Error("Price alert consumer failed", "error", err)
+		}
+	}()
+
+	go func() {
+		if err := notifier.Run(ctx); err != nil && err != context.Canceled {
+			log.Error("Webhook notifier failed", "error", err)
+		}
+	}()
+
+	go func() {
+		if err := priceStatsJob.Run(ctx); err != nil && err != context.Canceled {
+			log.Error("Price stats job failed", "error", err)
+		}
+	}()
+
+	go func() {
+		if err := priceStreamBroker.Run(ctx); err != nil && err != context.Canceled {
+			log.Error("Price stream broker failed", "error", err)
+		}
+	}()
+
+	go func() {
+		if err := trendingWatcher.Run(ctx); err != nil && err != context.Canceled {
+			log.Error("Trending watcher failed", "error", err)
+		}
+	}()
+
 	go func() {
 		if err := runGRPCServer(api, log); err != nil {
 			panic(fmt.Errorf("failed to run gRPC server: %v", err))
@@ -56,7 +100,7 @@ func AppRun(
 	}()
 
 	go func() {
-		if err := runGatewayServer(log); err != nil {
+		if err := runGatewayServer(feedAPI, exportAPI, log); err != nil {
 			panic(fmt.Errorf("failed to run gateway server: %v", err))
 		}
 	}()
@@ -91,7 +135,7 @@ func runGRPCServer(api skins_service_api.SkinsServiceAPI, log *slog.Logger) erro
 	return s.Serve(lis)
 }
 
-func runGatewayServer(log *slog.Logger) error {
+func runGatewayServer(feedAPI *market_feed_api.MarketFeedAPI, exportAPI *export_api.ExportAPI, log *slog.Logger) error {
 	ctx := context.Background()
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
@@ -132,6 +176,10 @@ func runGatewayServer(log *slog.Logger) error {
 		http.Redirect(w, r, target, http.StatusMovedPermanently)
 	})
 
+	// SSE, WebSocket и файловые выгрузки не проходят через grpc-gateway, поэтому регистрируются на роутере напрямую
+	feedAPI.Routes(r)
+	exportAPI.Routes(r)
+
 	mux := runtime.NewServeMux()
 	opts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
 
@@ -140,79 +188,8 @@ func runGatewayServer(log *slog.Logger) error {
 		return err
 	}
 
-	// REST endpoint для создания скина
-	r.Post("/api/v1/skins", handleCreateSkin)
-
 	r.Mount("/", mux)
 
 	log.Info("gRPC-Gateway server listening on :8080")
 	return http.ListenAndServe(":8080", r)
 }
-
-// handleCreateSkin - REST endpoint для создания скина
-func handleCreateSkin(w http.ResponseWriter, r *http.Request) {
-	var req struct {
-		MarketHashName string  `json:"market_hash_name"` // Опционально, генерируется автоматически
-		Name           string  `json:"name"`             // Обязательно
-		Weapon         string  `json:"weapon"`           // Обязательно
-		Quality        string  `json:"quality"`          // Обязательно
-		Rarity         string  `json:"rarity"`
-		CurrentPrice   float64 `json:"current_price"`
-		Currency       string  `json:"currency"`
-		ImageURL       string  `json:"image_url"`
-	}
-
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		http.Error(w, fmt.Sprintf("Invalid request: %v", err), http.StatusBadRequest)
-		return
-	}
-
-	// Валидация обязательных полей
-	if req.Name == "" || req.Weapon == "" || req.Quality == "" {
-		http.Error(w, "Missing required fields: name, weapon, quality", http.StatusBadRequest)
-		return
-	}
-
-	// Создаем новый скин (marketHashName генерируется автоматически, если не указан)
-	skin := models.NewSkin(
-		req.MarketHashName, // Если пустой, будет сгенерирован автоматически
-		req.Name,
-		req.Weapon,
-		req.Quality,
-	)
-
-	skin.Rarity = req.Rarity
-	skin.CurrentPrice = req.CurrentPrice
-	skin.Currency = req.Currency
-	if req.Currency == "" {
-		skin.Currency = "USD"
-	}
-	skin.ImageURL = req.ImageURL
-
-	// Сохраняем в БД
-	if err := globalStorage.CreateSkin(r.Context(), skin); err != nil {
-		http.Error(w, fmt.Sprintf("Failed to create skin: %v", err), http.StatusInternalServerError)
-		return
-	}
-
-	// Возвращаем результат
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(http.StatusCreated)
-	json.NewEncoder(w).Encode(map[string]interface{}{
-		"message": "Skin created successfully",
-		"skin": map[string]interface{}{
-			"id":               skin.ID.String(),
-			"slug":             skin.Slug,
-			"market_hash_name": skin.MarketHashName,
-			"name":             skin.Name,
-			"weapon":           skin.Weapon,
-			"quality":          skin.Quality,
-			"rarity":           skin.Rarity,
-			"current_price":    skin.CurrentPrice,
-			"currency":         skin.Currency,
-			"image_url":        skin.ImageURL,
-			"created_at":       skin.CreatedAt,
-			"updated_at":       skin.UpdatedAt,
-		},
-	})
-}
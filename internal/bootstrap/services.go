@@ -2,12 +2,21 @@ package bootstrap
 
 import (
 	"log/slog"
+	"net/http"
 	"os"
+	"time"
 
 	"github.com/kedr891/cs-parser/config"
+	pricealertproducer "github.com/kedr891/cs-parser/internal/producer/price_alert_producer"
+	priceupdateproducer "github.com/kedr891/cs-parser/internal/producer/price_update_producer"
+	skindiscoveredproducer "github.com/kedr891/cs-parser/internal/producer/skin_discovered_producer"
 	analyticsservice "github.com/kedr891/cs-parser/internal/services/analyticsService"
+	exportservice "github.com/kedr891/cs-parser/internal/services/exportService"
+	notifierservice "github.com/kedr891/cs-parser/internal/services/notifierService"
+	parserservice "github.com/kedr891/cs-parser/internal/services/parserService"
 	skinservice "github.com/kedr891/cs-parser/internal/services/skinService"
 	"github.com/kedr891/cs-parser/internal/storage/pgstorage"
+	redisstorage "github.com/kedr891/cs-parser/internal/storage/redis"
 )
 
 func InitLogger(cfg *config.Config) *slog.Logger {
@@ -17,12 +26,64 @@ func InitLogger(cfg *config.Config) *slog.Logger {
 }
 
 func InitSkinService(storage *pgstorage.Storage, cache *skinservice.SkinsCache, log *slog.Logger) *skinservice.Service {
-	return skinservice.New(storage, cache, log)
+	autocomplete := skinservice.NewAutocompleteIndex(redisstorage.FromClient(cache.Client()))
+	return skinservice.New(storage, cache, log).WithAutocomplete(autocomplete)
 }
 
 func InitAnalyticsService(
 	storage *pgstorage.Storage,
+	cache *skinservice.SkinsCache,
+	alertProducer *pricealertproducer.PriceAlertProducer,
 	log *slog.Logger,
 ) *analyticsservice.Service {
-	return analyticsservice.New(storage, nil, nil, log)
+	analyticsCache := analyticsservice.NewAnalyticsCache(cache.Client())
+	autocomplete := skinservice.NewAutocompleteIndex(redisstorage.FromClient(cache.Client()))
+	return analyticsservice.New(storage, analyticsCache, analyticsCache, analyticsCache, alertProducer, log).
+		WithAutocomplete(autocomplete)
+}
+
+func InitParserService(
+	cfg *config.Config,
+	storage *pgstorage.Storage,
+	providers []parserservice.PriceProvider,
+	producer *priceupdateproducer.PriceUpdateProducer,
+	discoveredProducer *skindiscoveredproducer.SkinDiscoveredProducer,
+	log *slog.Logger,
+) *parserservice.Service {
+	service := parserservice.New(storage, providers, producer, cfg.ParserInterval(), log)
+
+	if !cfg.Parser.Discovery.Enabled {
+		return service
+	}
+
+	// Выдачу умеет отдавать только Steam Market; используем тот же адаптер, чтобы лимит запросов был общим.
+	for _, provider := range providers {
+		if listings, ok := provider.(parserservice.ListingSource); ok {
+			return service.WithDiscovery(listings, discoveredProducer, cfg.Parser.Discovery.Pages)
+		}
+	}
+
+	log.Warn("Discovery enabled but no provider supports market listings")
+	return service
+}
+
+func InitPriceStatsJob(cfg *config.Config, analyticsService *analyticsservice.Service) *analyticsservice.PriceStatsJob {
+	return analyticsservice.NewPriceStatsJob(analyticsService, cfg.StatsRecomputeInterval())
+}
+
+func InitNotifierService(cfg *config.Config, storage *pgstorage.Storage, log *slog.Logger) *notifierservice.Service {
+	var httpClient *http.Client
+	if cfg.Notifier.TimeoutSeconds > 0 {
+		httpClient = &http.Client{Timeout: time.Duration(cfg.Notifier.TimeoutSeconds) * time.Second}
+	}
+	queue := notifierservice.QueueConfig{
+		Depth:        cfg.Notifier.QueueDepth,
+		PollInterval: time.Duration(cfg.Notifier.PollIntervalSeconds) * time.Second,
+		IdleTimeout:  time.Duration(cfg.Notifier.WorkerIdleSeconds) * time.Second,
+	}
+	return notifierservice.New(storage, httpClient, retryPolicy(cfg.Notifier.Retry), queue, log)
+}
+
+func InitExportService(storage *pgstorage.Storage, log *slog.Logger) *exportservice.Service {
+	return exportservice.New(storage, log)
 }
@@ -1,11 +1,27 @@
 package bootstrap
 
 import (
+	"log/slog"
+
+	export_api "github.com/kedr891/cs-parser/internal/api/export_api"
 	skins_service_api "github.com/kedr891/cs-parser/internal/api/skins_service_api"
 	analyticsservice "github.com/kedr891/cs-parser/internal/services/analyticsService"
+	exportservice "github.com/kedr891/cs-parser/internal/services/exportService"
+	notifierservice "github.com/kedr891/cs-parser/internal/services/notifierService"
+	pricestreamservice "github.com/kedr891/cs-parser/internal/services/priceStreamService"
 	skinservice "github.com/kedr891/cs-parser/internal/services/skinService"
 )
 
-func InitSkinsServiceAPI(skinService *skinservice.Service, analyticsService *analyticsservice.Service) *skins_service_api.SkinsServiceAPI {
-	return skins_service_api.NewSkinsServiceAPI(skinService, analyticsService)
+func InitSkinsServiceAPI(
+	skinService *skinservice.Service,
+	analyticsService *analyticsservice.Service,
+	notifierService *notifierservice.Service,
+	priceStreamHub *pricestreamservice.Hub,
+	exportService *exportservice.Service,
+) *skins_service_api.SkinsServiceAPI {
+	return skins_service_api.NewSkinsServiceAPI(skinService, analyticsService, notifierService, priceStreamHub, exportService)
+}
+
+func InitExportAPI(exportService *exportservice.Service, log *slog.Logger) *export_api.ExportAPI {
+	return export_api.NewExportAPI(exportService, log)
 }
@@ -0,0 +1,26 @@
+package deadletterconsumer
+
+import (
+	"time"
+)
+
+const _defaultIdleTimeout = 10 * time.Second
+
+// DeadLetterConsumer вычитывает DLQ и возвращает сообщения в исходный топик.
+type DeadLetterConsumer struct {
+	kafkaBroker   []string
+	topicName     string
+	groupID       string
+	fallbackTopic string
+	idleTimeout   time.Duration
+}
+
+func NewDeadLetterConsumer(kafkaBroker []string, topicName, groupID, fallbackTopic string) *DeadLetterConsumer {
+	return &DeadLetterConsumer{
+		kafkaBroker:   kafkaBroker,
+		topicName:     topicName,
+		groupID:       groupID,
+		fallbackTopic: fallbackTopic,
+		idleTimeout:   _defaultIdleTimeout,
+	}
+}
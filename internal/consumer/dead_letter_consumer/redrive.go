@@ -0,0 +1,86 @@
+package deadletterconsumer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	deadletterproducer "github.com/kedr891/cs-parser/internal/producer/dead_letter_producer"
+	"github.com/segmentio/kafka-go"
+)
+
+// Redrive переотправляет до limit сообщений из DLQ в топик из заголовка x-dlq-original-topic
+// (или в fallbackTopic). limit <= 0 - без ограничения. Останавливается, когда
+// в DLQ нет новых сообщений дольше idleTimeout. Оффсет коммитится только после успешной записи.
+func (c *DeadLetterConsumer) Redrive(ctx context.Context, limit int) (int, error) {
+	r := kafka.NewReader(kafka.ReaderConfig{
+		Brokers:           c.kafkaBroker,
+		GroupID:           c.groupID,
+		Topic:             c.topicName,
+		HeartbeatInterval: 3 * time.Second,
+		SessionTimeout:    30 * time.Second,
+	})
+	defer r.Close()
+
+	w := &kafka.Writer{
+		Addr:         kafka.TCP(c.kafkaBroker...),
+		Balancer:     &kafka.Hash{},
+		RequiredAcks: kafka.RequireAll,
+	}
+	defer w.Close()
+
+	slog.Info("DLQ redrive started", "topic", c.topicName, "group", c.groupID, "limit", limit)
+
+	redriven := 0
+	for limit <= 0 || redriven < limit {
+		fetchCtx, cancel := context.WithTimeout(ctx, c.idleTimeout)
+		msg, err := r.FetchMessage(fetchCtx)
+		cancel()
+		if err != nil {
+			if ctx.Err() != nil {
+				return redriven, ctx.Err()
+			}
+			if errors.Is(err, context.DeadlineExceeded) {
+				break
+			}
+			return redriven, fmt.Errorf("fetch dead letter: %w", err)
+		}
+
+		if err := w.WriteMessages(ctx, redriveMessage(msg, c.fallbackTopic)); err != nil {
+			return redriven, fmt.Errorf("redrive message offset %d: %w", msg.Offset, err)
+		}
+		if err := r.CommitMessages(ctx, msg); err != nil {
+			return redriven, fmt.Errorf("commit dead letter offset %d: %w", msg.Offset, err)
+		}
+		redriven++
+	}
+
+	slog.Info("DLQ redrive finished", "redriven", redriven)
+	return redriven, nil
+}
+
+func redriveMessage(msg kafka.Message, fallbackTopic string) kafka.Message {
+	topic := fallbackTopic
+	headers := make([]kafka.Header, 0, len(msg.Headers))
+	for _, h := range msg.Headers {
+		switch h.Key {
+		case deadletterproducer.HeaderOriginalTopic:
+			if len(h.Value) > 0 {
+				topic = string(h.Value)
+			}
+			continue
+		case deadletterproducer.HeaderError, deadletterproducer.HeaderAttempts:
+			continue
+		}
+		headers = append(headers, h)
+	}
+
+	return kafka.Message{
+		Topic:   topic,
+		Key:     msg.Key,
+		Value:   msg.Value,
+		Headers: headers,
+	}
+}
@@ -0,0 +1,37 @@
+package deadletterconsumer
+
+import (
+	"testing"
+
+	deadletterproducer "github.com/kedr891/cs-parser/internal/producer/dead_letter_producer"
+	"github.com/segmentio/kafka-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRedriveMessage_UsesOriginalTopicAndDropsDLQHeaders(t *testing.T) {
+	msg := kafka.Message{
+		Topic: "skin.price.updated.dlq",
+		Key:   []byte("key"),
+		Value: []byte(`{"skin_id":"1"}`),
+		Headers: []kafka.Header{
+			{Key: "trace-id", Value: []byte("abc")},
+			{Key: deadletterproducer.HeaderError, Value: []byte("connection refused")},
+			{Key: deadletterproducer.HeaderAttempts, Value: []byte("5")},
+			{Key: deadletterproducer.HeaderOriginalTopic, Value: []byte("skin.price.updated")},
+		},
+	}
+
+	out := redriveMessage(msg, "fallback")
+
+	assert.Equal(t, "skin.price.updated", out.Topic)
+	assert.Equal(t, msg.Key, out.Key)
+	assert.Equal(t, msg.Value, out.Value)
+	assert.Equal(t, []kafka.Header{{Key: "trace-id", Value: []byte("abc")}}, out.Headers)
+}
+
+func TestRedriveMessage_FallsBackWithoutOriginalTopic(t *testing.T) {
+	out := redriveMessage(kafka.Message{Value: []byte("{}")}, "skin.price.updated")
+
+	assert.Equal(t, "skin.price.updated", out.Topic)
+	assert.Empty(t, out.Headers)
+}
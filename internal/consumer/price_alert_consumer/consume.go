@@ -0,0 +1,86 @@
+package pricealertconsumer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/kedr891/cs-parser/internal/models"
+	"github.com/segmentio/kafka-go"
+)
+
+// Consume обрабатывает уведомления с гарантией at-least-once: оффсет коммитится только после
+// того, как доставки на webhook-и сохранены в журнал в статусе pending или событие записано в DLQ.
+// Сама отправка с ее повторами идет в воркерах notifierService и не задерживает чтение топика;
+// неотправленные доставки воркеры забирают из журнала и после перезапуска.
+func (c *PriceAlertConsumer) Consume(ctx context.Context) error {
+	r := c.newReader()
+	defer r.Close()
+
+	slog.Info("PriceAlertConsumer started", "topic", c.topicName, "group", c.groupID)
+
+	for {
+		msg, err := r.FetchMessage(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				slog.Info("PriceAlertConsumer stopped")
+				return ctx.Err()
+			}
+			slog.Error("PriceAlertConsumer.Consume error", "error", err.Error())
+			continue
+		}
+
+		if err := c.processMessage(ctx, msg); err != nil {
+			// Обработка прервана остановкой: сообщение не коммитится и будет доставлено повторно.
+			slog.Info("PriceAlertConsumer stopped")
+			return err
+		}
+
+		commitCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), _commitTimeout)
+		if err := r.CommitMessages(commitCtx, msg); err != nil {
+			slog.Error("Failed to commit price alert offset", "error", err, "offset", msg.Offset)
+		}
+		cancel()
+	}
+}
+
+// processMessage обрабатывает сообщение с повторами. Сообщения, которые не удалось
+// декодировать или обработать за retry.MaxAttempts попыток, уходят в DLQ.
+// Ошибка возвращается только при остановке контекста.
+func (c *PriceAlertConsumer) processMessage(ctx context.Context, msg kafka.Message) error {
+	var event models.PriceAlertEvent
+	if err := json.Unmarshal(msg.Value, &event); err != nil {
+		slog.Error("Failed to unmarshal price alert event", "error", err)
+		return c.retry.SendToDeadLetter(ctx, c.deadLetters, msg, fmt.Errorf("unmarshal price alert event: %w", err), 0)
+	}
+
+	attempts, err := c.handleWithRetry(ctx, &event)
+	if err == nil {
+		return nil
+	}
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+
+	slog.Error("Failed to deliver price alert",
+		"error", err,
+		"alert_id", event.AlertID,
+		"attempts", attempts,
+	)
+	return c.retry.SendToDeadLetter(ctx, c.deadLetters, msg, err, attempts)
+}
+
+func (c *PriceAlertConsumer) handleWithRetry(ctx context.Context, event *models.PriceAlertEvent) (int, error) {
+	return c.retry.Do(ctx, func(int) error {
+		return c.processor.Handle(ctx, event)
+	}, func(attempt int, delay time.Duration, err error) {
+		slog.Warn("Retrying price alert",
+			"alert_id", event.AlertID,
+			"attempt", attempt,
+			"backoff", delay,
+			"error", err,
+		)
+	})
+}
@@ -0,0 +1,69 @@
+package pricealertconsumer
+
+import (
+	"context"
+	"time"
+
+	"github.com/kedr891/cs-parser/internal/models"
+	"github.com/kedr891/cs-parser/internal/retry"
+	"github.com/segmentio/kafka-go"
+)
+
+const (
+	_defaultInitialBackoff = 100 * time.Millisecond
+	_commitTimeout         = 10 * time.Second
+)
+
+type priceAlertProcessor interface {
+	Handle(ctx context.Context, event *models.PriceAlertEvent) error
+}
+
+type messageReader interface {
+	FetchMessage(ctx context.Context) (kafka.Message, error)
+	CommitMessages(ctx context.Context, msgs ...kafka.Message) error
+	Close() error
+}
+
+type PriceAlertConsumer struct {
+	processor   priceAlertProcessor
+	deadLetters retry.DeadLetterPublisher
+	retry       retry.Policy
+	kafkaBroker []string
+	topicName   string
+	groupID     string
+	newReader   func() messageReader
+}
+
+func NewPriceAlertConsumer(
+	processor priceAlertProcessor,
+	deadLetters retry.DeadLetterPublisher,
+	policy retry.Policy,
+	kafkaBroker []string,
+	topicName string,
+	groupID string,
+) *PriceAlertConsumer {
+
+	c := &PriceAlertConsumer{
+		processor:   processor,
+		deadLetters: deadLetters,
+		retry:       policy.WithDefaults(_defaultInitialBackoff),
+		kafkaBroker: kafkaBroker,
+		topicName:   topicName,
+		groupID:     groupID,
+	}
+	c.newReader = c.kafkaReader
+
+	return c
+}
+
+// kafkaReader создает reader без автокоммита: оффсеты коммитятся явно через CommitMessages.
+func (c *PriceAlertConsumer) kafkaReader() messageReader {
+	return kafka.NewReader(kafka.ReaderConfig{
+		Brokers:           c.kafkaBroker,
+		GroupID:           c.groupID,
+		Topic:             c.topicName,
+		HeartbeatInterval: 3 * time.Second,
+		SessionTimeout:    30 * time.Second,
+		CommitInterval:    0,
+	})
+}
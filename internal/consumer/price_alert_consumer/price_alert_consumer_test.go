@@ -0,0 +1,140 @@
+package pricealertconsumer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kedr891/cs-parser/internal/models"
+	"github.com/kedr891/cs-parser/internal/retry"
+	"github.com/segmentio/kafka-go"
+	"github.com/stretchr/testify/suite"
+)
+
+type fakeProcessor struct {
+	calls    int
+	failures int
+}
+
+func (p *fakeProcessor) Handle(ctx context.Context, event *models.PriceAlertEvent) error {
+	p.calls++
+	if p.calls <= p.failures {
+		return errors.New("list active webhooks: connection refused")
+	}
+	return nil
+}
+
+type fakeDeadLetters struct {
+	letters []kafka.Message
+}
+
+func (d *fakeDeadLetters) PublishDeadLetter(ctx context.Context, msg kafka.Message, cause error, attempts int) error {
+	d.letters = append(d.letters, msg)
+	return nil
+}
+
+// fakeReader отдает сообщения по очереди, затем отменяет контекст.
+type fakeReader struct {
+	messages  []kafka.Message
+	committed []kafka.Message
+	cancel    context.CancelFunc
+}
+
+func (r *fakeReader) FetchMessage(ctx context.Context) (kafka.Message, error) {
+	if len(r.messages) == 0 {
+		r.cancel()
+		<-ctx.Done()
+		return kafka.Message{}, ctx.Err()
+	}
+	msg := r.messages[0]
+	r.messages = r.messages[1:]
+	return msg, nil
+}
+
+func (r *fakeReader) CommitMessages(ctx context.Context, msgs ...kafka.Message) error {
+	r.committed = append(r.committed, msgs...)
+	return nil
+}
+
+func (r *fakeReader) Close() error {
+	return nil
+}
+
+type PriceAlertConsumerSuite struct {
+	suite.Suite
+	ctx         context.Context
+	processor   *fakeProcessor
+	deadLetters *fakeDeadLetters
+	consumer    *PriceAlertConsumer
+	msg         kafka.Message
+}
+
+func (suite *PriceAlertConsumerSuite) SetupTest() {
+	suite.ctx = context.Background()
+	suite.processor = &fakeProcessor{}
+	suite.deadLetters = &fakeDeadLetters{}
+	suite.consumer = NewPriceAlertConsumer(
+		suite.processor,
+		suite.deadLetters,
+		retry.Policy{MaxAttempts: 3, InitialBackoff: time.Millisecond, MaxBackoff: 2 * time.Millisecond},
+		nil,
+		"notification.price_alert",
+		"test-group",
+	)
+
+	data, err := json.Marshal(&models.PriceAlertEvent{AlertID: uuid.New(), Slug: "awp_asiimov_ft", Price: 95})
+	suite.Require().NoError(err)
+	suite.msg = kafka.Message{Topic: "notification.price_alert", Value: data}
+}
+
+func TestPriceAlertConsumerSuite(t *testing.T) {
+	suite.Run(t, new(PriceAlertConsumerSuite))
+}
+
+func (suite *PriceAlertConsumerSuite) TestConsume_RetriesThenCommits() {
+	suite.processor.failures = 2
+	ctx, cancel := context.WithCancel(suite.ctx)
+	defer cancel()
+
+	reader := &fakeReader{messages: []kafka.Message{suite.msg}, cancel: cancel}
+	suite.consumer.newReader = func() messageReader { return reader }
+
+	err := suite.consumer.Consume(ctx)
+
+	suite.ErrorIs(err, context.Canceled)
+	suite.Equal(3, suite.processor.calls)
+	suite.Len(reader.committed, 1)
+	suite.Empty(suite.deadLetters.letters)
+}
+
+func (suite *PriceAlertConsumerSuite) TestConsume_CommitsOnlyAfterDeadLetter() {
+	suite.processor.failures = 10
+	ctx, cancel := context.WithCancel(suite.ctx)
+	defer cancel()
+
+	reader := &fakeReader{messages: []kafka.Message{suite.msg}, cancel: cancel}
+	suite.consumer.newReader = func() messageReader { return reader }
+
+	err := suite.consumer.Consume(ctx)
+
+	suite.ErrorIs(err, context.Canceled)
+	suite.Equal(3, suite.processor.calls)
+	suite.Require().Len(suite.deadLetters.letters, 1)
+	suite.Equal(suite.msg.Value, suite.deadLetters.letters[0].Value)
+	suite.Len(reader.committed, 1)
+}
+
+func (suite *PriceAlertConsumerSuite) TestProcessMessage_StopsOnContextCancel() {
+	suite.processor.failures = 10
+	suite.consumer.retry.InitialBackoff = time.Hour
+	ctx, cancel := context.WithCancel(suite.ctx)
+	cancel()
+
+	err := suite.consumer.processMessage(ctx, suite.msg)
+
+	suite.ErrorIs(err, context.Canceled)
+	suite.Empty(suite.deadLetters.letters)
+}
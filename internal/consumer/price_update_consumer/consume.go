@@ -3,51 +3,205 @@ package priceupdateconsumer
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"hash/fnv"
 	"log/slog"
+	"strconv"
+	"sync"
 	"time"
 
 	"github.com/kedr891/cs-parser/internal/models"
 	"github.com/segmentio/kafka-go"
 )
 
+// Consume обрабатывает сообщения пулом воркеров с гарантией at-least-once: оффсет
+// партиции коммитится только до первого сообщения, которое еще не обработано и не записано в DLQ.
+// При отмене ctx новые сообщения не читаются, а уже полученные дорабатываются в течение pool.DrainTimeout.
 func (c *PriceUpdateConsumer) Consume(ctx context.Context) error {
-	r := kafka.NewReader(kafka.ReaderConfig{
-		Brokers:           c.kafkaBroker,
-		GroupID:           c.groupID,
-		Topic:             c.topicName,
-		HeartbeatInterval: 3 * time.Second,
-		SessionTimeout:    30 * time.Second,
-	})
+	r := c.newReader()
 	defer r.Close()
 
-	slog.Info("PriceUpdateConsumer started", "topic", c.topicName, "group", c.groupID)
+	// Обработка не прерывается вместе с ctx, чтобы воркеры успели доработать очередь.
+	processCtx, cancelProcess := context.WithCancel(context.WithoutCancel(ctx))
+	defer cancelProcess()
+
+	tracker := newOffsetTracker()
+	done := make(chan kafka.Message, c.pool.Workers*c.pool.QueueDepth)
+	queues := make([]chan kafka.Message, c.pool.Workers)
+
+	var workers sync.WaitGroup
+	for i := range queues {
+		queues[i] = make(chan kafka.Message, c.pool.QueueDepth)
+		workers.Add(1)
+		go func(queue <-chan kafka.Message) {
+			defer workers.Done()
+			c.runWorker(processCtx, queue, done)
+		}(queues[i])
+	}
+
+	committerDone := make(chan struct{})
+	go func() {
+		defer close(committerDone)
+		c.commitLoop(r, tracker, done)
+	}()
+
+	slog.Info("PriceUpdateConsumer started",
+		"topic", c.topicName,
+		"group", c.groupID,
+		"workers", c.pool.Workers,
+	)
+
+	err := c.dispatch(ctx, r, tracker, queues)
+
+	slog.Info("PriceUpdateConsumer draining")
+	for _, queue := range queues {
+		close(queue)
+	}
+
+	drained := make(chan struct{})
+	go func() {
+		workers.Wait()
+		close(drained)
+	}()
+
+	select {
+	case <-drained:
+	case <-time.After(c.pool.DrainTimeout):
+		slog.Warn("PriceUpdateConsumer drain timeout, in-flight messages will be redelivered")
+		cancelProcess()
+		<-drained
+	}
+
+	close(done)
+	<-committerDone
 
+	slog.Info("PriceUpdateConsumer stopped")
+	return err
+}
+
+// dispatch читает сообщения и раскладывает их по очередям воркеров по ключу.
+func (c *PriceUpdateConsumer) dispatch(ctx context.Context, r messageReader, tracker *offsetTracker, queues []chan kafka.Message) error {
 	for {
+		msg, err := r.FetchMessage(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			slog.Error("PriceUpdateConsumer.Consume error", "error", err.Error())
+			continue
+		}
+
+		tracker.track(msg)
+
 		select {
+		case queues[workerIndex(msg, len(queues))] <- msg:
 		case <-ctx.Done():
-			slog.Info("PriceUpdateConsumer stopped")
 			return ctx.Err()
-		default:
-			msg, err := r.ReadMessage(ctx)
-			if err != nil {
-				if err == context.Canceled {
-					return err
+		}
+	}
+}
+
+func (c *PriceUpdateConsumer) runWorker(ctx context.Context, queue <-chan kafka.Message, done chan<- kafka.Message) {
+	for msg := range queue {
+		if err := c.processMessage(ctx, msg); err != nil {
+			// Обработка прервана: сообщение не коммитится и будет доставлено повторно.
+			continue
+		}
+		done <- msg
+	}
+}
+
+// commitLoop коммитит непрерывные префиксы обработанных оффсетов, объединяя
+// накопившиеся подтверждения в один CommitMessages.
+func (c *PriceUpdateConsumer) commitLoop(r messageReader, tracker *offsetTracker, done <-chan kafka.Message) {
+	for msg := range done {
+		ready := make(map[int]kafka.Message)
+		if last, ok := tracker.markDone(msg); ok {
+			ready[last.Partition] = last
+		}
+
+	collect:
+		for {
+			select {
+			case next, ok := <-done:
+				if !ok {
+					break collect
+				}
+				if last, ok := tracker.markDone(next); ok {
+					ready[last.Partition] = last
 				}
-				slog.Error("PriceUpdateConsumer.Consume error", "error", err.Error())
-				continue
+			default:
+				break collect
 			}
+		}
 
-			var event models.PriceUpdateEvent
-			err = json.Unmarshal(msg.Value, &event)
-			if err != nil {
-				slog.Error("Failed to unmarshal price update event", "error", err)
-				continue
-			}
+		if len(ready) == 0 {
+			continue
+		}
 
-			err = c.processor.Handle(ctx, &event)
-			if err != nil {
-				slog.Error("Failed to handle price update", "error", err, "skin_id", event.SkinID)
-			}
+		msgs := make([]kafka.Message, 0, len(ready))
+		for _, m := range ready {
+			msgs = append(msgs, m)
+		}
+
+		commitCtx, cancel := context.WithTimeout(context.Background(), _commitTimeout)
+		if err := r.CommitMessages(commitCtx, msgs...); err != nil {
+			slog.Error("Failed to commit price update offsets", "error", err, "partitions", len(msgs))
 		}
+		cancel()
+	}
+}
+
+func workerIndex(msg kafka.Message, workers int) int {
+	if workers == 1 {
+		return 0
 	}
+
+	h := fnv.New32a()
+	if len(msg.Key) > 0 {
+		h.Write(msg.Key)
+	} else {
+		h.Write([]byte(strconv.Itoa(msg.Partition)))
+	}
+
+	return int(h.Sum32() % uint32(workers))
+}
+
+// processMessage обрабатывает сообщение с повторами. Сообщения, которые не удалось
+// декодировать или обработать за retry.MaxAttempts попыток, уходят в DLQ.
+// Ошибка возвращается только при остановке контекста.
+func (c *PriceUpdateConsumer) processMessage(ctx context.Context, msg kafka.Message) error {
+	var event models.PriceUpdateEvent
+	if err := json.Unmarshal(msg.Value, &event); err != nil {
+		slog.Error("Failed to unmarshal price update event", "error", err)
+		return c.retry.SendToDeadLetter(ctx, c.deadLetters, msg, fmt.Errorf("unmarshal price update event: %w", err), 0)
+	}
+
+	attempts, err := c.handleWithRetry(ctx, &event)
+	if err == nil {
+		return nil
+	}
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+
+	slog.Error("Failed to handle price update",
+		"error", err,
+		"skin_id", event.SkinID,
+		"attempts", attempts,
+	)
+	return c.retry.SendToDeadLetter(ctx, c.deadLetters, msg, err, attempts)
+}
+
+func (c *PriceUpdateConsumer) handleWithRetry(ctx context.Context, event *models.PriceUpdateEvent) (int, error) {
+	return c.retry.Do(ctx, func(int) error {
+		return c.processor.Handle(ctx, event)
+	}, func(attempt int, delay time.Duration, err error) {
+		slog.Warn("Retrying price update",
+			"skin_id", event.SkinID,
+			"attempt", attempt,
+			"backoff", delay,
+			"error", err,
+		)
+	})
 }
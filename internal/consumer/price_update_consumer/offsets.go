@@ -0,0 +1,72 @@
+package priceupdateconsumer
+
+import (
+	"sync"
+
+	"github.com/segmentio/kafka-go"
+)
+
+// offsetTracker отслеживает обработку сообщений по партициям. Воркеры завершают
+// сообщения не по порядку, поэтому коммитить можно только непрерывный префикс
+// обработанных оффсетов партиции.
+type offsetTracker struct {
+	mu         sync.Mutex
+	partitions map[int]*partitionOffsets
+}
+
+type partitionOffsets struct {
+	pending []int64
+	done    map[int64]kafka.Message
+}
+
+func newOffsetTracker() *offsetTracker {
+	return &offsetTracker{partitions: make(map[int]*partitionOffsets)}
+}
+
+// track регистрирует полученное сообщение. Вызывается в порядке FetchMessage.
+func (t *offsetTracker) track(msg kafka.Message) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	p, ok := t.partitions[msg.Partition]
+	if !ok {
+		p = &partitionOffsets{done: make(map[int64]kafka.Message)}
+		t.partitions[msg.Partition] = p
+	}
+	// Оффсет не больше уже полученного - партиция переназначена после ребаланса,
+	// прежние незавершенные сообщения будут доставлены повторно.
+	if n := len(p.pending); n > 0 && msg.Offset <= p.pending[n-1] {
+		p.pending = p.pending[:0]
+		p.done = make(map[int64]kafka.Message)
+	}
+	p.pending = append(p.pending, msg.Offset)
+}
+
+// markDone отмечает сообщение обработанным и возвращает последнее сообщение
+// непрерывного обработанного префикса партиции, если префикс сдвинулся.
+func (t *offsetTracker) markDone(msg kafka.Message) (kafka.Message, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	p, ok := t.partitions[msg.Partition]
+	if !ok {
+		return kafka.Message{}, false
+	}
+	p.done[msg.Offset] = msg
+
+	var (
+		last     kafka.Message
+		advanced bool
+	)
+	for len(p.pending) > 0 {
+		m, ok := p.done[p.pending[0]]
+		if !ok {
+			break
+		}
+		delete(p.done, p.pending[0])
+		p.pending = p.pending[1:]
+		last, advanced = m, true
+	}
+
+	return last, advanced
+}
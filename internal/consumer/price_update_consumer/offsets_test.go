@@ -0,0 +1,56 @@
+package priceupdateconsumer
+
+import (
+	"testing"
+
+	"github.com/segmentio/kafka-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOffsetTracker_CommitsContiguousPrefixOnly(t *testing.T) {
+	tracker := newOffsetTracker()
+	msgs := []kafka.Message{
+		{Partition: 0, Offset: 10},
+		{Partition: 0, Offset: 11},
+		{Partition: 0, Offset: 13},
+		{Partition: 1, Offset: 5},
+	}
+	for _, msg := range msgs {
+		tracker.track(msg)
+	}
+
+	_, ok := tracker.markDone(msgs[1])
+	assert.False(t, ok, "offset 10 is still in flight")
+
+	last, ok := tracker.markDone(msgs[3])
+	assert.True(t, ok)
+	assert.Equal(t, int64(5), last.Offset)
+
+	last, ok = tracker.markDone(msgs[0])
+	assert.True(t, ok)
+	assert.Equal(t, int64(11), last.Offset)
+
+	last, ok = tracker.markDone(msgs[2])
+	assert.True(t, ok)
+	assert.Equal(t, int64(13), last.Offset)
+}
+
+func TestOffsetTracker_IgnoresUntrackedPartition(t *testing.T) {
+	tracker := newOffsetTracker()
+
+	_, ok := tracker.markDone(kafka.Message{Partition: 2, Offset: 1})
+
+	assert.False(t, ok)
+}
+
+func TestOffsetTracker_ResetsPartitionOnRedelivery(t *testing.T) {
+	tracker := newOffsetTracker()
+	tracker.track(kafka.Message{Partition: 0, Offset: 7})
+	tracker.track(kafka.Message{Partition: 0, Offset: 8})
+
+	tracker.track(kafka.Message{Partition: 0, Offset: 7})
+	last, ok := tracker.markDone(kafka.Message{Partition: 0, Offset: 7})
+
+	assert.True(t, ok)
+	assert.Equal(t, int64(7), last.Offset)
+}
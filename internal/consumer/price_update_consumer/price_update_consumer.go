@@ -2,31 +2,90 @@ package priceupdateconsumer
 
 import (
 	"context"
+	"time"
 
 	"github.com/kedr891/cs-parser/internal/models"
+	"github.com/kedr891/cs-parser/internal/retry"
+	"github.com/segmentio/kafka-go"
+)
+
+const (
+	_defaultInitialBackoff = 100 * time.Millisecond
+	_defaultQueueDepth     = 100
+	_defaultDrainTimeout   = 10 * time.Second
+	_commitTimeout         = 10 * time.Second
 )
 
 type priceUpdateProcessor interface {
 	Handle(ctx context.Context, event *models.PriceUpdateEvent) error
 }
 
+type messageReader interface {
+	FetchMessage(ctx context.Context) (kafka.Message, error)
+	CommitMessages(ctx context.Context, msgs ...kafka.Message) error
+	Close() error
+}
+
+// PoolConfig задает пул воркеров: события одного скина (ключ сообщения) всегда
+// попадают в одного воркера и обрабатываются по порядку.
+type PoolConfig struct {
+	Workers      int
+	QueueDepth   int
+	DrainTimeout time.Duration
+}
+
 type PriceUpdateConsumer struct {
 	processor   priceUpdateProcessor
+	deadLetters retry.DeadLetterPublisher
+	retry       retry.Policy
+	pool        PoolConfig
 	kafkaBroker []string
 	topicName   string
 	groupID     string
+	newReader   func() messageReader
 }
 
 func NewPriceUpdateConsumer(
 	processor priceUpdateProcessor,
+	deadLetters retry.DeadLetterPublisher,
+	policy retry.Policy,
+	pool PoolConfig,
 	kafkaBroker []string,
 	topicName string,
 	groupID string,
 ) *PriceUpdateConsumer {
-	return &PriceUpdateConsumer{
+	if pool.Workers <= 0 {
+		pool.Workers = 1
+	}
+	if pool.QueueDepth <= 0 {
+		pool.QueueDepth = _defaultQueueDepth
+	}
+	if pool.DrainTimeout <= 0 {
+		pool.DrainTimeout = _defaultDrainTimeout
+	}
+
+	c := &PriceUpdateConsumer{
 		processor:   processor,
+		deadLetters: deadLetters,
+		retry:       policy.WithDefaults(_defaultInitialBackoff),
+		pool:        pool,
 		kafkaBroker: kafkaBroker,
 		topicName:   topicName,
 		groupID:     groupID,
 	}
+	c.newReader = c.kafkaReader
+
+	return c
+}
+
+// kafkaReader создает reader без автокоммита: оффсеты коммитятся явно через CommitMessages.
+func (c *PriceUpdateConsumer) kafkaReader() messageReader {
+	return kafka.NewReader(kafka.ReaderConfig{
+		Brokers:           c.kafkaBroker,
+		GroupID:           c.groupID,
+		Topic:             c.topicName,
+		HeartbeatInterval: 3 * time.Second,
+		SessionTimeout:    30 * time.Second,
+		CommitInterval:    0,
+	})
 }
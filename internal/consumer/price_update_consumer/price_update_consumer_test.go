@@ -0,0 +1,258 @@
+package priceupdateconsumer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kedr891/cs-parser/internal/models"
+	"github.com/kedr891/cs-parser/internal/retry"
+	"github.com/segmentio/kafka-go"
+	"github.com/stretchr/testify/suite"
+)
+
+type fakeProcessor struct {
+	mu       sync.Mutex
+	calls    int
+	failures int
+	onCall   func()
+	handled  map[uuid.UUID][]float64
+}
+
+func (p *fakeProcessor) Handle(ctx context.Context, event *models.PriceUpdateEvent) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.calls++
+	if p.onCall != nil {
+		p.onCall()
+	}
+	if p.calls <= p.failures {
+		return errors.New("connection refused")
+	}
+	if p.handled == nil {
+		p.handled = make(map[uuid.UUID][]float64)
+	}
+	p.handled[event.SkinID] = append(p.handled[event.SkinID], event.NewPrice)
+	return nil
+}
+
+type deadLetter struct {
+	msg      kafka.Message
+	cause    error
+	attempts int
+}
+
+type fakeDeadLetters struct {
+	letters  []deadLetter
+	failures int
+	calls    int
+}
+
+func (d *fakeDeadLetters) PublishDeadLetter(ctx context.Context, msg kafka.Message, cause error, attempts int) error {
+	d.calls++
+	if d.calls <= d.failures {
+		return errors.New("broker not available")
+	}
+	d.letters = append(d.letters, deadLetter{msg: msg, cause: cause, attempts: attempts})
+	return nil
+}
+
+// fakeReader отдает сообщения по очереди, затем отменяет контекст.
+type fakeReader struct {
+	messages  []kafka.Message
+	committed []kafka.Message
+	cancel    context.CancelFunc
+}
+
+func (r *fakeReader) FetchMessage(ctx context.Context) (kafka.Message, error) {
+	if len(r.messages) == 0 {
+		r.cancel()
+		<-ctx.Done()
+		return kafka.Message{}, ctx.Err()
+	}
+	msg := r.messages[0]
+	r.messages = r.messages[1:]
+	return msg, nil
+}
+
+func (r *fakeReader) CommitMessages(ctx context.Context, msgs ...kafka.Message) error {
+	r.committed = append(r.committed, msgs...)
+	return nil
+}
+
+func (r *fakeReader) Close() error {
+	return nil
+}
+
+type PriceUpdateConsumerSuite struct {
+	suite.Suite
+	ctx         context.Context
+	processor   *fakeProcessor
+	deadLetters *fakeDeadLetters
+	consumer    *PriceUpdateConsumer
+	msg         kafka.Message
+}
+
+func (suite *PriceUpdateConsumerSuite) SetupTest() {
+	suite.ctx = context.Background()
+	suite.processor = &fakeProcessor{}
+	suite.deadLetters = &fakeDeadLetters{}
+	suite.consumer = NewPriceUpdateConsumer(
+		suite.processor,
+		suite.deadLetters,
+		retry.Policy{MaxAttempts: 3, InitialBackoff: time.Millisecond, MaxBackoff: 2 * time.Millisecond},
+		PoolConfig{Workers: 1, QueueDepth: 10, DrainTimeout: time.Second},
+		nil,
+		"skin.price.updated",
+		"test-group",
+	)
+
+	event := models.NewPriceUpdateEvent(uuid.New(), "awp_asiimov_ft", "AWP | Asiimov (Field-Tested)", "steam_market", 100, 110, 5)
+	data, err := json.Marshal(event)
+	suite.Require().NoError(err)
+	suite.msg = kafka.Message{Topic: "skin.price.updated", Key: []byte(event.SkinID.String()), Value: data}
+}
+
+func TestPriceUpdateConsumerSuite(t *testing.T) {
+	suite.Run(t, new(PriceUpdateConsumerSuite))
+}
+
+func (suite *PriceUpdateConsumerSuite) TestProcessMessage_RetriesTransientErrors() {
+	suite.processor.failures = 2
+
+	err := suite.consumer.processMessage(suite.ctx, suite.msg)
+
+	suite.NoError(err)
+	suite.Equal(3, suite.processor.calls)
+	suite.Empty(suite.deadLetters.letters)
+}
+
+func (suite *PriceUpdateConsumerSuite) TestProcessMessage_SendsToDeadLetterAfterMaxAttempts() {
+	suite.processor.failures = 10
+
+	err := suite.consumer.processMessage(suite.ctx, suite.msg)
+
+	suite.NoError(err)
+	suite.Equal(3, suite.processor.calls)
+	suite.Require().Len(suite.deadLetters.letters, 1)
+	suite.Equal(3, suite.deadLetters.letters[0].attempts)
+	suite.Equal(suite.msg.Value, suite.deadLetters.letters[0].msg.Value)
+	suite.Contains(suite.deadLetters.letters[0].cause.Error(), "connection refused")
+}
+
+func (suite *PriceUpdateConsumerSuite) TestProcessMessage_UndecodableMessageGoesToDeadLetter() {
+	suite.msg.Value = []byte("{not json")
+
+	err := suite.consumer.processMessage(suite.ctx, suite.msg)
+
+	suite.NoError(err)
+	suite.Zero(suite.processor.calls)
+	suite.Require().Len(suite.deadLetters.letters, 1)
+	suite.Equal(0, suite.deadLetters.letters[0].attempts)
+	suite.Contains(suite.deadLetters.letters[0].cause.Error(), "unmarshal")
+}
+
+func (suite *PriceUpdateConsumerSuite) TestProcessMessage_StopsOnContextCancel() {
+	suite.processor.failures = 10
+	suite.consumer.retry.InitialBackoff = time.Hour
+	ctx, cancel := context.WithCancel(suite.ctx)
+	cancel()
+
+	err := suite.consumer.processMessage(ctx, suite.msg)
+
+	suite.ErrorIs(err, context.Canceled)
+	suite.Empty(suite.deadLetters.letters)
+}
+
+func (suite *PriceUpdateConsumerSuite) TestProcessMessage_RetriesDeadLetterPublish() {
+	suite.msg.Value = []byte("{not json")
+	suite.deadLetters.failures = 2
+
+	err := suite.consumer.processMessage(suite.ctx, suite.msg)
+
+	suite.NoError(err)
+	suite.Equal(3, suite.deadLetters.calls)
+	suite.Len(suite.deadLetters.letters, 1)
+}
+
+func (suite *PriceUpdateConsumerSuite) TestConsume_CommitsAfterHandling() {
+	ctx, cancel := context.WithCancel(suite.ctx)
+	defer cancel()
+
+	second := suite.msg
+	second.Offset = 1
+	reader := &fakeReader{messages: []kafka.Message{suite.msg, second}, cancel: cancel}
+	suite.consumer.newReader = func() messageReader { return reader }
+
+	err := suite.consumer.Consume(ctx)
+
+	suite.ErrorIs(err, context.Canceled)
+	suite.Equal(2, suite.processor.calls)
+	suite.Require().NotEmpty(reader.committed)
+	suite.Equal(int64(1), reader.committed[len(reader.committed)-1].Offset)
+}
+
+func (suite *PriceUpdateConsumerSuite) TestConsume_DoesNotCommitInterruptedMessage() {
+	ctx, cancel := context.WithCancel(suite.ctx)
+	defer cancel()
+
+	suite.processor.failures = 10
+	suite.consumer.retry.InitialBackoff = time.Hour
+	reader := &fakeReader{messages: []kafka.Message{suite.msg}, cancel: cancel}
+	suite.consumer.newReader = func() messageReader { return reader }
+	suite.processor.onCall = cancel
+	suite.consumer.pool.DrainTimeout = 10 * time.Millisecond
+
+	err := suite.consumer.Consume(ctx)
+
+	suite.ErrorIs(err, context.Canceled)
+	suite.Empty(reader.committed)
+	suite.Empty(suite.deadLetters.letters)
+}
+
+func (suite *PriceUpdateConsumerSuite) TestConsume_KeepsPerSkinOrderAcrossWorkers() {
+	ctx, cancel := context.WithCancel(suite.ctx)
+	defer cancel()
+
+	suite.consumer.pool.Workers = 4
+	skins := []uuid.UUID{uuid.New(), uuid.New(), uuid.New()}
+
+	var messages []kafka.Message
+	for i := 0; i < 30; i++ {
+		skinID := skins[i%len(skins)]
+		event := models.NewPriceUpdateEvent(skinID, "slug", "name", "steam_market", 1, float64(i), 1)
+		data, err := json.Marshal(event)
+		suite.Require().NoError(err)
+		messages = append(messages, kafka.Message{
+			Partition: i % 2,
+			Offset:    int64(i / 2),
+			Key:       []byte(skinID.String()),
+			Value:     data,
+		})
+	}
+	reader := &fakeReader{messages: messages, cancel: cancel}
+	suite.consumer.newReader = func() messageReader { return reader }
+
+	err := suite.consumer.Consume(ctx)
+
+	suite.ErrorIs(err, context.Canceled)
+	suite.Equal(30, suite.processor.calls)
+	for i, skinID := range skins {
+		var expected []float64
+		for j := i; j < 30; j += len(skins) {
+			expected = append(expected, float64(j))
+		}
+		suite.Equal(expected, suite.processor.handled[skinID])
+	}
+
+	lastCommitted := make(map[int]int64)
+	for _, msg := range reader.committed {
+		lastCommitted[msg.Partition] = msg.Offset
+	}
+	suite.Equal(map[int]int64{0: 14, 1: 14}, lastCommitted)
+}
@@ -0,0 +1,53 @@
+package skindiscoveredconsumer
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"time"
+
+	"github.com/kedr891/cs-parser/internal/models"
+	"github.com/segmentio/kafka-go"
+)
+
+func (c *SkinDiscoveredConsumer) Consume(ctx context.Context) error {
+	r := kafka.NewReader(kafka.ReaderConfig{
+		Brokers:           c.kafkaBroker,
+		GroupID:           c.groupID,
+		Topic:             c.topicName,
+		HeartbeatInterval: 3 * time.Second,
+		SessionTimeout:    30 * time.Second,
+	})
+	defer r.Close()
+
+	slog.Info("SkinDiscoveredConsumer started", "topic", c.topicName, "group", c.groupID)
+
+	for {
+		select {
+		case <-ctx.Done():
+			slog.Info("SkinDiscoveredConsumer stopped")
+			return ctx.Err()
+		default:
+			msg, err := r.ReadMessage(ctx)
+			if err != nil {
+				if err == context.Canceled {
+					return err
+				}
+				slog.Error("SkinDiscoveredConsumer.Consume error", "error", err.Error())
+				continue
+			}
+
+			var event models.SkinDiscoveredEvent
+			err = json.Unmarshal(msg.Value, &event)
+			if err != nil {
+				slog.Error("Failed to unmarshal skin discovered event", "error", err)
+				continue
+			}
+
+			err = c.processor.Handle(ctx, &event)
+			if err != nil {
+				slog.Error("Failed to handle skin discovered", "error", err, "market_hash_name", event.MarketHashName)
+			}
+		}
+	}
+}
@@ -0,0 +1,32 @@
+package skindiscoveredconsumer
+
+import (
+	"context"
+
+	"github.com/kedr891/cs-parser/internal/models"
+)
+
+type skinDiscoveredProcessor interface {
+	Handle(ctx context.Context, event *models.SkinDiscoveredEvent) error
+}
+
+type SkinDiscoveredConsumer struct {
+	processor   skinDiscoveredProcessor
+	kafkaBroker []string
+	topicName   string
+	groupID     string
+}
+
+func NewSkinDiscoveredConsumer(
+	processor skinDiscoveredProcessor,
+	kafkaBroker []string,
+	topicName string,
+	groupID string,
+) *SkinDiscoveredConsumer {
+	return &SkinDiscoveredConsumer{
+		processor:   processor,
+		kafkaBroker: kafkaBroker,
+		topicName:   topicName,
+		groupID:     groupID,
+	}
+}
@@ -0,0 +1,99 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	AlertConditionAbove         = "above"
+	AlertConditionBelow         = "below"
+	AlertConditionChangePercent = "change_percent"
+)
+
+var (
+	ErrInvalidAlert  = errors.New("invalid alert")
+	ErrAlertNotFound = errors.New("alert not found")
+)
+
+// PriceAlert - правило уведомления о цене скина на площадке Source. Для условия change_percent
+// TargetPrice задает порог изменения в процентах относительно BasePrice -
+// цены скина на этой площадке на момент создания правила.
+type PriceAlert struct {
+	ID              uuid.UUID  `json:"id" db:"id"`
+	SkinID          uuid.UUID  `json:"skin_id" db:"skin_id"`
+	Slug            string     `json:"slug" db:"slug"`
+	Weapon          string     `json:"weapon" db:"weapon"`
+	Condition       string     `json:"condition" db:"condition"`
+	Source          string     `json:"source" db:"source"`
+	TargetPrice     float64    `json:"target_price" db:"target_price"`
+	BasePrice       float64    `json:"base_price" db:"base_price"`
+	CurrentPrice    float64    `json:"current_price" db:"-"`
+	Triggered       bool       `json:"triggered" db:"triggered"`
+	LastTriggeredAt *time.Time `json:"last_triggered_at,omitempty" db:"last_triggered_at"`
+	CreatedAt       time.Time  `json:"created_at" db:"created_at"`
+}
+
+func (a *PriceAlert) Validate() error {
+	switch a.Condition {
+	case AlertConditionAbove, AlertConditionBelow, AlertConditionChangePercent:
+	default:
+		return fmt.Errorf("%w: unknown condition %q", ErrInvalidAlert, a.Condition)
+	}
+	if !PriceSource(a.Source).IsValid() {
+		return fmt.Errorf("%w: unknown source %q", ErrInvalidAlert, a.Source)
+	}
+	if a.TargetPrice <= 0 {
+		return fmt.Errorf("%w: target must be positive", ErrInvalidAlert)
+	}
+	return nil
+}
+
+func (a *PriceAlert) ShouldTrigger() bool {
+	switch a.Condition {
+	case AlertConditionBelow:
+		return a.CurrentPrice <= a.TargetPrice
+	case AlertConditionAbove:
+		return a.CurrentPrice >= a.TargetPrice
+	case AlertConditionChangePercent:
+		if a.BasePrice <= 0 {
+			return false
+		}
+		return math.Abs(a.CurrentPrice-a.BasePrice)/a.BasePrice*100 >= a.TargetPrice
+	default:
+		return false
+	}
+}
+
+// PriceAlertEvent публикуется в notification.price_alert при срабатывании правила.
+type PriceAlertEvent struct {
+	AlertID        uuid.UUID `json:"alert_id"`
+	SkinID         uuid.UUID `json:"skin_id"`
+	Slug           string    `json:"slug"`
+	MarketHashName string    `json:"market_hash_name"`
+	Condition      string    `json:"condition"`
+	TargetPrice    float64   `json:"target_price"`
+	BasePrice      float64   `json:"base_price"`
+	Price          float64   `json:"price"`
+	Source         string    `json:"source"`
+	TriggeredAt    time.Time `json:"triggered_at"`
+}
+
+func NewPriceAlertEvent(alert *PriceAlert, event *PriceUpdateEvent) *PriceAlertEvent {
+	return &PriceAlertEvent{
+		AlertID:        alert.ID,
+		SkinID:         alert.SkinID,
+		Slug:           alert.Slug,
+		MarketHashName: event.MarketHashName,
+		Condition:      alert.Condition,
+		TargetPrice:    alert.TargetPrice,
+		BasePrice:      alert.BasePrice,
+		Price:          event.NewPrice,
+		Source:         event.Source,
+		TriggeredAt:    time.Now(),
+	}
+}
@@ -0,0 +1,76 @@
+package models
+
+import (
+	"errors"
+	"strings"
+)
+
+// ErrAutocompleteUnavailable - индекс подсказок не подключен.
+var ErrAutocompleteUnavailable = errors.New("autocomplete index is not configured")
+
+const (
+	DefaultAutocompleteLimit = 10
+	MaxAutocompleteLimit     = 50
+
+	// AutocompletePrefixMaxLen - самый длинный префикс слова в индексе; более длинные слова запроса обрезаются до него.
+	AutocompletePrefixMaxLen = 20
+	// AutocompleteViewWeight - сколько продаж за сутки весит один просмотр карточки скина.
+	AutocompleteViewWeight = 5
+)
+
+type AutocompleteSuggestion struct {
+	Slug           string  `json:"slug"`
+	MarketHashName string  `json:"market_hash_name"`
+	Weapon         string  `json:"weapon"`
+	ImageURL       string  `json:"image_url"`
+	Score          float64 `json:"score"`
+}
+
+func NewAutocompleteSuggestion(skin *Skin) AutocompleteSuggestion {
+	return AutocompleteSuggestion{
+		Slug:           skin.Slug,
+		MarketHashName: skin.MarketHashName,
+		Weapon:         skin.Weapon,
+		ImageURL:       skin.ImageURL,
+	}
+}
+
+// AutocompleteScore - вес подсказки: продажи за сутки плюс просмотры карточки.
+func AutocompleteScore(volume24h int, views int64) float64 {
+	return float64(volume24h) + float64(views)*AutocompleteViewWeight
+}
+
+// AutocompleteTerms разбивает ввод на слова так же, как строится slug, и обрезает их до AutocompletePrefixMaxLen.
+func AutocompleteTerms(query string) []string {
+	words := strings.Split(GenerateSlug(query), "_")
+
+	terms := make([]string, 0, len(words))
+	seen := make(map[string]struct{}, len(words))
+	for _, word := range words {
+		if len(word) > AutocompletePrefixMaxLen {
+			word = word[:AutocompletePrefixMaxLen]
+		}
+		if _, ok := seen[word]; ok || word == "" {
+			continue
+		}
+		seen[word] = struct{}{}
+		terms = append(terms, word)
+	}
+	return terms
+}
+
+// AutocompletePrefixes - все префиксы слов slug, под которыми скин попадает в индекс подсказок.
+func AutocompletePrefixes(slug string) []string {
+	var prefixes []string
+	seen := make(map[string]struct{})
+	for _, word := range AutocompleteTerms(slug) {
+		for n := 1; n <= len(word); n++ {
+			if _, ok := seen[word[:n]]; ok {
+				continue
+			}
+			seen[word[:n]] = struct{}{}
+			prefixes = append(prefixes, word[:n])
+		}
+	}
+	return prefixes
+}
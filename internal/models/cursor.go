@@ -0,0 +1,113 @@
+package models
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var ErrInvalidPageToken = errors.New("invalid page token")
+
+// SkinCursorTimeLayout - формат времени в курсоре, совпадает с точностью TIMESTAMP в PostgreSQL.
+const SkinCursorTimeLayout = "2006-01-02 15:04:05.999999"
+
+// SortKey возвращает нормализованный ключ сортировки (price, volume, name, updated, created, weapon)
+// и направление. Неизвестный ключ сортирует по updated, направление по умолчанию - по убыванию.
+func (f *SkinFilter) SortKey() (sortBy string, desc bool) {
+	switch f.SortBy {
+	case "price", "volume", "name", "updated", "created", "weapon":
+		sortBy = f.SortBy
+	default:
+		sortBy = "updated"
+	}
+	return sortBy, !strings.EqualFold(f.SortOrder, "ASC")
+}
+
+// SkinCursor - позиция в выдаче GetSkins: значение ключа сортировки и id последнего отданного скина.
+// Курсор привязан к сортировке, с которой был получен.
+type SkinCursor struct {
+	SortBy string    `json:"s"`
+	Desc   bool      `json:"d"`
+	Value  string    `json:"v"`
+	ID     uuid.UUID `json:"id"`
+}
+
+func NewSkinCursor(filter *SkinFilter, skin *Skin) *SkinCursor {
+	sortBy, desc := filter.SortKey()
+	return &SkinCursor{
+		SortBy: sortBy,
+		Desc:   desc,
+		Value:  SkinSortValue(skin, sortBy),
+		ID:     skin.ID,
+	}
+}
+
+func (c *SkinCursor) Encode() string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// DecodeSkinCursor разбирает filter.PageToken. Возвращает nil, если токен не задан.
+func DecodeSkinCursor(filter *SkinFilter) (*SkinCursor, error) {
+	if filter.PageToken == "" {
+		return nil, nil
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(filter.PageToken)
+	if err != nil {
+		return nil, ErrInvalidPageToken
+	}
+
+	var cursor SkinCursor
+	if err := json.Unmarshal(data, &cursor); err != nil || cursor.ID == uuid.Nil {
+		return nil, ErrInvalidPageToken
+	}
+
+	sortBy, desc := filter.SortKey()
+	if cursor.SortBy != sortBy || cursor.Desc != desc {
+		return nil, fmt.Errorf("%w: sort_by and sort_order must match the previous page", ErrInvalidPageToken)
+	}
+
+	if err := validateSortValue(sortBy, cursor.Value); err != nil {
+		return nil, ErrInvalidPageToken
+	}
+
+	return &cursor, nil
+}
+
+// SkinSortValue - значение ключа сортировки скина в виде, пригодном для сравнения в SQL.
+func SkinSortValue(skin *Skin, sortBy string) string {
+	switch sortBy {
+	case "price":
+		return strconv.FormatFloat(skin.CurrentPrice, 'f', -1, 64)
+	case "volume":
+		return strconv.Itoa(skin.Volume24h)
+	case "name":
+		return skin.Name
+	case "weapon":
+		return skin.Weapon
+	case "created":
+		return skin.CreatedAt.UTC().Format(SkinCursorTimeLayout)
+	default:
+		return skin.UpdatedAt.UTC().Format(SkinCursorTimeLayout)
+	}
+}
+
+func validateSortValue(sortBy, value string) error {
+	var err error
+	switch sortBy {
+	case "price":
+		_, err = strconv.ParseFloat(value, 64)
+	case "volume":
+		_, err = strconv.Atoi(value)
+	case "updated", "created":
+		_, err = time.Parse(SkinCursorTimeLayout, value)
+	}
+	return err
+}
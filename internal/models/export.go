@@ -0,0 +1,118 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var ErrInvalidExport = errors.New("invalid export request")
+
+type ExportDataset string
+
+const (
+	ExportSkins        ExportDataset = "skins"
+	ExportPriceHistory ExportDataset = "price_history"
+)
+
+type ExportFormat string
+
+const (
+	ExportCSV     ExportFormat = "csv"
+	ExportNDJSON  ExportFormat = "ndjson"
+	ExportParquet ExportFormat = "parquet"
+)
+
+// Extension - расширение файла выгрузки.
+func (f ExportFormat) Extension() string {
+	return string(f)
+}
+
+func (f ExportFormat) ContentType() string {
+	switch f {
+	case ExportCSV:
+		return "text/csv; charset=utf-8"
+	case ExportNDJSON:
+		return "application/x-ndjson"
+	default:
+		return "application/vnd.apache.parquet"
+	}
+}
+
+// ExportFilter - что выгружать. Weapon и временной диапазон применяются к обоим наборам
+// (для каталога диапазон - по updated_at), Source - только к истории цен.
+type ExportFilter struct {
+	Dataset ExportDataset
+	Format  ExportFormat
+	Weapon  string
+	Source  string
+	From    time.Time
+	To      time.Time
+}
+
+// NewExportFilter собирает фильтр из строковых параметров API и CLI. from и to - RFC3339,
+// формат по умолчанию - CSV.
+func NewExportFilter(dataset, format, weapon, source, from, to string) (*ExportFilter, error) {
+	filter := &ExportFilter{
+		Dataset: ExportDataset(dataset),
+		Format:  ExportFormat(format),
+		Weapon:  weapon,
+		Source:  source,
+	}
+	if filter.Format == "" {
+		filter.Format = ExportCSV
+	}
+
+	var err error
+	if from != "" {
+		if filter.From, err = time.Parse(time.RFC3339, from); err != nil {
+			return nil, fmt.Errorf("%w: from must be an RFC3339 timestamp", ErrInvalidExport)
+		}
+	}
+	if to != "" {
+		if filter.To, err = time.Parse(time.RFC3339, to); err != nil {
+			return nil, fmt.Errorf("%w: to must be an RFC3339 timestamp", ErrInvalidExport)
+		}
+	}
+
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+	return filter, nil
+}
+
+func (f *ExportFilter) Validate() error {
+	switch f.Dataset {
+	case ExportSkins, ExportPriceHistory:
+	default:
+		return fmt.Errorf("%w: dataset must be skins or price_history", ErrInvalidExport)
+	}
+	switch f.Format {
+	case ExportCSV, ExportNDJSON, ExportParquet:
+	default:
+		return fmt.Errorf("%w: format must be csv, ndjson or parquet", ErrInvalidExport)
+	}
+	if f.Source != "" && f.Dataset != ExportPriceHistory {
+		return fmt.Errorf("%w: source filter applies only to price_history", ErrInvalidExport)
+	}
+	if !f.From.IsZero() && !f.To.IsZero() && !f.From.Before(f.To) {
+		return fmt.Errorf("%w: from must be before to", ErrInvalidExport)
+	}
+	return nil
+}
+
+// PriceHistoryExport - точка истории цен вместе с данными скина, чтобы выгрузку
+// можно было анализировать без отдельного join с каталогом.
+type PriceHistoryExport struct {
+	SkinID         uuid.UUID `json:"skin_id"`
+	Slug           string    `json:"slug"`
+	MarketHashName string    `json:"market_hash_name"`
+	Weapon         string    `json:"weapon"`
+	Price          float64   `json:"price"`
+	Currency       string    `json:"currency"`
+	Source         string    `json:"source"`
+	Volume         int       `json:"volume"`
+	RecordedAt     time.Time `json:"recorded_at"`
+}
@@ -0,0 +1,132 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+)
+
+var ErrInvalidPriceBuckets = errors.New("invalid price buckets")
+
+const (
+	FacetWeapon  = "weapon"
+	FacetQuality = "quality"
+	FacetRarity  = "rarity"
+	FacetPrice   = "price"
+
+	MaxPriceBuckets = 20
+)
+
+// DefaultPriceBuckets - границы ценовых диапазонов по умолчанию: [0, 1), [1, 5), ..., [1000, +inf).
+var DefaultPriceBuckets = []float64{1, 5, 10, 50, 100, 500, 1000}
+
+// SkinFacetRow - число скинов с одним значением фасета на одном шарде.
+// Для фасета price Value - номер диапазона из width_bucket.
+type SkinFacetRow struct {
+	Facet string
+	Value string
+	Count int
+}
+
+type FacetCount struct {
+	Value string `json:"value"`
+	Count int    `json:"count"`
+}
+
+// PriceBucketCount - диапазон [Min, Max); Max = 0 у последнего, открытого сверху диапазона.
+type PriceBucketCount struct {
+	Min   float64 `json:"min"`
+	Max   float64 `json:"max"`
+	Count int     `json:"count"`
+}
+
+type SkinFacets struct {
+	Weapons      []FacetCount       `json:"weapons"`
+	Qualities    []FacetCount       `json:"qualities"`
+	Rarities     []FacetCount       `json:"rarities"`
+	Categories   []FacetCount       `json:"categories"`
+	PriceBuckets []PriceBucketCount `json:"price_buckets"`
+}
+
+// NormalizePriceBuckets подставляет границы по умолчанию и проверяет, что они положительные и строго возрастают.
+func NormalizePriceBuckets(bounds []float64) ([]float64, error) {
+	if len(bounds) == 0 {
+		return DefaultPriceBuckets, nil
+	}
+	if len(bounds) > MaxPriceBuckets {
+		return nil, fmt.Errorf("%w: at most %d bounds allowed", ErrInvalidPriceBuckets, MaxPriceBuckets)
+	}
+	for i, bound := range bounds {
+		if bound <= 0 {
+			return nil, fmt.Errorf("%w: bound %v must be positive", ErrInvalidPriceBuckets, bound)
+		}
+		if i > 0 && bound <= bounds[i-1] {
+			return nil, fmt.Errorf("%w: bounds must be strictly ascending", ErrInvalidPriceBuckets)
+		}
+	}
+	return bounds, nil
+}
+
+// NewSkinFacets складывает счетчики шардов. Категории считаются по оружию,
+// ценовые диапазоны возвращаются все, включая пустые, чтобы набор не зависел от фильтра.
+func NewSkinFacets(rows []SkinFacetRow, bounds []float64) *SkinFacets {
+	weapons := make(map[string]int)
+	qualities := make(map[string]int)
+	rarities := make(map[string]int)
+	categories := make(map[string]int)
+	buckets := make([]PriceBucketCount, len(bounds)+1)
+
+	for i := range buckets {
+		if i > 0 {
+			buckets[i].Min = bounds[i-1]
+		}
+		if i < len(bounds) {
+			buckets[i].Max = bounds[i]
+		}
+	}
+
+	for _, row := range rows {
+		switch row.Facet {
+		case FacetWeapon:
+			weapons[row.Value] += row.Count
+			categories[string(WeaponCategory(row.Value))] += row.Count
+		case FacetQuality:
+			qualities[row.Value] += row.Count
+		case FacetRarity:
+			value := row.Value
+			if value == "" {
+				value = UnknownRarity
+			}
+			rarities[value] += row.Count
+		case FacetPrice:
+			// цены меньше первой границы попадают в диапазон 0, как и в width_bucket
+			if bucket, err := strconv.Atoi(row.Value); err == nil && bucket >= 0 && bucket < len(buckets) {
+				buckets[bucket].Count += row.Count
+			}
+		}
+	}
+
+	return &SkinFacets{
+		Weapons:      facetCounts(weapons),
+		Qualities:    facetCounts(qualities),
+		Rarities:     facetCounts(rarities),
+		Categories:   facetCounts(categories),
+		PriceBuckets: buckets,
+	}
+}
+
+// facetCounts сортирует значения по убыванию числа скинов, затем по значению.
+func facetCounts(counts map[string]int) []FacetCount {
+	result := make([]FacetCount, 0, len(counts))
+	for value, count := range counts {
+		result = append(result, FacetCount{Value: value, Count: count})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Count != result[j].Count {
+			return result[i].Count > result[j].Count
+		}
+		return result[i].Value < result[j].Value
+	})
+	return result
+}
@@ -0,0 +1,139 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+var (
+	ErrInvalidFeedFilter = errors.New("invalid feed filter")
+	// ErrSlowSubscriber - подписчик не успевал читать поток и был отключен.
+	// Клиент может переподключиться с номером последнего полученного события.
+	ErrSlowSubscriber = errors.New("subscriber is too slow")
+)
+
+const (
+	FeedEventPrice    = "price"
+	FeedEventAlert    = "alert"
+	FeedEventTrending = "trending"
+)
+
+// FeedEvent - событие живой ленты рынка: обновление цены, сработавшее правило или новый список трендов.
+// Seq - номер события в ленте, его назначает RedisBroker при публикации; у трендов номера нет.
+type FeedEvent struct {
+	Seq       uint64            `json:"seq,omitempty"`
+	Type      string            `json:"type"`
+	Timestamp time.Time         `json:"timestamp"`
+	Price     *PriceUpdateEvent `json:"price,omitempty"`
+	Alert     *PriceAlertEvent  `json:"alert,omitempty"`
+	Trending  []Skin            `json:"trending,omitempty"`
+}
+
+func NewPriceFeedEvent(event *PriceUpdateEvent) *FeedEvent {
+	return &FeedEvent{Type: FeedEventPrice, Timestamp: event.Timestamp, Price: event}
+}
+
+func NewAlertFeedEvent(event *PriceAlertEvent) *FeedEvent {
+	return &FeedEvent{Type: FeedEventAlert, Timestamp: event.TriggeredAt, Alert: event}
+}
+
+func NewTrendingFeedEvent(skins []Skin) *FeedEvent {
+	return &FeedEvent{Type: FeedEventTrending, Timestamp: time.Now(), Trending: skins}
+}
+
+// Valid проверяет, что у события есть данные его типа.
+func (e *FeedEvent) Valid() bool {
+	switch e.Type {
+	case FeedEventPrice:
+		return e.Price != nil
+	case FeedEventAlert:
+		return e.Alert != nil
+	case FeedEventTrending:
+		return true
+	default:
+		return false
+	}
+}
+
+// ID - идентификатор события для Last-Event-ID: номер Seq, общий для всех реплик.
+// Событие без номера (тренды) идентификатора не имеет, чтобы не сбрасывать Last-Event-ID клиента.
+func (e *FeedEvent) ID() string {
+	if e.Seq == 0 {
+		return ""
+	}
+	return strconv.FormatUint(e.Seq, 10)
+}
+
+func ParseFeedEventID(id string) (uint64, error) {
+	seq, err := strconv.ParseUint(id, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w: bad event id %q", ErrInvalidFeedFilter, id)
+	}
+	return seq, nil
+}
+
+// FeedFilter - на какие события подписан клиент. Цены и правила фильтруются по slug или оружию,
+// трендам фильтр по скинам не нужен.
+type FeedFilter struct {
+	Types   map[string]bool
+	All     bool
+	Slugs   map[string]bool
+	Weapons map[string]bool
+}
+
+func NewFeedFilter(types []string, all bool, slugs, weapons []string) (*FeedFilter, error) {
+	if len(types) == 0 {
+		return nil, fmt.Errorf("%w: specify event types", ErrInvalidFeedFilter)
+	}
+	if !all && len(slugs) == 0 && len(weapons) == 0 {
+		return nil, fmt.Errorf("%w: specify slugs, weapons or all", ErrInvalidFeedFilter)
+	}
+
+	filter := &FeedFilter{
+		Types:   make(map[string]bool, len(types)),
+		All:     all,
+		Slugs:   make(map[string]bool, len(slugs)),
+		Weapons: make(map[string]bool, len(weapons)),
+	}
+	for _, t := range types {
+		switch t {
+		case FeedEventPrice, FeedEventAlert, FeedEventTrending:
+			filter.Types[t] = true
+		default:
+			return nil, fmt.Errorf("%w: unknown event type %q", ErrInvalidFeedFilter, t)
+		}
+	}
+	for _, slug := range slugs {
+		filter.Slugs[slug] = true
+	}
+	for _, weapon := range weapons {
+		filter.Weapons[weapon] = true
+	}
+
+	return filter, nil
+}
+
+func (f *FeedFilter) Match(event *FeedEvent) bool {
+	if !f.Types[event.Type] {
+		return false
+	}
+
+	switch event.Type {
+	case FeedEventPrice:
+		return f.matchSkin(event.Price.Slug, event.Price.Weapon)
+	case FeedEventAlert:
+		weapon, _, _ := ParseMarketHashName(event.Alert.MarketHashName)
+		return f.matchSkin(event.Alert.Slug, weapon)
+	default:
+		return true
+	}
+}
+
+func (f *FeedFilter) matchSkin(slug, weapon string) bool {
+	if f.All || f.Slugs[slug] {
+		return true
+	}
+	return weapon != "" && f.Weapons[weapon]
+}
@@ -1,6 +1,9 @@
 package models
 
 import (
+	"errors"
+	"fmt"
+	"sort"
 	"time"
 
 	"github.com/google/uuid"
@@ -26,6 +29,15 @@ const (
 	SourceManual      PriceSource = "manual"
 )
 
+func (s PriceSource) IsValid() bool {
+	switch s {
+	case SourceSteamMarket, SourceCSMoney, SourceSkinport, SourceBuffMarket, SourceManual:
+		return true
+	default:
+		return false
+	}
+}
+
 func NewPriceHistory(skinID uuid.UUID, price float64, source string, volume int) *PriceHistory {
 	return &PriceHistory{
 		SkinID:     skinID,
@@ -37,10 +49,30 @@ func NewPriceHistory(skinID uuid.UUID, price float64, source string, volume int)
 	}
 }
 
+type MarketPrice struct {
+	MarketHashName string      `json:"market_hash_name"`
+	Source         PriceSource `json:"source"`
+	Price          float64     `json:"price"`
+	Currency       string      `json:"currency"`
+	Volume         int         `json:"volume"`
+	FetchedAt      time.Time   `json:"fetched_at"`
+}
+
+// MarketListing - предмет из поисковой выдачи маркетплейса, используется для поиска новых скинов.
+type MarketListing struct {
+	MarketHashName string  `json:"market_hash_name"`
+	Rarity         string  `json:"rarity"`
+	Price          float64 `json:"price"`
+	Currency       string  `json:"currency"`
+	Listings       int     `json:"listings"`
+	ImageURL       string  `json:"image_url"`
+}
+
 type PriceUpdateEvent struct {
 	SkinID         uuid.UUID `json:"skin_id"`
 	Slug           string    `json:"slug"`
 	MarketHashName string    `json:"market_hash_name"`
+	Weapon         string    `json:"weapon,omitempty"`
 	Source         string    `json:"source"`
 	OldPrice       float64   `json:"old_price"`
 	NewPrice       float64   `json:"new_price"`
@@ -118,14 +150,87 @@ type PriceChartData struct {
 
 type PriceChartResponse struct {
 	SkinID      uuid.UUID        `json:"skin_id"`
-	Period      string           `json:"period"` // 24h, 7d, 30d, 90d, 1y, all
+	Period      string           `json:"period"`             // 24h, 7d, 30d, 90d, 1y, all
+	Interval    string           `json:"interval,omitempty"` // пусто - точки без агрегации
+	Source      string           `json:"source,omitempty"`   // площадка свечей
 	DataPoints  []PriceChartData `json:"data_points"`
+	Candles     []PriceCandle    `json:"candles,omitempty"`
 	MinPrice    float64          `json:"min_price"`
 	MaxPrice    float64          `json:"max_price"`
 	AvgPrice    float64          `json:"avg_price"`
 	TotalVolume int              `json:"total_volume"`
 }
 
+var ErrInvalidChartInterval = errors.New("invalid chart interval")
+
+// ChartInterval - ширина свечи графика цены.
+type ChartInterval string
+
+const (
+	Interval5m ChartInterval = "5m"
+	Interval1h ChartInterval = "1h"
+	Interval4h ChartInterval = "4h"
+	Interval1d ChartInterval = "1d"
+	Interval1w ChartInterval = "1w"
+)
+
+func ParseChartInterval(value string) (ChartInterval, error) {
+	interval := ChartInterval(value)
+	if interval.Duration() == 0 {
+		return "", fmt.Errorf("%w: %q, expected 5m, 1h, 4h, 1d or 1w", ErrInvalidChartInterval, value)
+	}
+	return interval, nil
+}
+
+func (i ChartInterval) Duration() time.Duration {
+	switch i {
+	case Interval5m:
+		return 5 * time.Minute
+	case Interval1h:
+		return time.Hour
+	case Interval4h:
+		return 4 * time.Hour
+	case Interval1d:
+		return 24 * time.Hour
+	case Interval1w:
+		return 7 * 24 * time.Hour
+	default:
+		return 0
+	}
+}
+
+// MaxChartCandles - сколько свечей может вернуть один запрос графика.
+const MaxChartCandles = 2500
+
+// ValidateCandleRange проверяет, что period при ширине свечи interval дает не больше MaxChartCandles свечей.
+// Длина истории за all заранее неизвестна, поэтому для нее разрешены только дневные и недельные свечи.
+func ValidateCandleRange(period PriceStatsPeriod, interval ChartInterval) error {
+	if period == PeriodAll {
+		if interval.Duration() < 24*time.Hour {
+			return fmt.Errorf("%w: period all requires 1d or 1w", ErrInvalidChartInterval)
+		}
+		return nil
+	}
+	if candles := period.GetDuration() / interval.Duration(); candles > MaxChartCandles {
+		return fmt.Errorf("%w: %s over %s gives %d candles, max %d", ErrInvalidChartInterval, interval, period, candles, MaxChartCandles)
+	}
+	return nil
+}
+
+// PriceCandle - OHLCV-свеча одной площадки: цены первой и последней точки интервала, экстремумы
+// и средняя цена по Count точкам истории. Volume - объем за 24 часа из последней точки интервала:
+// в истории хранятся снимки volume_24h, и их сумма завышала бы объем в число точек.
+type PriceCandle struct {
+	Timestamp time.Time `json:"timestamp"`
+	Open      float64   `json:"open"`
+	High      float64   `json:"high"`
+	Low       float64   `json:"low"`
+	Close     float64   `json:"close"`
+	AvgPrice  float64   `json:"avg_price"`
+	Volume    int       `json:"volume"`
+	Count     int       `json:"count"`
+}
+
 type PriceStatsPeriod string
 
 const (
@@ -154,41 +259,162 @@ func (p PriceStatsPeriod) GetDuration() time.Duration {
 	}
 }
 
+// PriceComparison - последние цены скина на площадках. BestPrice/BestSource - где дешевле купить,
+// SellPrice/SellSource - где дороже продать, PriceDiff - разница между ними,
+// SpreadPercent - та же разница в процентах от BestPrice.
 type PriceComparison struct {
 	SkinID         uuid.UUID          `json:"skin_id"`
+	Slug           string             `json:"slug"`
 	MarketHashName string             `json:"market_hash_name"`
 	Prices         map[string]float64 `json:"prices"`
 	BestPrice      float64            `json:"best_price"`
 	BestSource     string             `json:"best_source"`
+	SellPrice      float64            `json:"sell_price"`
+	SellSource     string             `json:"sell_source"`
 	PriceDiff      float64            `json:"price_diff"`
+	SpreadPercent  float64            `json:"spread_percent"`
 	UpdatedAt      time.Time          `json:"updated_at"`
 }
 
+// DefaultComparisonMaxAge - цены старше этого не участвуют в сравнении площадок.
+const DefaultComparisonMaxAge = 24 * time.Hour
+
+// NewPriceComparison считает лучшую площадку для покупки и продажи по ценам prices (source -> цена).
+// При равных ценах выбирается площадка с меньшим именем, чтобы результат не зависел от порядка map.
+func NewPriceComparison(skin *Skin, prices map[string]float64, updatedAt time.Time) *PriceComparison {
+	comparison := &PriceComparison{
+		SkinID:         skin.ID,
+		Slug:           skin.Slug,
+		MarketHashName: skin.MarketHashName,
+		Prices:         prices,
+		UpdatedAt:      updatedAt,
+	}
+
+	sources := make([]string, 0, len(prices))
+	for source := range prices {
+		sources = append(sources, source)
+	}
+	sort.Strings(sources)
+
+	for _, source := range sources {
+		price := prices[source]
+		if comparison.BestSource == "" || price < comparison.BestPrice {
+			comparison.BestPrice, comparison.BestSource = price, source
+		}
+		if comparison.SellSource == "" || price > comparison.SellPrice {
+			comparison.SellPrice, comparison.SellSource = price, source
+		}
+	}
+
+	comparison.PriceDiff = comparison.SellPrice - comparison.BestPrice
+	if comparison.BestPrice > 0 {
+		comparison.SpreadPercent = comparison.PriceDiff / comparison.BestPrice * 100
+	}
+
+	return comparison
+}
+
 type MarketOverview struct {
-	TotalSkins      int       `json:"total_skins"`
-	AvgPrice        float64   `json:"avg_price"`
-	TotalVolume24h  int       `json:"total_volume_24h"`
-	TopGainers      []Skin    `json:"top_gainers"`
-	TopLosers       []Skin    `json:"top_losers"`
-	MostPopular     []Skin    `json:"most_popular"`
-	RecentlyUpdated []Skin    `json:"recently_updated"`
-	UpdatedAt       time.Time `json:"updated_at"`
-}
-
-type PriceAlert struct {
-	TargetPrice  float64 `json:"target_price"`
-	CurrentPrice float64 `json:"current_price"`
-	Condition    string  `json:"condition"`
-}
-
-func (a *PriceAlert) ShouldTrigger() bool {
-	switch a.Condition {
-	case "below":
-		return a.CurrentPrice <= a.TargetPrice
-	case "above":
-		return a.CurrentPrice >= a.TargetPrice
-	default:
-		return false
+	TotalSkins      int             `json:"total_skins"`
+	AvgPrice        float64         `json:"avg_price"`
+	TotalVolume24h  int             `json:"total_volume_24h"`
+	TopGainers      []Skin          `json:"top_gainers"`
+	TopLosers       []Skin          `json:"top_losers"`
+	MostPopular     []Skin          `json:"most_popular"`
+	RecentlyUpdated []Skin          `json:"recently_updated"`
+	ByCategory      []MarketSegment `json:"by_category"`
+	ByRarity        []MarketSegment `json:"by_rarity"`
+	ByShard         []MarketSegment `json:"by_shard"`
+	UpdatedAt       time.Time       `json:"updated_at"`
+}
+
+// UnknownRarity - сегмент для скинов без указанной редкости.
+const UnknownRarity = "Unknown"
+
+// MarketStatsRow - агрегаты одного шарда по паре оружие/редкость.
+// Средняя цена считается только по скинам с ценой, поэтому PricedCount и PriceSum хранятся отдельно.
+type MarketStatsRow struct {
+	Shard       int
+	Weapon      string
+	Rarity      string
+	SkinCount   int
+	PricedCount int
+	PriceSum    float64
+	Volume24h   int
+}
+
+type MarketSegment struct {
+	Name           string  `json:"name"`
+	SkinCount      int     `json:"skin_count"`
+	AvgPrice       float64 `json:"avg_price"`
+	TotalVolume24h int     `json:"total_volume_24h"`
+}
+
+// NewMarketOverview считает итоги рынка и разбивки по категориям, редкости и шардам.
+// Списки скинов заполняет вызывающий.
+func NewMarketOverview(rows []MarketStatsRow, updatedAt time.Time) *MarketOverview {
+	total := newMarketSegments(rows, func(*MarketStatsRow) string { return "" })
+
+	overview := &MarketOverview{
+		ByCategory: newMarketSegments(rows, func(row *MarketStatsRow) string {
+			return string(WeaponCategory(row.Weapon))
+		}),
+		ByRarity: newMarketSegments(rows, func(row *MarketStatsRow) string {
+			if row.Rarity == "" {
+				return UnknownRarity
+			}
+			return row.Rarity
+		}),
+		ByShard: newMarketSegments(rows, func(row *MarketStatsRow) string {
+			return fmt.Sprintf("shard-%d", row.Shard)
+		}),
+		UpdatedAt: updatedAt,
 	}
+	if len(total) > 0 {
+		overview.TotalSkins = total[0].SkinCount
+		overview.AvgPrice = total[0].AvgPrice
+		overview.TotalVolume24h = total[0].TotalVolume24h
+	}
+
+	return overview
 }
 
+// newMarketSegments группирует строки по ключу; сегменты идут по убыванию числа скинов, затем по имени.
+func newMarketSegments(rows []MarketStatsRow, key func(*MarketStatsRow) string) []MarketSegment {
+	type accumulator struct {
+		segment     MarketSegment
+		pricedCount int
+		priceSum    float64
+	}
+
+	byName := make(map[string]*accumulator)
+	for i := range rows {
+		row := &rows[i]
+		name := key(row)
+		acc, ok := byName[name]
+		if !ok {
+			acc = &accumulator{segment: MarketSegment{Name: name}}
+			byName[name] = acc
+		}
+		acc.segment.SkinCount += row.SkinCount
+		acc.segment.TotalVolume24h += row.Volume24h
+		acc.pricedCount += row.PricedCount
+		acc.priceSum += row.PriceSum
+	}
+
+	segments := make([]MarketSegment, 0, len(byName))
+	for _, acc := range byName {
+		if acc.pricedCount > 0 {
+			acc.segment.AvgPrice = acc.priceSum / float64(acc.pricedCount)
+		}
+		segments = append(segments, acc.segment)
+	}
+	sort.Slice(segments, func(i, j int) bool {
+		if segments[i].SkinCount != segments[j].SkinCount {
+			return segments[i].SkinCount > segments[j].SkinCount
+		}
+		return segments[i].Name < segments[j].Name
+	})
+
+	return segments
+}
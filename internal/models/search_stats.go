@@ -0,0 +1,107 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+var ErrInvalidSearchWindow = errors.New("invalid search window")
+
+// SearchWindow - скользящее окно статистики поиска. Окно собирается из нескольких корзин:
+// счетчик пишется в текущую корзину, а при чтении корзины окна складываются.
+type SearchWindow string
+
+const (
+	SearchWindowHour SearchWindow = "hour"
+	SearchWindowDay  SearchWindow = "day"
+
+	DefaultPopularSearchesLimit = 10
+	MaxPopularSearchesLimit     = 100
+
+	// MaxSearchStatsQueryLen ограничивает длину запроса в статистике, чтобы случайный длинный ввод не раздувал ключи.
+	MaxSearchStatsQueryLen = 64
+)
+
+var SearchWindows = []SearchWindow{SearchWindowHour, SearchWindowDay}
+
+func ParseSearchWindow(value string) (SearchWindow, error) {
+	window := SearchWindow(value)
+	if window.BucketSize() == 0 {
+		return "", fmt.Errorf("%w: %q, expected hour or day", ErrInvalidSearchWindow, value)
+	}
+	return window, nil
+}
+
+func (w SearchWindow) BucketSize() time.Duration {
+	switch w {
+	case SearchWindowHour:
+		return 5 * time.Minute
+	case SearchWindowDay:
+		return time.Hour
+	default:
+		return 0
+	}
+}
+
+func (w SearchWindow) Duration() time.Duration {
+	switch w {
+	case SearchWindowHour:
+		return time.Hour
+	case SearchWindowDay:
+		return 24 * time.Hour
+	default:
+		return 0
+	}
+}
+
+// Buckets - начала корзин окна, заканчивающегося в now, от текущей к самой старой.
+// Кроме текущей, заполненной частично, берется Duration полных корзин, поэтому окно
+// немного длиннее Duration. TTL корзины (Duration + BucketSize) сохраняет самую старую из них.
+func (w SearchWindow) Buckets(now time.Time) []time.Time {
+	size := w.BucketSize()
+	if size == 0 {
+		return nil
+	}
+
+	current := now.UTC().Truncate(size)
+	buckets := make([]time.Time, w.Duration()/size+1)
+	for i := range buckets {
+		buckets[i] = current.Add(-time.Duration(i) * size)
+	}
+	return buckets
+}
+
+// SearchStatsKey - ключ sorted set запрос -> число поисков в корзине окна.
+// zeroResults выбирает счетчик поисков, не нашедших ни одного скина.
+func SearchStatsKey(window SearchWindow, bucket time.Time, zeroResults bool) string {
+	kind := "all"
+	if zeroResults {
+		kind = "zero"
+	}
+	return fmt.Sprintf("analytics:search:%s:%s:%d", window, kind, bucket.Unix())
+}
+
+// NormalizeSearchStatsQuery приводит запрос к виду, в котором он считается в статистике:
+// "AK-47 | Redline" и "ak-47  redline" попадают в один счетчик "ak 47 redline".
+func NormalizeSearchStatsQuery(query string) string {
+	words, _ := NormalizeSearchQuery(query)
+	if len(words) > MaxSearchStatsQueryLen {
+		words = words[:MaxSearchStatsQueryLen]
+	}
+	return strings.TrimSpace(strings.ReplaceAll(words, "_", " "))
+}
+
+type PopularSearch struct {
+	Query       string `json:"query"`
+	Count       int64  `json:"count"`
+	ZeroResults int64  `json:"zero_results"`
+}
+
+type PopularSearches struct {
+	Window             SearchWindow    `json:"window"`
+	From               time.Time       `json:"from"`
+	Searches           []PopularSearch `json:"searches"`
+	ZeroResultSearches []PopularSearch `json:"zero_result_searches"`
+}
@@ -1,6 +1,7 @@
 package models
 
 import (
+	"errors"
 	"fmt"
 	"regexp"
 	"strings"
@@ -9,6 +10,11 @@ import (
 	"github.com/google/uuid"
 )
 
+var (
+	ErrInvalidSkin  = errors.New("invalid skin")
+	ErrSkinNotFound = errors.New("skin not found")
+)
+
 type Skin struct {
 	ID             uuid.UUID `json:"id" db:"id"`
 	Slug           string    `json:"slug" db:"slug"`
@@ -55,6 +61,19 @@ func GenerateSlug(marketHashName string) string {
 	return slug
 }
 
+// NormalizeSearchQuery приводит поисковый запрос к виду slug: words - слова через "_"
+// для сравнения по триграммам, compact - без разделителей для поиска подстроки.
+func NormalizeSearchQuery(query string) (words, compact string) {
+	words = GenerateSlug(query)
+	return words, strings.ReplaceAll(words, "_", "")
+}
+
+// SkinLookup - результат поиска одного ключа (slug или id) в пакетном запросе. Skin равен nil, если скин не найден.
+type SkinLookup struct {
+	Key  string `json:"key"`
+	Skin *Skin  `json:"skin,omitempty"`
+}
+
 type SkinQuality string
 
 const (
@@ -64,8 +83,20 @@ const (
 	QualityWellWorn      SkinQuality = "Well-Worn"
 	QualityBattleScarred SkinQuality = "Battle-Scarred"
 	QualityNotPainted    SkinQuality = "Not Painted"
+	// QualityNone - предметы без износа: кейсы, стикеры, граффити.
+	QualityNone SkinQuality = "None"
 )
 
+func (q SkinQuality) IsValid() bool {
+	switch q {
+	case QualityFactoryNew, QualityMinimalWear, QualityFieldTested, QualityWellWorn,
+		QualityBattleScarred, QualityNotPainted, QualityNone:
+		return true
+	default:
+		return false
+	}
+}
+
 type SkinRarity string
 
 const (
@@ -76,8 +107,25 @@ const (
 	RarityClassified      SkinRarity = "Classified"
 	RarityCovert          SkinRarity = "Covert"
 	RarityContraband      SkinRarity = "Contraband"
+	// Редкости стикеров, кейсов и прочих предметов.
+	RarityBaseGrade     SkinRarity = "Base Grade"
+	RarityHighGrade     SkinRarity = "High Grade"
+	RarityRemarkable    SkinRarity = "Remarkable"
+	RarityExotic        SkinRarity = "Exotic"
+	RarityExtraordinary SkinRarity = "Extraordinary"
 )
 
+func (r SkinRarity) IsValid() bool {
+	switch r {
+	case RarityConsumerGrade, RarityIndustrialGrade, RarityMilSpecGrade, RarityRestricted,
+		RarityClassified, RarityCovert, RarityContraband,
+		RarityBaseGrade, RarityHighGrade, RarityRemarkable, RarityExotic, RarityExtraordinary:
+		return true
+	default:
+		return false
+	}
+}
+
 type SkinCategory string
 
 const (
@@ -100,6 +148,43 @@ func GenerateMarketHashName(weapon, name, quality string) string {
 	return fmt.Sprintf("%s | %s (%s)", weapon, name, quality)
 }
 
+// ParseMarketHashName разбирает marketHashName на weapon, name и quality.
+// Префиксы StatTrak™, Souvenir и ★ не входят в weapon, чтобы скин попадал в шард своего оружия.
+// Предметы без износа (кейсы, стикеры) получают quality "None", как в 002_seed_data.sql.
+func ParseMarketHashName(marketHashName string) (weapon, name, quality string) {
+	quality = "None"
+	base := strings.TrimSpace(marketHashName)
+
+	if strings.HasSuffix(base, ")") {
+		if idx := strings.LastIndex(base, " ("); idx > 0 {
+			wear := base[idx+2 : len(base)-1]
+			switch SkinQuality(wear) {
+			case QualityFactoryNew, QualityMinimalWear, QualityFieldTested, QualityWellWorn, QualityBattleScarred:
+				quality = wear
+				base = base[:idx]
+			}
+		}
+	}
+
+	parts := strings.SplitN(base, " | ", 2)
+	if len(parts) == 1 {
+		if strings.HasSuffix(base, " Case") {
+			return "Case", base, quality
+		}
+		return "Other", base, quality
+	}
+
+	weapon = parts[0]
+	for _, prefix := range []string{"★ ", StatTrakPrefix, SouvenirPrefix} {
+		weapon = strings.TrimPrefix(weapon, prefix)
+	}
+	if weapon == "Sealed Graffiti" {
+		weapon = "Graffiti"
+	}
+
+	return weapon, parts[1], quality
+}
+
 func NewSkin(marketHashName, name, weapon, quality string) *Skin {
 	now := time.Now()
 	// Если marketHashName пустой, генерируем его автоматически
@@ -120,6 +205,30 @@ func NewSkin(marketHashName, name, weapon, quality string) *Skin {
 	}
 }
 
+// Validate проверяет поля скина перед сохранением. Редкость необязательна:
+// у предметов, найденных в выдаче маркетплейса, ее может не быть.
+func (s *Skin) Validate() error {
+	if strings.TrimSpace(s.Name) == "" {
+		return fmt.Errorf("%w: name is required", ErrInvalidSkin)
+	}
+	if strings.TrimSpace(s.Weapon) == "" {
+		return fmt.Errorf("%w: weapon is required", ErrInvalidSkin)
+	}
+	if !SkinQuality(s.Quality).IsValid() {
+		return fmt.Errorf("%w: unknown quality %q", ErrInvalidSkin, s.Quality)
+	}
+	if s.Rarity != "" && !SkinRarity(s.Rarity).IsValid() {
+		return fmt.Errorf("%w: unknown rarity %q", ErrInvalidSkin, s.Rarity)
+	}
+	if s.CurrentPrice < 0 {
+		return fmt.Errorf("%w: current_price must not be negative", ErrInvalidSkin)
+	}
+	if len(s.Currency) != 3 {
+		return fmt.Errorf("%w: currency must be a 3-letter code", ErrInvalidSkin)
+	}
+	return nil
+}
+
 func (s *Skin) UpdatePrice(newPrice float64, volume int) {
 	s.CurrentPrice = newPrice
 	s.Volume24h = volume
@@ -143,36 +252,42 @@ func (s *Skin) IsTrending() bool {
 }
 
 func (s *Skin) GetCategory() SkinCategory {
-	weapons := map[string]SkinCategory{
-		"AK-47":           CategoryRifle,
-		"M4A4":            CategoryRifle,
-		"M4A1-S":          CategoryRifle,
-		"AWP":             CategorySniper,
-		"Desert Eagle":    CategoryPistol,
-		"Glock-18":        CategoryPistol,
-		"USP-S":           CategoryPistol,
-		"P250":            CategoryPistol,
-		"MP9":             CategorySMG,
-		"MAC-10":          CategorySMG,
-		"UMP-45":          CategorySMG,
-		"Nova":            CategoryShotgun,
-		"XM1014":          CategoryShotgun,
-		"Negev":           CategoryMachinegun,
-		"M249":            CategoryMachinegun,
-		"Karambit":        CategoryKnife,
-		"Bayonet":         CategoryKnife,
-		"Butterfly Knife": CategoryKnife,
-	}
-
-	if category, ok := weapons[s.Weapon]; ok {
+	return WeaponCategory(s.Weapon)
+}
+
+// weaponCategories - оружие с явно заданной категорией, остальное классифицирует WeaponCategory.
+var weaponCategories = map[string]SkinCategory{
+	"AK-47":           CategoryRifle,
+	"M4A4":            CategoryRifle,
+	"M4A1-S":          CategoryRifle,
+	"AWP":             CategorySniper,
+	"Desert Eagle":    CategoryPistol,
+	"Glock-18":        CategoryPistol,
+	"USP-S":           CategoryPistol,
+	"P250":            CategoryPistol,
+	"MP9":             CategorySMG,
+	"MAC-10":          CategorySMG,
+	"UMP-45":          CategorySMG,
+	"Nova":            CategoryShotgun,
+	"XM1014":          CategoryShotgun,
+	"Negev":           CategoryMachinegun,
+	"M249":            CategoryMachinegun,
+	"Karambit":        CategoryKnife,
+	"Bayonet":         CategoryKnife,
+	"Butterfly Knife": CategoryKnife,
+}
+
+// WeaponCategory определяет категорию по названию оружия; неизвестное оружие считается винтовкой.
+func WeaponCategory(weapon string) SkinCategory {
+	if category, ok := weaponCategories[weapon]; ok {
 		return category
 	}
 
-	if len(s.Weapon) > 6 && s.Weapon[:6] == "Gloves" {
+	if len(weapon) > 6 && weapon[:6] == "Gloves" {
 		return CategoryGloves
 	}
 
-	if len(s.Weapon) > 5 && (s.Weapon[len(s.Weapon)-5:] == "Knife" || s.Weapon[:5] == "Knife") {
+	if len(weapon) > 5 && (weapon[len(weapon)-5:] == "Knife" || weapon[:5] == "Knife") {
 		return CategoryKnife
 	}
 
@@ -190,6 +305,27 @@ type SkinFilter struct {
 	SortOrder string
 	Limit     int
 	Offset    int
+	// PageToken - курсор из NextPageToken предыдущей страницы. Если задан, Offset не используется.
+	PageToken string
+
+	// Списки объединяются с одиночными Weapon, Quality и Rarity: скин подходит, если совпадает любое значение.
+	Weapons    []string
+	Qualities  []string
+	Rarities   []string
+	Categories []SkinCategory
+	// Границы диапазонов включительные, nil - без ограничения.
+	MinPriceChange24h *float64
+	MaxPriceChange24h *float64
+	MinPriceChange7d  *float64
+	MaxPriceChange7d  *float64
+	MinVolume24h      *int
+	MaxVolume24h      *int
+	// Диапазон last_updated, нулевое время - без ограничения.
+	UpdatedAfter  time.Time
+	UpdatedBefore time.Time
+	// nil - любые, true - только StatTrak™/Souvenir, false - без них.
+	StatTrak *bool
+	Souvenir *bool
 }
 
 func NewSkinFilter() *SkinFilter {
@@ -207,6 +343,8 @@ type SkinListResponse struct {
 	Page       int    `json:"page"`
 	PageSize   int    `json:"page_size"`
 	TotalPages int    `json:"total_pages"`
+	// NextPageToken пустой на последней странице
+	NextPageToken string `json:"next_page_token,omitempty"`
 }
 
 type SkinDetailResponse struct {
@@ -248,3 +386,38 @@ func (s *Skin) ToSlugResponse() SkinSlugResponse {
 		ImageURL:       s.ImageURL,
 	}
 }
+
+// ApplyUpdate переносит в скин поля patch, перечисленные в fields (имена полей как в JSON).
+// Цена и валюта меняются, только если указаны явно, поэтому частичное обновление не затирает цену,
+// записанную парсером. Slug не меняется: по нему скин адресуется в API.
+func (s *Skin) ApplyUpdate(patch *Skin, fields []string) error {
+	if len(fields) == 0 {
+		return fmt.Errorf("%w: update mask is empty", ErrInvalidSkin)
+	}
+
+	for _, field := range fields {
+		switch field {
+		case "market_hash_name":
+			s.MarketHashName = patch.MarketHashName
+		case "name":
+			s.Name = patch.Name
+		case "weapon":
+			s.Weapon = patch.Weapon
+		case "quality":
+			s.Quality = patch.Quality
+		case "rarity":
+			s.Rarity = patch.Rarity
+		case "current_price":
+			s.CurrentPrice = patch.CurrentPrice
+		case "currency":
+			s.Currency = patch.Currency
+		case "image_url":
+			s.ImageURL = patch.ImageURL
+		default:
+			return fmt.Errorf("%w: field %q cannot be updated", ErrInvalidSkin, field)
+		}
+	}
+
+	s.UpdatedAt = time.Now()
+	return nil
+}
@@ -0,0 +1,143 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+)
+
+var ErrInvalidSkinFilter = errors.New("invalid skin filter")
+
+const (
+	StatTrakPrefix = "StatTrak™ "
+	SouvenirPrefix = "Souvenir "
+
+	// MaxFilterValues ограничивает длину каждого списка в фильтре
+	MaxFilterValues = 50
+)
+
+func (c SkinCategory) IsValid() bool {
+	switch c {
+	case CategoryRifle, CategoryPistol, CategorySMG, CategorySniper,
+		CategoryShotgun, CategoryMachinegun, CategoryKnife, CategoryGloves:
+		return true
+	}
+	return false
+}
+
+// CategoryWeapons возвращает оружие категории из справочника weaponCategories.
+// exhaustive = false у категорий, куда WeaponCategory относит и оружие не из справочника
+// (винтовки по умолчанию, ножи и перчатки по названию).
+func CategoryWeapons(category SkinCategory) (weapons []string, exhaustive bool) {
+	for weapon, c := range weaponCategories {
+		if c == category {
+			weapons = append(weapons, weapon)
+		}
+	}
+	sort.Strings(weapons)
+
+	switch category {
+	case CategoryRifle, CategoryKnife, CategoryGloves:
+		return weapons, false
+	}
+	return weapons, true
+}
+
+// KnownWeapons - все оружие из справочника категорий.
+func KnownWeapons() []string {
+	weapons := make([]string, 0, len(weaponCategories))
+	for weapon := range weaponCategories {
+		weapons = append(weapons, weapon)
+	}
+	sort.Strings(weapons)
+	return weapons
+}
+
+func (f *SkinFilter) WeaponValues() []string  { return filterValues(f.Weapon, f.Weapons) }
+func (f *SkinFilter) QualityValues() []string { return filterValues(f.Quality, f.Qualities) }
+func (f *SkinFilter) RarityValues() []string  { return filterValues(f.Rarity, f.Rarities) }
+
+// filterValues объединяет одиночное значение со списком без пустых строк и повторов.
+func filterValues(single string, list []string) []string {
+	var values []string
+	for _, value := range append([]string{single}, list...) {
+		if value != "" && !slices.Contains(values, value) {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+// CandidateWeapons - оружие, которым ограничивают выборку фильтры weapon и category.
+// bounded = false, если набор не ограничен и нужны все шарды.
+func (f *SkinFilter) CandidateWeapons() (weapons []string, bounded bool) {
+	weapons = f.WeaponValues()
+	bounded = len(weapons) > 0
+
+	if len(f.Categories) == 0 {
+		return weapons, bounded
+	}
+
+	var categoryWeapons []string
+	for _, category := range f.Categories {
+		list, exhaustive := CategoryWeapons(category)
+		if !exhaustive {
+			return weapons, bounded
+		}
+		categoryWeapons = append(categoryWeapons, list...)
+	}
+
+	if !bounded {
+		return categoryWeapons, true
+	}
+	return slices.DeleteFunc(weapons, func(weapon string) bool {
+		return !slices.Contains(categoryWeapons, weapon)
+	}), true
+}
+
+func (f *SkinFilter) Validate() error {
+	for _, list := range []struct {
+		name string
+		size int
+	}{
+		{"weapons", len(f.Weapons)},
+		{"qualities", len(f.Qualities)},
+		{"rarities", len(f.Rarities)},
+		{"categories", len(f.Categories)},
+	} {
+		if list.size > MaxFilterValues {
+			return fmt.Errorf("%w: at most %d %s allowed", ErrInvalidSkinFilter, MaxFilterValues, list.name)
+		}
+	}
+	for _, category := range f.Categories {
+		if !category.IsValid() {
+			return fmt.Errorf("%w: unknown category %q", ErrInvalidSkinFilter, category)
+		}
+	}
+
+	if f.MinPrice > 0 && f.MaxPrice > 0 && f.MinPrice > f.MaxPrice {
+		return fmt.Errorf("%w: min_price is greater than max_price", ErrInvalidSkinFilter)
+	}
+	if err := validateRange("price_change_24h", f.MinPriceChange24h, f.MaxPriceChange24h); err != nil {
+		return err
+	}
+	if err := validateRange("price_change_7d", f.MinPriceChange7d, f.MaxPriceChange7d); err != nil {
+		return err
+	}
+	if err := validateRange("volume_24h", f.MinVolume24h, f.MaxVolume24h); err != nil {
+		return err
+	}
+	if !f.UpdatedAfter.IsZero() && !f.UpdatedBefore.IsZero() && f.UpdatedAfter.After(f.UpdatedBefore) {
+		return fmt.Errorf("%w: updated_after is later than updated_before", ErrInvalidSkinFilter)
+	}
+
+	return nil
+}
+
+func validateRange[T int | float64](name string, min, max *T) error {
+	if min != nil && max != nil && *min > *max {
+		return fmt.Errorf("%w: min %s is greater than max", ErrInvalidSkinFilter, name)
+	}
+	return nil
+}
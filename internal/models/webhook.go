@@ -0,0 +1,48 @@
+package models
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	DeliveryStatusPending   = "pending"
+	DeliveryStatusDelivered = "delivered"
+	DeliveryStatusFailed    = "failed"
+)
+
+var ErrInvalidWebhook = errors.New("invalid webhook")
+
+// Webhook - адрес, на который отправляются сработавшие правила уведомлений.
+// Secret используется для подписи тела запроса (HMAC-SHA256).
+type Webhook struct {
+	ID        uuid.UUID `json:"id" db:"id"`
+	URL       string    `json:"url" db:"url"`
+	Secret    string    `json:"-" db:"secret"`
+	Active    bool      `json:"active" db:"active"`
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+}
+
+// WebhookDelivery - доставка одного уведомления на один webhook: в статусе pending она
+// еще ждет отправки, delivered и failed - итог всех попыток.
+type WebhookDelivery struct {
+	ID           uuid.UUID  `json:"id" db:"id"`
+	WebhookID    uuid.UUID  `json:"webhook_id" db:"webhook_id"`
+	AlertID      uuid.UUID  `json:"alert_id" db:"alert_id"`
+	URL          string     `json:"url" db:"url"`
+	Status       string     `json:"status" db:"status"`
+	Attempts     int        `json:"attempts" db:"attempts"`
+	ResponseCode int        `json:"response_code" db:"response_code"`
+	Error        string     `json:"error" db:"error"`
+	CreatedAt    time.Time  `json:"created_at" db:"created_at"`
+	DeliveredAt  *time.Time `json:"delivered_at,omitempty" db:"delivered_at"`
+	Payload      []byte     `json:"-" db:"payload"`
+}
+
+// PendingDelivery - доставка в статусе pending вместе с webhook-ом, на который она идет.
+type PendingDelivery struct {
+	Webhook  Webhook
+	Delivery WebhookDelivery
+}
@@ -481,30 +481,883 @@ func (x *PriceChartDataModel) GetVolume() int32 {
 	return 0
 }
 
+// OHLCV-свеча: count - сколько точек истории попало в интервал.
+type PriceCandleModel struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Timestamp     string                 `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Open          float64                `protobuf:"fixed64,2,opt,name=open,proto3" json:"open,omitempty"`
+	High          float64                `protobuf:"fixed64,3,opt,name=high,proto3" json:"high,omitempty"`
+	Low           float64                `protobuf:"fixed64,4,opt,name=low,proto3" json:"low,omitempty"`
+	Close         float64                `protobuf:"fixed64,5,opt,name=close,proto3" json:"close,omitempty"`
+	AvgPrice      float64                `protobuf:"fixed64,6,opt,name=avg_price,json=avgPrice,proto3" json:"avg_price,omitempty"`
+	Volume        int32                  `protobuf:"varint,7,opt,name=volume,proto3" json:"volume,omitempty"`
+	Count         int32                  `protobuf:"varint,8,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PriceCandleModel) Reset() {
+	*x = PriceCandleModel{}
+	mi := &file_models_skin_model_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceCandleModel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceCandleModel) ProtoMessage() {}
+
+func (x *PriceCandleModel) ProtoReflect() protoreflect.Message {
+	mi := &file_models_skin_model_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceCandleModel.ProtoReflect.Descriptor instead.
+func (*PriceCandleModel) Descriptor() ([]byte, []int) {
+	return file_models_skin_model_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *PriceCandleModel) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+func (x *PriceCandleModel) GetOpen() float64 {
+	if x != nil {
+		return x.Open
+	}
+	return 0
+}
+
+func (x *PriceCandleModel) GetHigh() float64 {
+	if x != nil {
+		return x.High
+	}
+	return 0
+}
+
+func (x *PriceCandleModel) GetLow() float64 {
+	if x != nil {
+		return x.Low
+	}
+	return 0
+}
+
+func (x *PriceCandleModel) GetClose() float64 {
+	if x != nil {
+		return x.Close
+	}
+	return 0
+}
+
+func (x *PriceCandleModel) GetAvgPrice() float64 {
+	if x != nil {
+		return x.AvgPrice
+	}
+	return 0
+}
+
+func (x *PriceCandleModel) GetVolume() int32 {
+	if x != nil {
+		return x.Volume
+	}
+	return 0
+}
+
+func (x *PriceCandleModel) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
 type MarketOverviewModel struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	TotalSkins      int32                  `protobuf:"varint,1,opt,name=total_skins,json=totalSkins,proto3" json:"total_skins,omitempty"`
 	AvgPrice        float64                `protobuf:"fixed64,2,opt,name=avg_price,json=avgPrice,proto3" json:"avg_price,omitempty"`
 	TotalVolume_24H int32                  `protobuf:"varint,3,opt,name=total_volume_24h,json=totalVolume24h,proto3" json:"total_volume_24h,omitempty"`
+	TopGainers      []*SkinModel           `protobuf:"bytes,4,rep,name=top_gainers,json=topGainers,proto3" json:"top_gainers,omitempty"`
+	TopLosers       []*SkinModel           `protobuf:"bytes,5,rep,name=top_losers,json=topLosers,proto3" json:"top_losers,omitempty"`
+	MostPopular     []*SkinModel           `protobuf:"bytes,6,rep,name=most_popular,json=mostPopular,proto3" json:"most_popular,omitempty"`
+	RecentlyUpdated []*SkinModel           `protobuf:"bytes,7,rep,name=recently_updated,json=recentlyUpdated,proto3" json:"recently_updated,omitempty"`
+	ByCategory      []*MarketSegmentModel  `protobuf:"bytes,8,rep,name=by_category,json=byCategory,proto3" json:"by_category,omitempty"`
+	ByRarity        []*MarketSegmentModel  `protobuf:"bytes,9,rep,name=by_rarity,json=byRarity,proto3" json:"by_rarity,omitempty"`
+	ByShard         []*MarketSegmentModel  `protobuf:"bytes,10,rep,name=by_shard,json=byShard,proto3" json:"by_shard,omitempty"`
+	UpdatedAt       string                 `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *MarketOverviewModel) Reset() {
+	*x = MarketOverviewModel{}
+	mi := &file_models_skin_model_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarketOverviewModel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketOverviewModel) ProtoMessage() {}
+
+func (x *MarketOverviewModel) ProtoReflect() protoreflect.Message {
+	mi := &file_models_skin_model_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketOverviewModel.ProtoReflect.Descriptor instead.
+func (*MarketOverviewModel) Descriptor() ([]byte, []int) {
+	return file_models_skin_model_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *MarketOverviewModel) GetTotalSkins() int32 {
+	if x != nil {
+		return x.TotalSkins
+	}
+	return 0
+}
+
+func (x *MarketOverviewModel) GetAvgPrice() float64 {
+	if x != nil {
+		return x.AvgPrice
+	}
+	return 0
+}
+
+func (x *MarketOverviewModel) GetTotalVolume_24H() int32 {
+	if x != nil {
+		return x.TotalVolume_24H
+	}
+	return 0
+}
+
+func (x *MarketOverviewModel) GetTopGainers() []*SkinModel {
+	if x != nil {
+		return x.TopGainers
+	}
+	return nil
+}
+
+func (x *MarketOverviewModel) GetTopLosers() []*SkinModel {
+	if x != nil {
+		return x.TopLosers
+	}
+	return nil
+}
+
+func (x *MarketOverviewModel) GetMostPopular() []*SkinModel {
+	if x != nil {
+		return x.MostPopular
+	}
+	return nil
+}
+
+func (x *MarketOverviewModel) GetRecentlyUpdated() []*SkinModel {
+	if x != nil {
+		return x.RecentlyUpdated
+	}
+	return nil
+}
+
+func (x *MarketOverviewModel) GetByCategory() []*MarketSegmentModel {
+	if x != nil {
+		return x.ByCategory
+	}
+	return nil
+}
+
+func (x *MarketOverviewModel) GetByRarity() []*MarketSegmentModel {
+	if x != nil {
+		return x.ByRarity
+	}
+	return nil
+}
+
+func (x *MarketOverviewModel) GetByShard() []*MarketSegmentModel {
+	if x != nil {
+		return x.ByShard
+	}
+	return nil
+}
+
+func (x *MarketOverviewModel) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+// Срез рынка: категория, редкость или шард. avg_price - по скинам с ценой.
+type MarketSegmentModel struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Name            string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	SkinCount       int32                  `protobuf:"varint,2,opt,name=skin_count,json=skinCount,proto3" json:"skin_count,omitempty"`
+	AvgPrice        float64                `protobuf:"fixed64,3,opt,name=avg_price,json=avgPrice,proto3" json:"avg_price,omitempty"`
+	TotalVolume_24H int32                  `protobuf:"varint,4,opt,name=total_volume_24h,json=totalVolume24h,proto3" json:"total_volume_24h,omitempty"`
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
 
-func (x *MarketOverviewModel) Reset() {
-	*x = MarketOverviewModel{}
-	mi := &file_models_skin_model_proto_msgTypes[5]
+func (x *MarketSegmentModel) Reset() {
+	*x = MarketSegmentModel{}
+	mi := &file_models_skin_model_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarketSegmentModel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketSegmentModel) ProtoMessage() {}
+
+func (x *MarketSegmentModel) ProtoReflect() protoreflect.Message {
+	mi := &file_models_skin_model_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketSegmentModel.ProtoReflect.Descriptor instead.
+func (*MarketSegmentModel) Descriptor() ([]byte, []int) {
+	return file_models_skin_model_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *MarketSegmentModel) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MarketSegmentModel) GetSkinCount() int32 {
+	if x != nil {
+		return x.SkinCount
+	}
+	return 0
+}
+
+func (x *MarketSegmentModel) GetAvgPrice() float64 {
+	if x != nil {
+		return x.AvgPrice
+	}
+	return 0
+}
+
+func (x *MarketSegmentModel) GetTotalVolume_24H() int32 {
+	if x != nil {
+		return x.TotalVolume_24H
+	}
+	return 0
+}
+
+type FacetCountModel struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FacetCountModel) Reset() {
+	*x = FacetCountModel{}
+	mi := &file_models_skin_model_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FacetCountModel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FacetCountModel) ProtoMessage() {}
+
+func (x *FacetCountModel) ProtoReflect() protoreflect.Message {
+	mi := &file_models_skin_model_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FacetCountModel.ProtoReflect.Descriptor instead.
+func (*FacetCountModel) Descriptor() ([]byte, []int) {
+	return file_models_skin_model_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *FacetCountModel) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *FacetCountModel) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// Диапазон [min, max); max = 0 у последнего диапазона без верхней границы.
+type PriceBucketModel struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Min           float64                `protobuf:"fixed64,1,opt,name=min,proto3" json:"min,omitempty"`
+	Max           float64                `protobuf:"fixed64,2,opt,name=max,proto3" json:"max,omitempty"`
+	Count         int32                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PriceBucketModel) Reset() {
+	*x = PriceBucketModel{}
+	mi := &file_models_skin_model_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceBucketModel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceBucketModel) ProtoMessage() {}
+
+func (x *PriceBucketModel) ProtoReflect() protoreflect.Message {
+	mi := &file_models_skin_model_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceBucketModel.ProtoReflect.Descriptor instead.
+func (*PriceBucketModel) Descriptor() ([]byte, []int) {
+	return file_models_skin_model_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *PriceBucketModel) GetMin() float64 {
+	if x != nil {
+		return x.Min
+	}
+	return 0
+}
+
+func (x *PriceBucketModel) GetMax() float64 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+func (x *PriceBucketModel) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type SkinFacetsModel struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Weapons       []*FacetCountModel     `protobuf:"bytes,1,rep,name=weapons,proto3" json:"weapons,omitempty"`
+	Qualities     []*FacetCountModel     `protobuf:"bytes,2,rep,name=qualities,proto3" json:"qualities,omitempty"`
+	Rarities      []*FacetCountModel     `protobuf:"bytes,3,rep,name=rarities,proto3" json:"rarities,omitempty"`
+	Categories    []*FacetCountModel     `protobuf:"bytes,4,rep,name=categories,proto3" json:"categories,omitempty"`
+	PriceBuckets  []*PriceBucketModel    `protobuf:"bytes,5,rep,name=price_buckets,json=priceBuckets,proto3" json:"price_buckets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SkinFacetsModel) Reset() {
+	*x = SkinFacetsModel{}
+	mi := &file_models_skin_model_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SkinFacetsModel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SkinFacetsModel) ProtoMessage() {}
+
+func (x *SkinFacetsModel) ProtoReflect() protoreflect.Message {
+	mi := &file_models_skin_model_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SkinFacetsModel.ProtoReflect.Descriptor instead.
+func (*SkinFacetsModel) Descriptor() ([]byte, []int) {
+	return file_models_skin_model_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SkinFacetsModel) GetWeapons() []*FacetCountModel {
+	if x != nil {
+		return x.Weapons
+	}
+	return nil
+}
+
+func (x *SkinFacetsModel) GetQualities() []*FacetCountModel {
+	if x != nil {
+		return x.Qualities
+	}
+	return nil
+}
+
+func (x *SkinFacetsModel) GetRarities() []*FacetCountModel {
+	if x != nil {
+		return x.Rarities
+	}
+	return nil
+}
+
+func (x *SkinFacetsModel) GetCategories() []*FacetCountModel {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *SkinFacetsModel) GetPriceBuckets() []*PriceBucketModel {
+	if x != nil {
+		return x.PriceBuckets
+	}
+	return nil
+}
+
+type TrendingSkinModel struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Rank            int32                  `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"`
+	Skin            *SkinModel             `protobuf:"bytes,2,opt,name=skin,proto3" json:"skin,omitempty"`
+	PriceChangeRate float64                `protobuf:"fixed64,3,opt,name=price_change_rate,json=priceChangeRate,proto3" json:"price_change_rate,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *TrendingSkinModel) Reset() {
+	*x = TrendingSkinModel{}
+	mi := &file_models_skin_model_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrendingSkinModel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrendingSkinModel) ProtoMessage() {}
+
+func (x *TrendingSkinModel) ProtoReflect() protoreflect.Message {
+	mi := &file_models_skin_model_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrendingSkinModel.ProtoReflect.Descriptor instead.
+func (*TrendingSkinModel) Descriptor() ([]byte, []int) {
+	return file_models_skin_model_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *TrendingSkinModel) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *TrendingSkinModel) GetSkin() *SkinModel {
+	if x != nil {
+		return x.Skin
+	}
+	return nil
+}
+
+func (x *TrendingSkinModel) GetPriceChangeRate() float64 {
+	if x != nil {
+		return x.PriceChangeRate
+	}
+	return 0
+}
+
+type PriceAlertModel struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SkinId          string                 `protobuf:"bytes,2,opt,name=skin_id,json=skinId,proto3" json:"skin_id,omitempty"`
+	Slug            string                 `protobuf:"bytes,3,opt,name=slug,proto3" json:"slug,omitempty"`
+	Condition       string                 `protobuf:"bytes,4,opt,name=condition,proto3" json:"condition,omitempty"`
+	TargetPrice     float64                `protobuf:"fixed64,5,opt,name=target_price,json=targetPrice,proto3" json:"target_price,omitempty"`
+	BasePrice       float64                `protobuf:"fixed64,6,opt,name=base_price,json=basePrice,proto3" json:"base_price,omitempty"`
+	Triggered       bool                   `protobuf:"varint,7,opt,name=triggered,proto3" json:"triggered,omitempty"`
+	LastTriggeredAt string                 `protobuf:"bytes,8,opt,name=last_triggered_at,json=lastTriggeredAt,proto3" json:"last_triggered_at,omitempty"`
+	CreatedAt       string                 `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Source          string                 `protobuf:"bytes,10,opt,name=source,proto3" json:"source,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PriceAlertModel) Reset() {
+	*x = PriceAlertModel{}
+	mi := &file_models_skin_model_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceAlertModel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceAlertModel) ProtoMessage() {}
+
+func (x *PriceAlertModel) ProtoReflect() protoreflect.Message {
+	mi := &file_models_skin_model_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceAlertModel.ProtoReflect.Descriptor instead.
+func (*PriceAlertModel) Descriptor() ([]byte, []int) {
+	return file_models_skin_model_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *PriceAlertModel) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PriceAlertModel) GetSkinId() string {
+	if x != nil {
+		return x.SkinId
+	}
+	return ""
+}
+
+func (x *PriceAlertModel) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *PriceAlertModel) GetCondition() string {
+	if x != nil {
+		return x.Condition
+	}
+	return ""
+}
+
+func (x *PriceAlertModel) GetTargetPrice() float64 {
+	if x != nil {
+		return x.TargetPrice
+	}
+	return 0
+}
+
+func (x *PriceAlertModel) GetBasePrice() float64 {
+	if x != nil {
+		return x.BasePrice
+	}
+	return 0
+}
+
+func (x *PriceAlertModel) GetTriggered() bool {
+	if x != nil {
+		return x.Triggered
+	}
+	return false
+}
+
+func (x *PriceAlertModel) GetLastTriggeredAt() string {
+	if x != nil {
+		return x.LastTriggeredAt
+	}
+	return ""
+}
+
+func (x *PriceAlertModel) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *PriceAlertModel) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+type WebhookModel struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Active        bool                   `protobuf:"varint,3,opt,name=active,proto3" json:"active,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebhookModel) Reset() {
+	*x = WebhookModel{}
+	mi := &file_models_skin_model_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookModel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookModel) ProtoMessage() {}
+
+func (x *WebhookModel) ProtoReflect() protoreflect.Message {
+	mi := &file_models_skin_model_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookModel.ProtoReflect.Descriptor instead.
+func (*WebhookModel) Descriptor() ([]byte, []int) {
+	return file_models_skin_model_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *WebhookModel) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WebhookModel) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *WebhookModel) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *WebhookModel) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type WebhookDeliveryModel struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	WebhookId     string                 `protobuf:"bytes,2,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"`
+	AlertId       string                 `protobuf:"bytes,3,opt,name=alert_id,json=alertId,proto3" json:"alert_id,omitempty"`
+	Url           string                 `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"`
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	Attempts      int32                  `protobuf:"varint,6,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	ResponseCode  int32                  `protobuf:"varint,7,opt,name=response_code,json=responseCode,proto3" json:"response_code,omitempty"`
+	Error         string                 `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	DeliveredAt   string                 `protobuf:"bytes,10,opt,name=delivered_at,json=deliveredAt,proto3" json:"delivered_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebhookDeliveryModel) Reset() {
+	*x = WebhookDeliveryModel{}
+	mi := &file_models_skin_model_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookDeliveryModel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookDeliveryModel) ProtoMessage() {}
+
+func (x *WebhookDeliveryModel) ProtoReflect() protoreflect.Message {
+	mi := &file_models_skin_model_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookDeliveryModel.ProtoReflect.Descriptor instead.
+func (*WebhookDeliveryModel) Descriptor() ([]byte, []int) {
+	return file_models_skin_model_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *WebhookDeliveryModel) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WebhookDeliveryModel) GetWebhookId() string {
+	if x != nil {
+		return x.WebhookId
+	}
+	return ""
+}
+
+func (x *WebhookDeliveryModel) GetAlertId() string {
+	if x != nil {
+		return x.AlertId
+	}
+	return ""
+}
+
+func (x *WebhookDeliveryModel) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *WebhookDeliveryModel) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *WebhookDeliveryModel) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *WebhookDeliveryModel) GetResponseCode() int32 {
+	if x != nil {
+		return x.ResponseCode
+	}
+	return 0
+}
+
+func (x *WebhookDeliveryModel) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *WebhookDeliveryModel) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *WebhookDeliveryModel) GetDeliveredAt() string {
+	if x != nil {
+		return x.DeliveredAt
+	}
+	return ""
+}
+
+type PriceTickModel struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SkinId         string                 `protobuf:"bytes,1,opt,name=skin_id,json=skinId,proto3" json:"skin_id,omitempty"`
+	Slug           string                 `protobuf:"bytes,2,opt,name=slug,proto3" json:"slug,omitempty"`
+	MarketHashName string                 `protobuf:"bytes,3,opt,name=market_hash_name,json=marketHashName,proto3" json:"market_hash_name,omitempty"`
+	Weapon         string                 `protobuf:"bytes,4,opt,name=weapon,proto3" json:"weapon,omitempty"`
+	Source         string                 `protobuf:"bytes,5,opt,name=source,proto3" json:"source,omitempty"`
+	OldPrice       float64                `protobuf:"fixed64,6,opt,name=old_price,json=oldPrice,proto3" json:"old_price,omitempty"`
+	NewPrice       float64                `protobuf:"fixed64,7,opt,name=new_price,json=newPrice,proto3" json:"new_price,omitempty"`
+	Currency       string                 `protobuf:"bytes,8,opt,name=currency,proto3" json:"currency,omitempty"`
+	Volume_24H     int32                  `protobuf:"varint,9,opt,name=volume_24h,json=volume24h,proto3" json:"volume_24h,omitempty"`
+	PriceChange    float64                `protobuf:"fixed64,10,opt,name=price_change,json=priceChange,proto3" json:"price_change,omitempty"`
+	Timestamp      string                 `protobuf:"bytes,11,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// номер события в ленте, одинаковый на всех репликах
+	Seq           uint64 `protobuf:"varint,12,opt,name=seq,proto3" json:"seq,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PriceTickModel) Reset() {
+	*x = PriceTickModel{}
+	mi := &file_models_skin_model_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MarketOverviewModel) String() string {
+func (x *PriceTickModel) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MarketOverviewModel) ProtoMessage() {}
+func (*PriceTickModel) ProtoMessage() {}
 
-func (x *MarketOverviewModel) ProtoReflect() protoreflect.Message {
-	mi := &file_models_skin_model_proto_msgTypes[5]
+func (x *PriceTickModel) ProtoReflect() protoreflect.Message {
+	mi := &file_models_skin_model_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -515,56 +1368,129 @@ func (x *MarketOverviewModel) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MarketOverviewModel.ProtoReflect.Descriptor instead.
-func (*MarketOverviewModel) Descriptor() ([]byte, []int) {
-	return file_models_skin_model_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use PriceTickModel.ProtoReflect.Descriptor instead.
+func (*PriceTickModel) Descriptor() ([]byte, []int) {
+	return file_models_skin_model_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *MarketOverviewModel) GetTotalSkins() int32 {
+func (x *PriceTickModel) GetSkinId() string {
 	if x != nil {
-		return x.TotalSkins
+		return x.SkinId
+	}
+	return ""
+}
+
+func (x *PriceTickModel) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *PriceTickModel) GetMarketHashName() string {
+	if x != nil {
+		return x.MarketHashName
+	}
+	return ""
+}
+
+func (x *PriceTickModel) GetWeapon() string {
+	if x != nil {
+		return x.Weapon
+	}
+	return ""
+}
+
+func (x *PriceTickModel) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *PriceTickModel) GetOldPrice() float64 {
+	if x != nil {
+		return x.OldPrice
 	}
 	return 0
 }
 
-func (x *MarketOverviewModel) GetAvgPrice() float64 {
+func (x *PriceTickModel) GetNewPrice() float64 {
 	if x != nil {
-		return x.AvgPrice
+		return x.NewPrice
 	}
 	return 0
 }
 
-func (x *MarketOverviewModel) GetTotalVolume_24H() int32 {
+func (x *PriceTickModel) GetCurrency() string {
 	if x != nil {
-		return x.TotalVolume_24H
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *PriceTickModel) GetVolume_24H() int32 {
+	if x != nil {
+		return x.Volume_24H
 	}
 	return 0
 }
 
-type TrendingSkinModel struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Rank            int32                  `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"`
-	Skin            *SkinModel             `protobuf:"bytes,2,opt,name=skin,proto3" json:"skin,omitempty"`
-	PriceChangeRate float64                `protobuf:"fixed64,3,opt,name=price_change_rate,json=priceChangeRate,proto3" json:"price_change_rate,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+func (x *PriceTickModel) GetPriceChange() float64 {
+	if x != nil {
+		return x.PriceChange
+	}
+	return 0
 }
 
-func (x *TrendingSkinModel) Reset() {
-	*x = TrendingSkinModel{}
-	mi := &file_models_skin_model_proto_msgTypes[6]
+func (x *PriceTickModel) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+func (x *PriceTickModel) GetSeq() uint64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+// best_price/best_source - где дешевле купить, sell_price/sell_source - где дороже продать,
+// spread_percent - (sell_price - best_price) / best_price * 100.
+type PriceComparisonModel struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SkinId         string                 `protobuf:"bytes,1,opt,name=skin_id,json=skinId,proto3" json:"skin_id,omitempty"`
+	Slug           string                 `protobuf:"bytes,2,opt,name=slug,proto3" json:"slug,omitempty"`
+	MarketHashName string                 `protobuf:"bytes,3,opt,name=market_hash_name,json=marketHashName,proto3" json:"market_hash_name,omitempty"`
+	Prices         map[string]float64     `protobuf:"bytes,4,rep,name=prices,proto3" json:"prices,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"fixed64,2,opt,name=value"`
+	BestPrice      float64                `protobuf:"fixed64,5,opt,name=best_price,json=bestPrice,proto3" json:"best_price,omitempty"`
+	BestSource     string                 `protobuf:"bytes,6,opt,name=best_source,json=bestSource,proto3" json:"best_source,omitempty"`
+	SellPrice      float64                `protobuf:"fixed64,7,opt,name=sell_price,json=sellPrice,proto3" json:"sell_price,omitempty"`
+	SellSource     string                 `protobuf:"bytes,8,opt,name=sell_source,json=sellSource,proto3" json:"sell_source,omitempty"`
+	PriceDiff      float64                `protobuf:"fixed64,9,opt,name=price_diff,json=priceDiff,proto3" json:"price_diff,omitempty"`
+	SpreadPercent  float64                `protobuf:"fixed64,10,opt,name=spread_percent,json=spreadPercent,proto3" json:"spread_percent,omitempty"`
+	UpdatedAt      string                 `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PriceComparisonModel) Reset() {
+	*x = PriceComparisonModel{}
+	mi := &file_models_skin_model_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TrendingSkinModel) String() string {
+func (x *PriceComparisonModel) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TrendingSkinModel) ProtoMessage() {}
+func (*PriceComparisonModel) ProtoMessage() {}
 
-func (x *TrendingSkinModel) ProtoReflect() protoreflect.Message {
-	mi := &file_models_skin_model_proto_msgTypes[6]
+func (x *PriceComparisonModel) ProtoReflect() protoreflect.Message {
+	mi := &file_models_skin_model_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -575,28 +1501,221 @@ func (x *TrendingSkinModel) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TrendingSkinModel.ProtoReflect.Descriptor instead.
-func (*TrendingSkinModel) Descriptor() ([]byte, []int) {
-	return file_models_skin_model_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use PriceComparisonModel.ProtoReflect.Descriptor instead.
+func (*PriceComparisonModel) Descriptor() ([]byte, []int) {
+	return file_models_skin_model_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *TrendingSkinModel) GetRank() int32 {
+func (x *PriceComparisonModel) GetSkinId() string {
 	if x != nil {
-		return x.Rank
+		return x.SkinId
 	}
-	return 0
+	return ""
 }
 
-func (x *TrendingSkinModel) GetSkin() *SkinModel {
+func (x *PriceComparisonModel) GetSlug() string {
 	if x != nil {
-		return x.Skin
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *PriceComparisonModel) GetMarketHashName() string {
+	if x != nil {
+		return x.MarketHashName
+	}
+	return ""
+}
+
+func (x *PriceComparisonModel) GetPrices() map[string]float64 {
+	if x != nil {
+		return x.Prices
 	}
 	return nil
 }
 
-func (x *TrendingSkinModel) GetPriceChangeRate() float64 {
+func (x *PriceComparisonModel) GetBestPrice() float64 {
 	if x != nil {
-		return x.PriceChangeRate
+		return x.BestPrice
+	}
+	return 0
+}
+
+func (x *PriceComparisonModel) GetBestSource() string {
+	if x != nil {
+		return x.BestSource
+	}
+	return ""
+}
+
+func (x *PriceComparisonModel) GetSellPrice() float64 {
+	if x != nil {
+		return x.SellPrice
+	}
+	return 0
+}
+
+func (x *PriceComparisonModel) GetSellSource() string {
+	if x != nil {
+		return x.SellSource
+	}
+	return ""
+}
+
+func (x *PriceComparisonModel) GetPriceDiff() float64 {
+	if x != nil {
+		return x.PriceDiff
+	}
+	return 0
+}
+
+func (x *PriceComparisonModel) GetSpreadPercent() float64 {
+	if x != nil {
+		return x.SpreadPercent
+	}
+	return 0
+}
+
+func (x *PriceComparisonModel) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type AutocompleteSuggestionModel struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Slug           string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	MarketHashName string                 `protobuf:"bytes,2,opt,name=market_hash_name,json=marketHashName,proto3" json:"market_hash_name,omitempty"`
+	Weapon         string                 `protobuf:"bytes,3,opt,name=weapon,proto3" json:"weapon,omitempty"`
+	ImageUrl       string                 `protobuf:"bytes,4,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	Score          float64                `protobuf:"fixed64,5,opt,name=score,proto3" json:"score,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AutocompleteSuggestionModel) Reset() {
+	*x = AutocompleteSuggestionModel{}
+	mi := &file_models_skin_model_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AutocompleteSuggestionModel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AutocompleteSuggestionModel) ProtoMessage() {}
+
+func (x *AutocompleteSuggestionModel) ProtoReflect() protoreflect.Message {
+	mi := &file_models_skin_model_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AutocompleteSuggestionModel.ProtoReflect.Descriptor instead.
+func (*AutocompleteSuggestionModel) Descriptor() ([]byte, []int) {
+	return file_models_skin_model_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *AutocompleteSuggestionModel) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *AutocompleteSuggestionModel) GetMarketHashName() string {
+	if x != nil {
+		return x.MarketHashName
+	}
+	return ""
+}
+
+func (x *AutocompleteSuggestionModel) GetWeapon() string {
+	if x != nil {
+		return x.Weapon
+	}
+	return ""
+}
+
+func (x *AutocompleteSuggestionModel) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+func (x *AutocompleteSuggestionModel) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+type PopularSearchModel struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Query string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Count int64                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	// сколько из count поисков не нашли ни одного скина
+	ZeroResults   int64 `protobuf:"varint,3,opt,name=zero_results,json=zeroResults,proto3" json:"zero_results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PopularSearchModel) Reset() {
+	*x = PopularSearchModel{}
+	mi := &file_models_skin_model_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PopularSearchModel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PopularSearchModel) ProtoMessage() {}
+
+func (x *PopularSearchModel) ProtoReflect() protoreflect.Message {
+	mi := &file_models_skin_model_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PopularSearchModel.ProtoReflect.Descriptor instead.
+func (*PopularSearchModel) Descriptor() ([]byte, []int) {
+	return file_models_skin_model_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *PopularSearchModel) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *PopularSearchModel) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *PopularSearchModel) GetZeroResults() int64 {
+	if x != nil {
+		return x.ZeroResults
 	}
 	return 0
 }
@@ -655,16 +1774,140 @@ const file_models_skin_model_proto_rawDesc = "" +
 	"\x13PriceChartDataModel\x12\x1c\n" +
 	"\ttimestamp\x18\x01 \x01(\tR\ttimestamp\x12\x14\n" +
 	"\x05price\x18\x02 \x01(\x01R\x05price\x12\x16\n" +
-	"\x06volume\x18\x03 \x01(\x05R\x06volume\"}\n" +
+	"\x06volume\x18\x03 \x01(\x05R\x06volume\"\xcb\x01\n" +
+	"\x10PriceCandleModel\x12\x1c\n" +
+	"\ttimestamp\x18\x01 \x01(\tR\ttimestamp\x12\x12\n" +
+	"\x04open\x18\x02 \x01(\x01R\x04open\x12\x12\n" +
+	"\x04high\x18\x03 \x01(\x01R\x04high\x12\x10\n" +
+	"\x03low\x18\x04 \x01(\x01R\x03low\x12\x14\n" +
+	"\x05close\x18\x05 \x01(\x01R\x05close\x12\x1b\n" +
+	"\tavg_price\x18\x06 \x01(\x01R\bavgPrice\x12\x16\n" +
+	"\x06volume\x18\a \x01(\x05R\x06volume\x12\x14\n" +
+	"\x05count\x18\b \x01(\x05R\x05count\"\xe2\x04\n" +
 	"\x13MarketOverviewModel\x12\x1f\n" +
 	"\vtotal_skins\x18\x01 \x01(\x05R\n" +
 	"totalSkins\x12\x1b\n" +
 	"\tavg_price\x18\x02 \x01(\x01R\bavgPrice\x12(\n" +
-	"\x10total_volume_24h\x18\x03 \x01(\x05R\x0etotalVolume24h\"\x83\x01\n" +
+	"\x10total_volume_24h\x18\x03 \x01(\x05R\x0etotalVolume24h\x12;\n" +
+	"\vtop_gainers\x18\x04 \x03(\v2\x1a.skins.models.v1.SkinModelR\n" +
+	"topGainers\x129\n" +
+	"\n" +
+	"top_losers\x18\x05 \x03(\v2\x1a.skins.models.v1.SkinModelR\ttopLosers\x12=\n" +
+	"\fmost_popular\x18\x06 \x03(\v2\x1a.skins.models.v1.SkinModelR\vmostPopular\x12E\n" +
+	"\x10recently_updated\x18\a \x03(\v2\x1a.skins.models.v1.SkinModelR\x0frecentlyUpdated\x12D\n" +
+	"\vby_category\x18\b \x03(\v2#.skins.models.v1.MarketSegmentModelR\n" +
+	"byCategory\x12@\n" +
+	"\tby_rarity\x18\t \x03(\v2#.skins.models.v1.MarketSegmentModelR\bbyRarity\x12>\n" +
+	"\bby_shard\x18\n" +
+	" \x03(\v2#.skins.models.v1.MarketSegmentModelR\abyShard\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\v \x01(\tR\tupdatedAt\"\x8e\x01\n" +
+	"\x12MarketSegmentModel\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"skin_count\x18\x02 \x01(\x05R\tskinCount\x12\x1b\n" +
+	"\tavg_price\x18\x03 \x01(\x01R\bavgPrice\x12(\n" +
+	"\x10total_volume_24h\x18\x04 \x01(\x05R\x0etotalVolume24h\"=\n" +
+	"\x0fFacetCountModel\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\"L\n" +
+	"\x10PriceBucketModel\x12\x10\n" +
+	"\x03min\x18\x01 \x01(\x01R\x03min\x12\x10\n" +
+	"\x03max\x18\x02 \x01(\x01R\x03max\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x05R\x05count\"\xd5\x02\n" +
+	"\x0fSkinFacetsModel\x12:\n" +
+	"\aweapons\x18\x01 \x03(\v2 .skins.models.v1.FacetCountModelR\aweapons\x12>\n" +
+	"\tqualities\x18\x02 \x03(\v2 .skins.models.v1.FacetCountModelR\tqualities\x12<\n" +
+	"\brarities\x18\x03 \x03(\v2 .skins.models.v1.FacetCountModelR\brarities\x12@\n" +
+	"\n" +
+	"categories\x18\x04 \x03(\v2 .skins.models.v1.FacetCountModelR\n" +
+	"categories\x12F\n" +
+	"\rprice_buckets\x18\x05 \x03(\v2!.skins.models.v1.PriceBucketModelR\fpriceBuckets\"\x83\x01\n" +
 	"\x11TrendingSkinModel\x12\x12\n" +
 	"\x04rank\x18\x01 \x01(\x05R\x04rank\x12.\n" +
 	"\x04skin\x18\x02 \x01(\v2\x1a.skins.models.v1.SkinModelR\x04skin\x12*\n" +
-	"\x11price_change_rate\x18\x03 \x01(\x01R\x0fpriceChangeRateB1Z/github.com/kedr891/cs-parser/internal/pb/modelsb\x06proto3"
+	"\x11price_change_rate\x18\x03 \x01(\x01R\x0fpriceChangeRate\"\xaf\x02\n" +
+	"\x0fPriceAlertModel\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\askin_id\x18\x02 \x01(\tR\x06skinId\x12\x12\n" +
+	"\x04slug\x18\x03 \x01(\tR\x04slug\x12\x1c\n" +
+	"\tcondition\x18\x04 \x01(\tR\tcondition\x12!\n" +
+	"\ftarget_price\x18\x05 \x01(\x01R\vtargetPrice\x12\x1d\n" +
+	"\n" +
+	"base_price\x18\x06 \x01(\x01R\tbasePrice\x12\x1c\n" +
+	"\ttriggered\x18\a \x01(\bR\ttriggered\x12*\n" +
+	"\x11last_triggered_at\x18\b \x01(\tR\x0flastTriggeredAt\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\t \x01(\tR\tcreatedAt\x12\x16\n" +
+	"\x06source\x18\n" +
+	" \x01(\tR\x06source\"g\n" +
+	"\fWebhookModel\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12\x16\n" +
+	"\x06active\x18\x03 \x01(\bR\x06active\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\tR\tcreatedAt\"\xa3\x02\n" +
+	"\x14WebhookDeliveryModel\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"webhook_id\x18\x02 \x01(\tR\twebhookId\x12\x19\n" +
+	"\balert_id\x18\x03 \x01(\tR\aalertId\x12\x10\n" +
+	"\x03url\x18\x04 \x01(\tR\x03url\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12\x1a\n" +
+	"\battempts\x18\x06 \x01(\x05R\battempts\x12#\n" +
+	"\rresponse_code\x18\a \x01(\x05R\fresponseCode\x12\x14\n" +
+	"\x05error\x18\b \x01(\tR\x05error\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\t \x01(\tR\tcreatedAt\x12!\n" +
+	"\fdelivered_at\x18\n" +
+	" \x01(\tR\vdeliveredAt\"\xdf\x02\n" +
+	"\x0ePriceTickModel\x12\x17\n" +
+	"\askin_id\x18\x01 \x01(\tR\x06skinId\x12\x12\n" +
+	"\x04slug\x18\x02 \x01(\tR\x04slug\x12(\n" +
+	"\x10market_hash_name\x18\x03 \x01(\tR\x0emarketHashName\x12\x16\n" +
+	"\x06weapon\x18\x04 \x01(\tR\x06weapon\x12\x16\n" +
+	"\x06source\x18\x05 \x01(\tR\x06source\x12\x1b\n" +
+	"\told_price\x18\x06 \x01(\x01R\boldPrice\x12\x1b\n" +
+	"\tnew_price\x18\a \x01(\x01R\bnewPrice\x12\x1a\n" +
+	"\bcurrency\x18\b \x01(\tR\bcurrency\x12\x1d\n" +
+	"\n" +
+	"volume_24h\x18\t \x01(\x05R\tvolume24h\x12!\n" +
+	"\fprice_change\x18\n" +
+	" \x01(\x01R\vpriceChange\x12\x1c\n" +
+	"\ttimestamp\x18\v \x01(\tR\ttimestamp\x12\x10\n" +
+	"\x03seq\x18\f \x01(\x04R\x03seq\"\xd8\x03\n" +
+	"\x14PriceComparisonModel\x12\x17\n" +
+	"\askin_id\x18\x01 \x01(\tR\x06skinId\x12\x12\n" +
+	"\x04slug\x18\x02 \x01(\tR\x04slug\x12(\n" +
+	"\x10market_hash_name\x18\x03 \x01(\tR\x0emarketHashName\x12I\n" +
+	"\x06prices\x18\x04 \x03(\v21.skins.models.v1.PriceComparisonModel.PricesEntryR\x06prices\x12\x1d\n" +
+	"\n" +
+	"best_price\x18\x05 \x01(\x01R\tbestPrice\x12\x1f\n" +
+	"\vbest_source\x18\x06 \x01(\tR\n" +
+	"bestSource\x12\x1d\n" +
+	"\n" +
+	"sell_price\x18\a \x01(\x01R\tsellPrice\x12\x1f\n" +
+	"\vsell_source\x18\b \x01(\tR\n" +
+	"sellSource\x12\x1d\n" +
+	"\n" +
+	"price_diff\x18\t \x01(\x01R\tpriceDiff\x12%\n" +
+	"\x0espread_percent\x18\n" +
+	" \x01(\x01R\rspreadPercent\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\v \x01(\tR\tupdatedAt\x1a9\n" +
+	"\vPricesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value:\x028\x01\"\xa6\x01\n" +
+	"\x1bAutocompleteSuggestionModel\x12\x12\n" +
+	"\x04slug\x18\x01 \x01(\tR\x04slug\x12(\n" +
+	"\x10market_hash_name\x18\x02 \x01(\tR\x0emarketHashName\x12\x16\n" +
+	"\x06weapon\x18\x03 \x01(\tR\x06weapon\x12\x1b\n" +
+	"\timage_url\x18\x04 \x01(\tR\bimageUrl\x12\x14\n" +
+	"\x05score\x18\x05 \x01(\x01R\x05score\"c\n" +
+	"\x12PopularSearchModel\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\x12!\n" +
+	"\fzero_results\x18\x03 \x01(\x03R\vzeroResultsB1Z/github.com/kedr891/cs-parser/internal/pb/modelsb\x06proto3"
 
 var (
 	file_models_skin_model_proto_rawDescOnce sync.Once
@@ -678,26 +1921,52 @@ func file_models_skin_model_proto_rawDescGZIP() []byte {
 	return file_models_skin_model_proto_rawDescData
 }
 
-var file_models_skin_model_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_models_skin_model_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
 var file_models_skin_model_proto_goTypes = []any{
-	(*SkinModel)(nil),           // 0: skins.models.v1.SkinModel
-	(*SkinDetailModel)(nil),     // 1: skins.models.v1.SkinDetailModel
-	(*SkinStatisticsModel)(nil), // 2: skins.models.v1.SkinStatisticsModel
-	(*PriceHistoryModel)(nil),   // 3: skins.models.v1.PriceHistoryModel
-	(*PriceChartDataModel)(nil), // 4: skins.models.v1.PriceChartDataModel
-	(*MarketOverviewModel)(nil), // 5: skins.models.v1.MarketOverviewModel
-	(*TrendingSkinModel)(nil),   // 6: skins.models.v1.TrendingSkinModel
+	(*SkinModel)(nil),                   // 0: skins.models.v1.SkinModel
+	(*SkinDetailModel)(nil),             // 1: skins.models.v1.SkinDetailModel
+	(*SkinStatisticsModel)(nil),         // 2: skins.models.v1.SkinStatisticsModel
+	(*PriceHistoryModel)(nil),           // 3: skins.models.v1.PriceHistoryModel
+	(*PriceChartDataModel)(nil),         // 4: skins.models.v1.PriceChartDataModel
+	(*PriceCandleModel)(nil),            // 5: skins.models.v1.PriceCandleModel
+	(*MarketOverviewModel)(nil),         // 6: skins.models.v1.MarketOverviewModel
+	(*MarketSegmentModel)(nil),          // 7: skins.models.v1.MarketSegmentModel
+	(*FacetCountModel)(nil),             // 8: skins.models.v1.FacetCountModel
+	(*PriceBucketModel)(nil),            // 9: skins.models.v1.PriceBucketModel
+	(*SkinFacetsModel)(nil),             // 10: skins.models.v1.SkinFacetsModel
+	(*TrendingSkinModel)(nil),           // 11: skins.models.v1.TrendingSkinModel
+	(*PriceAlertModel)(nil),             // 12: skins.models.v1.PriceAlertModel
+	(*WebhookModel)(nil),                // 13: skins.models.v1.WebhookModel
+	(*WebhookDeliveryModel)(nil),        // 14: skins.models.v1.WebhookDeliveryModel
+	(*PriceTickModel)(nil),              // 15: skins.models.v1.PriceTickModel
+	(*PriceComparisonModel)(nil),        // 16: skins.models.v1.PriceComparisonModel
+	(*AutocompleteSuggestionModel)(nil), // 17: skins.models.v1.AutocompleteSuggestionModel
+	(*PopularSearchModel)(nil),          // 18: skins.models.v1.PopularSearchModel
+	nil,                                 // 19: skins.models.v1.PriceComparisonModel.PricesEntry
 }
 var file_models_skin_model_proto_depIdxs = []int32{
-	0, // 0: skins.models.v1.SkinDetailModel.skin:type_name -> skins.models.v1.SkinModel
-	2, // 1: skins.models.v1.SkinDetailModel.statistics:type_name -> skins.models.v1.SkinStatisticsModel
-	3, // 2: skins.models.v1.SkinDetailModel.price_history:type_name -> skins.models.v1.PriceHistoryModel
-	0, // 3: skins.models.v1.TrendingSkinModel.skin:type_name -> skins.models.v1.SkinModel
-	4, // [4:4] is the sub-list for method output_type
-	4, // [4:4] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	0,  // 0: skins.models.v1.SkinDetailModel.skin:type_name -> skins.models.v1.SkinModel
+	2,  // 1: skins.models.v1.SkinDetailModel.statistics:type_name -> skins.models.v1.SkinStatisticsModel
+	3,  // 2: skins.models.v1.SkinDetailModel.price_history:type_name -> skins.models.v1.PriceHistoryModel
+	0,  // 3: skins.models.v1.MarketOverviewModel.top_gainers:type_name -> skins.models.v1.SkinModel
+	0,  // 4: skins.models.v1.MarketOverviewModel.top_losers:type_name -> skins.models.v1.SkinModel
+	0,  // 5: skins.models.v1.MarketOverviewModel.most_popular:type_name -> skins.models.v1.SkinModel
+	0,  // 6: skins.models.v1.MarketOverviewModel.recently_updated:type_name -> skins.models.v1.SkinModel
+	7,  // 7: skins.models.v1.MarketOverviewModel.by_category:type_name -> skins.models.v1.MarketSegmentModel
+	7,  // 8: skins.models.v1.MarketOverviewModel.by_rarity:type_name -> skins.models.v1.MarketSegmentModel
+	7,  // 9: skins.models.v1.MarketOverviewModel.by_shard:type_name -> skins.models.v1.MarketSegmentModel
+	8,  // 10: skins.models.v1.SkinFacetsModel.weapons:type_name -> skins.models.v1.FacetCountModel
+	8,  // 11: skins.models.v1.SkinFacetsModel.qualities:type_name -> skins.models.v1.FacetCountModel
+	8,  // 12: skins.models.v1.SkinFacetsModel.rarities:type_name -> skins.models.v1.FacetCountModel
+	8,  // 13: skins.models.v1.SkinFacetsModel.categories:type_name -> skins.models.v1.FacetCountModel
+	9,  // 14: skins.models.v1.SkinFacetsModel.price_buckets:type_name -> skins.models.v1.PriceBucketModel
+	0,  // 15: skins.models.v1.TrendingSkinModel.skin:type_name -> skins.models.v1.SkinModel
+	19, // 16: skins.models.v1.PriceComparisonModel.prices:type_name -> skins.models.v1.PriceComparisonModel.PricesEntry
+	17, // [17:17] is the sub-list for method output_type
+	17, // [17:17] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
 }
 
 func init() { file_models_skin_model_proto_init() }
@@ -711,7 +1980,7 @@ func file_models_skin_model_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_models_skin_model_proto_rawDesc), len(file_models_skin_model_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   7,
+			NumMessages:   20,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -11,6 +11,7 @@ import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -23,35 +24,34 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-type GetSkinsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Weapon        string                 `protobuf:"bytes,1,opt,name=weapon,proto3" json:"weapon,omitempty"`
-	Quality       string                 `protobuf:"bytes,2,opt,name=quality,proto3" json:"quality,omitempty"`
-	MinPrice      float64                `protobuf:"fixed64,3,opt,name=min_price,json=minPrice,proto3" json:"min_price,omitempty"`
-	MaxPrice      float64                `protobuf:"fixed64,4,opt,name=max_price,json=maxPrice,proto3" json:"max_price,omitempty"`
-	Search        string                 `protobuf:"bytes,5,opt,name=search,proto3" json:"search,omitempty"`
-	SortBy        string                 `protobuf:"bytes,6,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
-	SortOrder     string                 `protobuf:"bytes,7,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"`
-	Page          int32                  `protobuf:"varint,8,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,9,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GetSkinsRequest) Reset() {
-	*x = GetSkinsRequest{}
+type CreateSkinRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	MarketHashName string                 `protobuf:"bytes,1,opt,name=market_hash_name,json=marketHashName,proto3" json:"market_hash_name,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Weapon         string                 `protobuf:"bytes,3,opt,name=weapon,proto3" json:"weapon,omitempty"`
+	Quality        string                 `protobuf:"bytes,4,opt,name=quality,proto3" json:"quality,omitempty"`
+	Rarity         string                 `protobuf:"bytes,5,opt,name=rarity,proto3" json:"rarity,omitempty"`
+	CurrentPrice   float64                `protobuf:"fixed64,6,opt,name=current_price,json=currentPrice,proto3" json:"current_price,omitempty"`
+	Currency       string                 `protobuf:"bytes,7,opt,name=currency,proto3" json:"currency,omitempty"`
+	ImageUrl       string                 `protobuf:"bytes,8,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateSkinRequest) Reset() {
+	*x = CreateSkinRequest{}
 	mi := &file_skins_api_skins_proto_msgTypes[0]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSkinsRequest) String() string {
+func (x *CreateSkinRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSkinsRequest) ProtoMessage() {}
+func (*CreateSkinRequest) ProtoMessage() {}
 
-func (x *GetSkinsRequest) ProtoReflect() protoreflect.Message {
+func (x *CreateSkinRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_skins_api_skins_proto_msgTypes[0]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -63,99 +63,89 @@ func (x *GetSkinsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSkinsRequest.ProtoReflect.Descriptor instead.
-func (*GetSkinsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateSkinRequest.ProtoReflect.Descriptor instead.
+func (*CreateSkinRequest) Descriptor() ([]byte, []int) {
 	return file_skins_api_skins_proto_rawDescGZIP(), []int{0}
 }
 
-func (x *GetSkinsRequest) GetWeapon() string {
+func (x *CreateSkinRequest) GetMarketHashName() string {
 	if x != nil {
-		return x.Weapon
+		return x.MarketHashName
 	}
 	return ""
 }
 
-func (x *GetSkinsRequest) GetQuality() string {
+func (x *CreateSkinRequest) GetName() string {
 	if x != nil {
-		return x.Quality
+		return x.Name
 	}
 	return ""
 }
 
-func (x *GetSkinsRequest) GetMinPrice() float64 {
-	if x != nil {
-		return x.MinPrice
-	}
-	return 0
-}
-
-func (x *GetSkinsRequest) GetMaxPrice() float64 {
+func (x *CreateSkinRequest) GetWeapon() string {
 	if x != nil {
-		return x.MaxPrice
+		return x.Weapon
 	}
-	return 0
+	return ""
 }
 
-func (x *GetSkinsRequest) GetSearch() string {
+func (x *CreateSkinRequest) GetQuality() string {
 	if x != nil {
-		return x.Search
+		return x.Quality
 	}
 	return ""
 }
 
-func (x *GetSkinsRequest) GetSortBy() string {
+func (x *CreateSkinRequest) GetRarity() string {
 	if x != nil {
-		return x.SortBy
+		return x.Rarity
 	}
 	return ""
 }
 
-func (x *GetSkinsRequest) GetSortOrder() string {
+func (x *CreateSkinRequest) GetCurrentPrice() float64 {
 	if x != nil {
-		return x.SortOrder
+		return x.CurrentPrice
 	}
-	return ""
+	return 0
 }
 
-func (x *GetSkinsRequest) GetPage() int32 {
+func (x *CreateSkinRequest) GetCurrency() string {
 	if x != nil {
-		return x.Page
+		return x.Currency
 	}
-	return 0
+	return ""
 }
 
-func (x *GetSkinsRequest) GetPageSize() int32 {
+func (x *CreateSkinRequest) GetImageUrl() string {
 	if x != nil {
-		return x.PageSize
+		return x.ImageUrl
 	}
-	return 0
+	return ""
 }
 
-type GetSkinsResponse struct {
+type CreateSkinResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Skins         []*models.SkinModel    `protobuf:"bytes,1,rep,name=skins,proto3" json:"skins,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
-	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	TotalPages    int32                  `protobuf:"varint,5,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+	Skin          *models.SkinModel      `protobuf:"bytes,1,opt,name=skin,proto3" json:"skin,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSkinsResponse) Reset() {
-	*x = GetSkinsResponse{}
+func (x *CreateSkinResponse) Reset() {
+	*x = CreateSkinResponse{}
 	mi := &file_skins_api_skins_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSkinsResponse) String() string {
+func (x *CreateSkinResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSkinsResponse) ProtoMessage() {}
+func (*CreateSkinResponse) ProtoMessage() {}
 
-func (x *GetSkinsResponse) ProtoReflect() protoreflect.Message {
+func (x *CreateSkinResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_skins_api_skins_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -167,68 +157,53 @@ func (x *GetSkinsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSkinsResponse.ProtoReflect.Descriptor instead.
-func (*GetSkinsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateSkinResponse.ProtoReflect.Descriptor instead.
+func (*CreateSkinResponse) Descriptor() ([]byte, []int) {
 	return file_skins_api_skins_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *GetSkinsResponse) GetSkins() []*models.SkinModel {
+func (x *CreateSkinResponse) GetSkin() *models.SkinModel {
 	if x != nil {
-		return x.Skins
+		return x.Skin
 	}
 	return nil
 }
 
-func (x *GetSkinsResponse) GetTotal() int32 {
-	if x != nil {
-		return x.Total
-	}
-	return 0
-}
-
-func (x *GetSkinsResponse) GetPage() int32 {
-	if x != nil {
-		return x.Page
-	}
-	return 0
-}
-
-func (x *GetSkinsResponse) GetPageSize() int32 {
-	if x != nil {
-		return x.PageSize
-	}
-	return 0
-}
-
-func (x *GetSkinsResponse) GetTotalPages() int32 {
+func (x *CreateSkinResponse) GetMessage() string {
 	if x != nil {
-		return x.TotalPages
+		return x.Message
 	}
-	return 0
+	return ""
 }
 
-type GetSkinBySlugRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Slug          string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
-	Period        string                 `protobuf:"bytes,2,opt,name=period,proto3" json:"period,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type SkinUpdate struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	MarketHashName string                 `protobuf:"bytes,1,opt,name=market_hash_name,json=marketHashName,proto3" json:"market_hash_name,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Weapon         string                 `protobuf:"bytes,3,opt,name=weapon,proto3" json:"weapon,omitempty"`
+	Quality        string                 `protobuf:"bytes,4,opt,name=quality,proto3" json:"quality,omitempty"`
+	Rarity         string                 `protobuf:"bytes,5,opt,name=rarity,proto3" json:"rarity,omitempty"`
+	CurrentPrice   float64                `protobuf:"fixed64,6,opt,name=current_price,json=currentPrice,proto3" json:"current_price,omitempty"`
+	Currency       string                 `protobuf:"bytes,7,opt,name=currency,proto3" json:"currency,omitempty"`
+	ImageUrl       string                 `protobuf:"bytes,8,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *GetSkinBySlugRequest) Reset() {
-	*x = GetSkinBySlugRequest{}
+func (x *SkinUpdate) Reset() {
+	*x = SkinUpdate{}
 	mi := &file_skins_api_skins_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSkinBySlugRequest) String() string {
+func (x *SkinUpdate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSkinBySlugRequest) ProtoMessage() {}
+func (*SkinUpdate) ProtoMessage() {}
 
-func (x *GetSkinBySlugRequest) ProtoReflect() protoreflect.Message {
+func (x *SkinUpdate) ProtoReflect() protoreflect.Message {
 	mi := &file_skins_api_skins_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -240,46 +215,90 @@ func (x *GetSkinBySlugRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSkinBySlugRequest.ProtoReflect.Descriptor instead.
-func (*GetSkinBySlugRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SkinUpdate.ProtoReflect.Descriptor instead.
+func (*SkinUpdate) Descriptor() ([]byte, []int) {
 	return file_skins_api_skins_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *GetSkinBySlugRequest) GetSlug() string {
+func (x *SkinUpdate) GetMarketHashName() string {
 	if x != nil {
-		return x.Slug
+		return x.MarketHashName
 	}
 	return ""
 }
 
-func (x *GetSkinBySlugRequest) GetPeriod() string {
+func (x *SkinUpdate) GetName() string {
 	if x != nil {
-		return x.Period
+		return x.Name
 	}
 	return ""
 }
 
-type GetSkinBySlugResponse struct {
-	state         protoimpl.MessageState  `protogen:"open.v1"`
-	Skin          *models.SkinDetailModel `protobuf:"bytes,1,opt,name=skin,proto3" json:"skin,omitempty"`
+func (x *SkinUpdate) GetWeapon() string {
+	if x != nil {
+		return x.Weapon
+	}
+	return ""
+}
+
+func (x *SkinUpdate) GetQuality() string {
+	if x != nil {
+		return x.Quality
+	}
+	return ""
+}
+
+func (x *SkinUpdate) GetRarity() string {
+	if x != nil {
+		return x.Rarity
+	}
+	return ""
+}
+
+func (x *SkinUpdate) GetCurrentPrice() float64 {
+	if x != nil {
+		return x.CurrentPrice
+	}
+	return 0
+}
+
+func (x *SkinUpdate) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *SkinUpdate) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+type UpdateSkinRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Slug          string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	Skin          *SkinUpdate            `protobuf:"bytes,2,opt,name=skin,proto3" json:"skin,omitempty"`
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSkinBySlugResponse) Reset() {
-	*x = GetSkinBySlugResponse{}
+func (x *UpdateSkinRequest) Reset() {
+	*x = UpdateSkinRequest{}
 	mi := &file_skins_api_skins_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSkinBySlugResponse) String() string {
+func (x *UpdateSkinRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSkinBySlugResponse) ProtoMessage() {}
+func (*UpdateSkinRequest) ProtoMessage() {}
 
-func (x *GetSkinBySlugResponse) ProtoReflect() protoreflect.Message {
+func (x *UpdateSkinRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_skins_api_skins_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -291,40 +310,53 @@ func (x *GetSkinBySlugResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSkinBySlugResponse.ProtoReflect.Descriptor instead.
-func (*GetSkinBySlugResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateSkinRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSkinRequest) Descriptor() ([]byte, []int) {
 	return file_skins_api_skins_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *GetSkinBySlugResponse) GetSkin() *models.SkinDetailModel {
+func (x *UpdateSkinRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *UpdateSkinRequest) GetSkin() *SkinUpdate {
 	if x != nil {
 		return x.Skin
 	}
 	return nil
 }
 
-type SearchSkinsRequest struct {
+func (x *UpdateSkinRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type UpdateSkinResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
-	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Skin          *models.SkinModel      `protobuf:"bytes,1,opt,name=skin,proto3" json:"skin,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SearchSkinsRequest) Reset() {
-	*x = SearchSkinsRequest{}
+func (x *UpdateSkinResponse) Reset() {
+	*x = UpdateSkinResponse{}
 	mi := &file_skins_api_skins_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SearchSkinsRequest) String() string {
+func (x *UpdateSkinResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchSkinsRequest) ProtoMessage() {}
+func (*UpdateSkinResponse) ProtoMessage() {}
 
-func (x *SearchSkinsRequest) ProtoReflect() protoreflect.Message {
+func (x *UpdateSkinResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_skins_api_skins_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -336,46 +368,39 @@ func (x *SearchSkinsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchSkinsRequest.ProtoReflect.Descriptor instead.
-func (*SearchSkinsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateSkinResponse.ProtoReflect.Descriptor instead.
+func (*UpdateSkinResponse) Descriptor() ([]byte, []int) {
 	return file_skins_api_skins_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *SearchSkinsRequest) GetQuery() string {
-	if x != nil {
-		return x.Query
-	}
-	return ""
-}
-
-func (x *SearchSkinsRequest) GetLimit() int32 {
+func (x *UpdateSkinResponse) GetSkin() *models.SkinModel {
 	if x != nil {
-		return x.Limit
+		return x.Skin
 	}
-	return 0
+	return nil
 }
 
-type SearchSkinsResponse struct {
+type DeleteSkinRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Skins         []*models.SkinModel    `protobuf:"bytes,1,rep,name=skins,proto3" json:"skins,omitempty"`
+	Slug          string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SearchSkinsResponse) Reset() {
-	*x = SearchSkinsResponse{}
+func (x *DeleteSkinRequest) Reset() {
+	*x = DeleteSkinRequest{}
 	mi := &file_skins_api_skins_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SearchSkinsResponse) String() string {
+func (x *DeleteSkinRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchSkinsResponse) ProtoMessage() {}
+func (*DeleteSkinRequest) ProtoMessage() {}
 
-func (x *SearchSkinsResponse) ProtoReflect() protoreflect.Message {
+func (x *DeleteSkinRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_skins_api_skins_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -387,39 +412,38 @@ func (x *SearchSkinsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchSkinsResponse.ProtoReflect.Descriptor instead.
-func (*SearchSkinsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteSkinRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSkinRequest) Descriptor() ([]byte, []int) {
 	return file_skins_api_skins_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *SearchSkinsResponse) GetSkins() []*models.SkinModel {
+func (x *DeleteSkinRequest) GetSlug() string {
 	if x != nil {
-		return x.Skins
+		return x.Slug
 	}
-	return nil
+	return ""
 }
 
-type GetPopularSkinsRequest struct {
+type DeleteSkinResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPopularSkinsRequest) Reset() {
-	*x = GetPopularSkinsRequest{}
+func (x *DeleteSkinResponse) Reset() {
+	*x = DeleteSkinResponse{}
 	mi := &file_skins_api_skins_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPopularSkinsRequest) String() string {
+func (x *DeleteSkinResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPopularSkinsRequest) ProtoMessage() {}
+func (*DeleteSkinResponse) ProtoMessage() {}
 
-func (x *GetPopularSkinsRequest) ProtoReflect() protoreflect.Message {
+func (x *DeleteSkinResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_skins_api_skins_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -431,40 +455,2048 @@ func (x *GetPopularSkinsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPopularSkinsRequest.ProtoReflect.Descriptor instead.
-func (*GetPopularSkinsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteSkinResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSkinResponse) Descriptor() ([]byte, []int) {
 	return file_skins_api_skins_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *GetPopularSkinsRequest) GetLimit() int32 {
+// keys - slug или id скина, не больше 500 за запрос
+type BatchGetSkinsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Keys          []string               `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetSkinsRequest) Reset() {
+	*x = BatchGetSkinsRequest{}
+	mi := &file_skins_api_skins_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetSkinsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetSkinsRequest) ProtoMessage() {}
+
+func (x *BatchGetSkinsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_skins_api_skins_proto_msgTypes[7]
 	if x != nil {
-		return x.Limit
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-type GetPopularSkinsResponse struct {
+// Deprecated: Use BatchGetSkinsRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetSkinsRequest) Descriptor() ([]byte, []int) {
+	return file_skins_api_skins_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *BatchGetSkinsRequest) GetKeys() []string {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+// results идут в порядке keys; found = false, если скин не найден
+type BatchGetSkinsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Skins         []*models.SkinModel    `protobuf:"bytes,1,rep,name=skins,proto3" json:"skins,omitempty"`
+	Results       []*SkinLookup          `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPopularSkinsResponse) Reset() {
-	*x = GetPopularSkinsResponse{}
-	mi := &file_skins_api_skins_proto_msgTypes[7]
+func (x *BatchGetSkinsResponse) Reset() {
+	*x = BatchGetSkinsResponse{}
+	mi := &file_skins_api_skins_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPopularSkinsResponse) String() string {
+func (x *BatchGetSkinsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPopularSkinsResponse) ProtoMessage() {}
+func (*BatchGetSkinsResponse) ProtoMessage() {}
 
-func (x *GetPopularSkinsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_skins_api_skins_proto_msgTypes[7]
+func (x *BatchGetSkinsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_skins_api_skins_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetSkinsResponse.ProtoReflect.Descriptor instead.
+func (*BatchGetSkinsResponse) Descriptor() ([]byte, []int) {
+	return file_skins_api_skins_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *BatchGetSkinsResponse) GetResults() []*SkinLookup {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type SkinLookup struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Found         bool                   `protobuf:"varint,2,opt,name=found,proto3" json:"found,omitempty"`
+	Skin          *models.SkinModel      `protobuf:"bytes,3,opt,name=skin,proto3" json:"skin,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SkinLookup) Reset() {
+	*x = SkinLookup{}
+	mi := &file_skins_api_skins_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SkinLookup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SkinLookup) ProtoMessage() {}
+
+func (x *SkinLookup) ProtoReflect() protoreflect.Message {
+	mi := &file_skins_api_skins_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SkinLookup.ProtoReflect.Descriptor instead.
+func (*SkinLookup) Descriptor() ([]byte, []int) {
+	return file_skins_api_skins_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SkinLookup) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *SkinLookup) GetFound() bool {
+	if x != nil {
+		return x.Found
+	}
+	return false
+}
+
+func (x *SkinLookup) GetSkin() *models.SkinModel {
+	if x != nil {
+		return x.Skin
+	}
+	return nil
+}
+
+type GetSkinsRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Weapon    string                 `protobuf:"bytes,1,opt,name=weapon,proto3" json:"weapon,omitempty"`
+	Quality   string                 `protobuf:"bytes,2,opt,name=quality,proto3" json:"quality,omitempty"`
+	MinPrice  float64                `protobuf:"fixed64,3,opt,name=min_price,json=minPrice,proto3" json:"min_price,omitempty"`
+	MaxPrice  float64                `protobuf:"fixed64,4,opt,name=max_price,json=maxPrice,proto3" json:"max_price,omitempty"`
+	Search    string                 `protobuf:"bytes,5,opt,name=search,proto3" json:"search,omitempty"`
+	SortBy    string                 `protobuf:"bytes,6,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
+	SortOrder string                 `protobuf:"bytes,7,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"`
+	Page      int32                  `protobuf:"varint,8,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize  int32                  `protobuf:"varint,9,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// next_page_token из предыдущего ответа; sort_by и sort_order должны совпадать, page при этом не учитывается
+	PageToken string `protobuf:"bytes,10,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	Rarity    string `protobuf:"bytes,11,opt,name=rarity,proto3" json:"rarity,omitempty"`
+	// include_facets добавляет в ответ счетчики по текущему фильтру
+	IncludeFacets bool `protobuf:"varint,12,opt,name=include_facets,json=includeFacets,proto3" json:"include_facets,omitempty"`
+	// возрастающие границы ценовых диапазонов фасета; по умолчанию 1, 5, 10, 50, 100, 500, 1000
+	PriceBuckets []float64 `protobuf:"fixed64,13,rep,packed,name=price_buckets,json=priceBuckets,proto3" json:"price_buckets,omitempty"`
+	// списки объединяются с weapon, quality и rarity: подходит любое значение
+	Weapons   []string `protobuf:"bytes,14,rep,name=weapons,proto3" json:"weapons,omitempty"`
+	Qualities []string `protobuf:"bytes,15,rep,name=qualities,proto3" json:"qualities,omitempty"`
+	Rarities  []string `protobuf:"bytes,16,rep,name=rarities,proto3" json:"rarities,omitempty"`
+	// Rifle, Pistol, SMG, Sniper, Shotgun, Machinegun, Knife, Gloves
+	Categories []string `protobuf:"bytes,17,rep,name=categories,proto3" json:"categories,omitempty"`
+	// границы диапазонов включительные
+	MinPriceChange_24H *float64 `protobuf:"fixed64,18,opt,name=min_price_change_24h,json=minPriceChange24h,proto3,oneof" json:"min_price_change_24h,omitempty"`
+	MaxPriceChange_24H *float64 `protobuf:"fixed64,19,opt,name=max_price_change_24h,json=maxPriceChange24h,proto3,oneof" json:"max_price_change_24h,omitempty"`
+	MinPriceChange_7D  *float64 `protobuf:"fixed64,20,opt,name=min_price_change_7d,json=minPriceChange7d,proto3,oneof" json:"min_price_change_7d,omitempty"`
+	MaxPriceChange_7D  *float64 `protobuf:"fixed64,21,opt,name=max_price_change_7d,json=maxPriceChange7d,proto3,oneof" json:"max_price_change_7d,omitempty"`
+	MinVolume_24H      *int32   `protobuf:"varint,22,opt,name=min_volume_24h,json=minVolume24h,proto3,oneof" json:"min_volume_24h,omitempty"`
+	MaxVolume_24H      *int32   `protobuf:"varint,23,opt,name=max_volume_24h,json=maxVolume24h,proto3,oneof" json:"max_volume_24h,omitempty"`
+	// диапазон last_updated в RFC3339
+	UpdatedAfter  string `protobuf:"bytes,24,opt,name=updated_after,json=updatedAfter,proto3" json:"updated_after,omitempty"`
+	UpdatedBefore string `protobuf:"bytes,25,opt,name=updated_before,json=updatedBefore,proto3" json:"updated_before,omitempty"`
+	// true - только StatTrak™/Souvenir, false - без них, не задано - любые
+	Stattrak      *bool `protobuf:"varint,26,opt,name=stattrak,proto3,oneof" json:"stattrak,omitempty"`
+	Souvenir      *bool `protobuf:"varint,27,opt,name=souvenir,proto3,oneof" json:"souvenir,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSkinsRequest) Reset() {
+	*x = GetSkinsRequest{}
+	mi := &file_skins_api_skins_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSkinsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSkinsRequest) ProtoMessage() {}
+
+func (x *GetSkinsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_skins_api_skins_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSkinsRequest.ProtoReflect.Descriptor instead.
+func (*GetSkinsRequest) Descriptor() ([]byte, []int) {
+	return file_skins_api_skins_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetSkinsRequest) GetWeapon() string {
+	if x != nil {
+		return x.Weapon
+	}
+	return ""
+}
+
+func (x *GetSkinsRequest) GetQuality() string {
+	if x != nil {
+		return x.Quality
+	}
+	return ""
+}
+
+func (x *GetSkinsRequest) GetMinPrice() float64 {
+	if x != nil {
+		return x.MinPrice
+	}
+	return 0
+}
+
+func (x *GetSkinsRequest) GetMaxPrice() float64 {
+	if x != nil {
+		return x.MaxPrice
+	}
+	return 0
+}
+
+func (x *GetSkinsRequest) GetSearch() string {
+	if x != nil {
+		return x.Search
+	}
+	return ""
+}
+
+func (x *GetSkinsRequest) GetSortBy() string {
+	if x != nil {
+		return x.SortBy
+	}
+	return ""
+}
+
+func (x *GetSkinsRequest) GetSortOrder() string {
+	if x != nil {
+		return x.SortOrder
+	}
+	return ""
+}
+
+func (x *GetSkinsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetSkinsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetSkinsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *GetSkinsRequest) GetRarity() string {
+	if x != nil {
+		return x.Rarity
+	}
+	return ""
+}
+
+func (x *GetSkinsRequest) GetIncludeFacets() bool {
+	if x != nil {
+		return x.IncludeFacets
+	}
+	return false
+}
+
+func (x *GetSkinsRequest) GetPriceBuckets() []float64 {
+	if x != nil {
+		return x.PriceBuckets
+	}
+	return nil
+}
+
+func (x *GetSkinsRequest) GetWeapons() []string {
+	if x != nil {
+		return x.Weapons
+	}
+	return nil
+}
+
+func (x *GetSkinsRequest) GetQualities() []string {
+	if x != nil {
+		return x.Qualities
+	}
+	return nil
+}
+
+func (x *GetSkinsRequest) GetRarities() []string {
+	if x != nil {
+		return x.Rarities
+	}
+	return nil
+}
+
+func (x *GetSkinsRequest) GetCategories() []string {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *GetSkinsRequest) GetMinPriceChange_24H() float64 {
+	if x != nil && x.MinPriceChange_24H != nil {
+		return *x.MinPriceChange_24H
+	}
+	return 0
+}
+
+func (x *GetSkinsRequest) GetMaxPriceChange_24H() float64 {
+	if x != nil && x.MaxPriceChange_24H != nil {
+		return *x.MaxPriceChange_24H
+	}
+	return 0
+}
+
+func (x *GetSkinsRequest) GetMinPriceChange_7D() float64 {
+	if x != nil && x.MinPriceChange_7D != nil {
+		return *x.MinPriceChange_7D
+	}
+	return 0
+}
+
+func (x *GetSkinsRequest) GetMaxPriceChange_7D() float64 {
+	if x != nil && x.MaxPriceChange_7D != nil {
+		return *x.MaxPriceChange_7D
+	}
+	return 0
+}
+
+func (x *GetSkinsRequest) GetMinVolume_24H() int32 {
+	if x != nil && x.MinVolume_24H != nil {
+		return *x.MinVolume_24H
+	}
+	return 0
+}
+
+func (x *GetSkinsRequest) GetMaxVolume_24H() int32 {
+	if x != nil && x.MaxVolume_24H != nil {
+		return *x.MaxVolume_24H
+	}
+	return 0
+}
+
+func (x *GetSkinsRequest) GetUpdatedAfter() string {
+	if x != nil {
+		return x.UpdatedAfter
+	}
+	return ""
+}
+
+func (x *GetSkinsRequest) GetUpdatedBefore() string {
+	if x != nil {
+		return x.UpdatedBefore
+	}
+	return ""
+}
+
+func (x *GetSkinsRequest) GetStattrak() bool {
+	if x != nil && x.Stattrak != nil {
+		return *x.Stattrak
+	}
+	return false
+}
+
+func (x *GetSkinsRequest) GetSouvenir() bool {
+	if x != nil && x.Souvenir != nil {
+		return *x.Souvenir
+	}
+	return false
+}
+
+type GetSkinsResponse struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Skins      []*models.SkinModel    `protobuf:"bytes,1,rep,name=skins,proto3" json:"skins,omitempty"`
+	Total      int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page       int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize   int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	TotalPages int32                  `protobuf:"varint,5,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+	// пустой на последней странице
+	NextPageToken string                  `protobuf:"bytes,6,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	Facets        *models.SkinFacetsModel `protobuf:"bytes,7,opt,name=facets,proto3" json:"facets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSkinsResponse) Reset() {
+	*x = GetSkinsResponse{}
+	mi := &file_skins_api_skins_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSkinsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSkinsResponse) ProtoMessage() {}
+
+func (x *GetSkinsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_skins_api_skins_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSkinsResponse.ProtoReflect.Descriptor instead.
+func (*GetSkinsResponse) Descriptor() ([]byte, []int) {
+	return file_skins_api_skins_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetSkinsResponse) GetSkins() []*models.SkinModel {
+	if x != nil {
+		return x.Skins
+	}
+	return nil
+}
+
+func (x *GetSkinsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetSkinsResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetSkinsResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetSkinsResponse) GetTotalPages() int32 {
+	if x != nil {
+		return x.TotalPages
+	}
+	return 0
+}
+
+func (x *GetSkinsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *GetSkinsResponse) GetFacets() *models.SkinFacetsModel {
+	if x != nil {
+		return x.Facets
+	}
+	return nil
+}
+
+type GetSkinBySlugRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Slug          string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	Period        string                 `protobuf:"bytes,2,opt,name=period,proto3" json:"period,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSkinBySlugRequest) Reset() {
+	*x = GetSkinBySlugRequest{}
+	mi := &file_skins_api_skins_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSkinBySlugRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSkinBySlugRequest) ProtoMessage() {}
+
+func (x *GetSkinBySlugRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_skins_api_skins_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSkinBySlugRequest.ProtoReflect.Descriptor instead.
+func (*GetSkinBySlugRequest) Descriptor() ([]byte, []int) {
+	return file_skins_api_skins_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetSkinBySlugRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *GetSkinBySlugRequest) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+type GetSkinBySlugResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Skin          *models.SkinDetailModel `protobuf:"bytes,1,opt,name=skin,proto3" json:"skin,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSkinBySlugResponse) Reset() {
+	*x = GetSkinBySlugResponse{}
+	mi := &file_skins_api_skins_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSkinBySlugResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSkinBySlugResponse) ProtoMessage() {}
+
+func (x *GetSkinBySlugResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_skins_api_skins_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSkinBySlugResponse.ProtoReflect.Descriptor instead.
+func (*GetSkinBySlugResponse) Descriptor() ([]byte, []int) {
+	return file_skins_api_skins_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetSkinBySlugResponse) GetSkin() *models.SkinDetailModel {
+	if x != nil {
+		return x.Skin
+	}
+	return nil
+}
+
+// Поиск терпит опечатки и разное написание ("ak47", "ak-47", "ak_47"); выдача отсортирована по релевантности.
+type SearchSkinsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	IncludeFacets bool                   `protobuf:"varint,3,opt,name=include_facets,json=includeFacets,proto3" json:"include_facets,omitempty"`
+	PriceBuckets  []float64              `protobuf:"fixed64,4,rep,packed,name=price_buckets,json=priceBuckets,proto3" json:"price_buckets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchSkinsRequest) Reset() {
+	*x = SearchSkinsRequest{}
+	mi := &file_skins_api_skins_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchSkinsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchSkinsRequest) ProtoMessage() {}
+
+func (x *SearchSkinsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_skins_api_skins_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchSkinsRequest.ProtoReflect.Descriptor instead.
+func (*SearchSkinsRequest) Descriptor() ([]byte, []int) {
+	return file_skins_api_skins_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SearchSkinsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchSkinsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *SearchSkinsRequest) GetIncludeFacets() bool {
+	if x != nil {
+		return x.IncludeFacets
+	}
+	return false
+}
+
+func (x *SearchSkinsRequest) GetPriceBuckets() []float64 {
+	if x != nil {
+		return x.PriceBuckets
+	}
+	return nil
+}
+
+type SearchSkinsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Skins []*models.SkinModel    `protobuf:"bytes,1,rep,name=skins,proto3" json:"skins,omitempty"`
+	// счетчики по всем найденным скинам, а не только по первым limit
+	Facets        *models.SkinFacetsModel `protobuf:"bytes,2,opt,name=facets,proto3" json:"facets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchSkinsResponse) Reset() {
+	*x = SearchSkinsResponse{}
+	mi := &file_skins_api_skins_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchSkinsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchSkinsResponse) ProtoMessage() {}
+
+func (x *SearchSkinsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_skins_api_skins_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchSkinsResponse.ProtoReflect.Descriptor instead.
+func (*SearchSkinsResponse) Descriptor() ([]byte, []int) {
+	return file_skins_api_skins_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SearchSkinsResponse) GetSkins() []*models.SkinModel {
+	if x != nil {
+		return x.Skins
+	}
+	return nil
+}
+
+func (x *SearchSkinsResponse) GetFacets() *models.SkinFacetsModel {
+	if x != nil {
+		return x.Facets
+	}
+	return nil
+}
+
+type AutocompleteRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Q     string                 `protobuf:"bytes,1,opt,name=q,proto3" json:"q,omitempty"`
+	// по умолчанию 10, не больше 50
+	Limit         int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AutocompleteRequest) Reset() {
+	*x = AutocompleteRequest{}
+	mi := &file_skins_api_skins_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AutocompleteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AutocompleteRequest) ProtoMessage() {}
+
+func (x *AutocompleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_skins_api_skins_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AutocompleteRequest.ProtoReflect.Descriptor instead.
+func (*AutocompleteRequest) Descriptor() ([]byte, []int) {
+	return file_skins_api_skins_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *AutocompleteRequest) GetQ() string {
+	if x != nil {
+		return x.Q
+	}
+	return ""
+}
+
+func (x *AutocompleteRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type AutocompleteResponse struct {
+	state         protoimpl.MessageState                `protogen:"open.v1"`
+	Suggestions   []*models.AutocompleteSuggestionModel `protobuf:"bytes,1,rep,name=suggestions,proto3" json:"suggestions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AutocompleteResponse) Reset() {
+	*x = AutocompleteResponse{}
+	mi := &file_skins_api_skins_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AutocompleteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AutocompleteResponse) ProtoMessage() {}
+
+func (x *AutocompleteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_skins_api_skins_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AutocompleteResponse.ProtoReflect.Descriptor instead.
+func (*AutocompleteResponse) Descriptor() ([]byte, []int) {
+	return file_skins_api_skins_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *AutocompleteResponse) GetSuggestions() []*models.AutocompleteSuggestionModel {
+	if x != nil {
+		return x.Suggestions
+	}
+	return nil
+}
+
+type GetPopularSkinsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPopularSkinsRequest) Reset() {
+	*x = GetPopularSkinsRequest{}
+	mi := &file_skins_api_skins_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPopularSkinsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPopularSkinsRequest) ProtoMessage() {}
+
+func (x *GetPopularSkinsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_skins_api_skins_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPopularSkinsRequest.ProtoReflect.Descriptor instead.
+func (*GetPopularSkinsRequest) Descriptor() ([]byte, []int) {
+	return file_skins_api_skins_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetPopularSkinsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetPopularSkinsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Skins         []*models.SkinModel    `protobuf:"bytes,1,rep,name=skins,proto3" json:"skins,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPopularSkinsResponse) Reset() {
+	*x = GetPopularSkinsResponse{}
+	mi := &file_skins_api_skins_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPopularSkinsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPopularSkinsResponse) ProtoMessage() {}
+
+func (x *GetPopularSkinsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_skins_api_skins_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPopularSkinsResponse.ProtoReflect.Descriptor instead.
+func (*GetPopularSkinsResponse) Descriptor() ([]byte, []int) {
+	return file_skins_api_skins_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetPopularSkinsResponse) GetSkins() []*models.SkinModel {
+	if x != nil {
+		return x.Skins
+	}
+	return nil
+}
+
+// interval (5m, 1h, 4h, 1d, 1w) включает OHLCV-свечи. Без него data_points - все точки истории,
+// с ним - цена закрытия каждой свечи. Запрос не может дать больше 2500 свечей, для period all
+// interval не меньше 1d.
+// source - площадка свечей, по умолчанию steam_market.
+type GetPriceChartRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Slug          string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	Period        string                 `protobuf:"bytes,2,opt,name=period,proto3" json:"period,omitempty"`
+	Interval      string                 `protobuf:"bytes,3,opt,name=interval,proto3" json:"interval,omitempty"`
+	Source        string                 `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPriceChartRequest) Reset() {
+	*x = GetPriceChartRequest{}
+	mi := &file_skins_api_skins_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPriceChartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPriceChartRequest) ProtoMessage() {}
+
+func (x *GetPriceChartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_skins_api_skins_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPriceChartRequest.ProtoReflect.Descriptor instead.
+func (*GetPriceChartRequest) Descriptor() ([]byte, []int) {
+	return file_skins_api_skins_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetPriceChartRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *GetPriceChartRequest) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *GetPriceChartRequest) GetInterval() string {
+	if x != nil {
+		return x.Interval
+	}
+	return ""
+}
+
+func (x *GetPriceChartRequest) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+type GetPriceChartResponse struct {
+	state         protoimpl.MessageState        `protogen:"open.v1"`
+	SkinId        string                        `protobuf:"bytes,1,opt,name=skin_id,json=skinId,proto3" json:"skin_id,omitempty"`
+	Period        string                        `protobuf:"bytes,2,opt,name=period,proto3" json:"period,omitempty"`
+	DataPoints    []*models.PriceChartDataModel `protobuf:"bytes,3,rep,name=data_points,json=dataPoints,proto3" json:"data_points,omitempty"`
+	MinPrice      float64                       `protobuf:"fixed64,4,opt,name=min_price,json=minPrice,proto3" json:"min_price,omitempty"`
+	MaxPrice      float64                       `protobuf:"fixed64,5,opt,name=max_price,json=maxPrice,proto3" json:"max_price,omitempty"`
+	AvgPrice      float64                       `protobuf:"fixed64,6,opt,name=avg_price,json=avgPrice,proto3" json:"avg_price,omitempty"`
+	TotalVolume   int32                         `protobuf:"varint,7,opt,name=total_volume,json=totalVolume,proto3" json:"total_volume,omitempty"`
+	Interval      string                        `protobuf:"bytes,8,opt,name=interval,proto3" json:"interval,omitempty"`
+	Candles       []*models.PriceCandleModel    `protobuf:"bytes,9,rep,name=candles,proto3" json:"candles,omitempty"`
+	Source        string                        `protobuf:"bytes,10,opt,name=source,proto3" json:"source,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPriceChartResponse) Reset() {
+	*x = GetPriceChartResponse{}
+	mi := &file_skins_api_skins_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPriceChartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPriceChartResponse) ProtoMessage() {}
+
+func (x *GetPriceChartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_skins_api_skins_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPriceChartResponse.ProtoReflect.Descriptor instead.
+func (*GetPriceChartResponse) Descriptor() ([]byte, []int) {
+	return file_skins_api_skins_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetPriceChartResponse) GetSkinId() string {
+	if x != nil {
+		return x.SkinId
+	}
+	return ""
+}
+
+func (x *GetPriceChartResponse) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *GetPriceChartResponse) GetDataPoints() []*models.PriceChartDataModel {
+	if x != nil {
+		return x.DataPoints
+	}
+	return nil
+}
+
+func (x *GetPriceChartResponse) GetMinPrice() float64 {
+	if x != nil {
+		return x.MinPrice
+	}
+	return 0
+}
+
+func (x *GetPriceChartResponse) GetMaxPrice() float64 {
+	if x != nil {
+		return x.MaxPrice
+	}
+	return 0
+}
+
+func (x *GetPriceChartResponse) GetAvgPrice() float64 {
+	if x != nil {
+		return x.AvgPrice
+	}
+	return 0
+}
+
+func (x *GetPriceChartResponse) GetTotalVolume() int32 {
+	if x != nil {
+		return x.TotalVolume
+	}
+	return 0
+}
+
+func (x *GetPriceChartResponse) GetInterval() string {
+	if x != nil {
+		return x.Interval
+	}
+	return ""
+}
+
+func (x *GetPriceChartResponse) GetCandles() []*models.PriceCandleModel {
+	if x != nil {
+		return x.Candles
+	}
+	return nil
+}
+
+func (x *GetPriceChartResponse) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+// max_age_hours - учитываются только цены не старше, по умолчанию 24.
+type GetPriceComparisonRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Slug          string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	MaxAgeHours   int32                  `protobuf:"varint,2,opt,name=max_age_hours,json=maxAgeHours,proto3" json:"max_age_hours,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPriceComparisonRequest) Reset() {
+	*x = GetPriceComparisonRequest{}
+	mi := &file_skins_api_skins_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPriceComparisonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPriceComparisonRequest) ProtoMessage() {}
+
+func (x *GetPriceComparisonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_skins_api_skins_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPriceComparisonRequest.ProtoReflect.Descriptor instead.
+func (*GetPriceComparisonRequest) Descriptor() ([]byte, []int) {
+	return file_skins_api_skins_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetPriceComparisonRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *GetPriceComparisonRequest) GetMaxAgeHours() int32 {
+	if x != nil {
+		return x.MaxAgeHours
+	}
+	return 0
+}
+
+type GetPriceComparisonResponse struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	Comparison    *models.PriceComparisonModel `protobuf:"bytes,1,opt,name=comparison,proto3" json:"comparison,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPriceComparisonResponse) Reset() {
+	*x = GetPriceComparisonResponse{}
+	mi := &file_skins_api_skins_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPriceComparisonResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPriceComparisonResponse) ProtoMessage() {}
+
+func (x *GetPriceComparisonResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_skins_api_skins_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPriceComparisonResponse.ProtoReflect.Descriptor instead.
+func (*GetPriceComparisonResponse) Descriptor() ([]byte, []int) {
+	return file_skins_api_skins_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetPriceComparisonResponse) GetComparison() *models.PriceComparisonModel {
+	if x != nil {
+		return x.Comparison
+	}
+	return nil
+}
+
+// Нужно указать slugs, weapons или all = true.
+// after_seq - seq последнего полученного обновления: сначала придут сохраненные обновления после него.
+type WatchPricesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Slugs         []string               `protobuf:"bytes,1,rep,name=slugs,proto3" json:"slugs,omitempty"`
+	Weapons       []string               `protobuf:"bytes,2,rep,name=weapons,proto3" json:"weapons,omitempty"`
+	All           bool                   `protobuf:"varint,3,opt,name=all,proto3" json:"all,omitempty"`
+	AfterSeq      uint64                 `protobuf:"varint,5,opt,name=after_seq,json=afterSeq,proto3" json:"after_seq,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchPricesRequest) Reset() {
+	*x = WatchPricesRequest{}
+	mi := &file_skins_api_skins_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchPricesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchPricesRequest) ProtoMessage() {}
+
+func (x *WatchPricesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_skins_api_skins_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchPricesRequest.ProtoReflect.Descriptor instead.
+func (*WatchPricesRequest) Descriptor() ([]byte, []int) {
+	return file_skins_api_skins_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *WatchPricesRequest) GetSlugs() []string {
+	if x != nil {
+		return x.Slugs
+	}
+	return nil
+}
+
+func (x *WatchPricesRequest) GetWeapons() []string {
+	if x != nil {
+		return x.Weapons
+	}
+	return nil
+}
+
+func (x *WatchPricesRequest) GetAll() bool {
+	if x != nil {
+		return x.All
+	}
+	return false
+}
+
+func (x *WatchPricesRequest) GetAfterSeq() uint64 {
+	if x != nil {
+		return x.AfterSeq
+	}
+	return 0
+}
+
+type WatchPricesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tick          *models.PriceTickModel `protobuf:"bytes,1,opt,name=tick,proto3" json:"tick,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchPricesResponse) Reset() {
+	*x = WatchPricesResponse{}
+	mi := &file_skins_api_skins_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchPricesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchPricesResponse) ProtoMessage() {}
+
+func (x *WatchPricesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_skins_api_skins_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchPricesResponse.ProtoReflect.Descriptor instead.
+func (*WatchPricesResponse) Descriptor() ([]byte, []int) {
+	return file_skins_api_skins_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *WatchPricesResponse) GetTick() *models.PriceTickModel {
+	if x != nil {
+		return x.Tick
+	}
+	return nil
+}
+
+// dataset - skins или price_history, format - csv (по умолчанию), ndjson или parquet.
+// from/to (RFC3339) ограничивают recorded_at истории или updated_at каталога, source - только для истории.
+type ExportDataRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Dataset       string                 `protobuf:"bytes,1,opt,name=dataset,proto3" json:"dataset,omitempty"`
+	Format        string                 `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
+	Weapon        string                 `protobuf:"bytes,3,opt,name=weapon,proto3" json:"weapon,omitempty"`
+	Source        string                 `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"`
+	From          string                 `protobuf:"bytes,5,opt,name=from,proto3" json:"from,omitempty"`
+	To            string                 `protobuf:"bytes,6,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportDataRequest) Reset() {
+	*x = ExportDataRequest{}
+	mi := &file_skins_api_skins_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportDataRequest) ProtoMessage() {}
+
+func (x *ExportDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_skins_api_skins_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportDataRequest.ProtoReflect.Descriptor instead.
+func (*ExportDataRequest) Descriptor() ([]byte, []int) {
+	return file_skins_api_skins_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ExportDataRequest) GetDataset() string {
+	if x != nil {
+		return x.Dataset
+	}
+	return ""
+}
+
+func (x *ExportDataRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ExportDataRequest) GetWeapon() string {
+	if x != nil {
+		return x.Weapon
+	}
+	return ""
+}
+
+func (x *ExportDataRequest) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *ExportDataRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *ExportDataRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+// Кусок файла выгрузки: склеенные по порядку data дают файл целиком.
+type ExportDataResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportDataResponse) Reset() {
+	*x = ExportDataResponse{}
+	mi := &file_skins_api_skins_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportDataResponse) ProtoMessage() {}
+
+func (x *ExportDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_skins_api_skins_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportDataResponse.ProtoReflect.Descriptor instead.
+func (*ExportDataResponse) Descriptor() ([]byte, []int) {
+	return file_skins_api_skins_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ExportDataResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type GetTrendingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Period        string                 `protobuf:"bytes,1,opt,name=period,proto3" json:"period,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTrendingRequest) Reset() {
+	*x = GetTrendingRequest{}
+	mi := &file_skins_api_skins_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTrendingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTrendingRequest) ProtoMessage() {}
+
+func (x *GetTrendingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_skins_api_skins_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTrendingRequest.ProtoReflect.Descriptor instead.
+func (*GetTrendingRequest) Descriptor() ([]byte, []int) {
+	return file_skins_api_skins_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetTrendingRequest) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *GetTrendingRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetTrendingResponse struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	TrendingSkins []*models.TrendingSkinModel `protobuf:"bytes,1,rep,name=trending_skins,json=trendingSkins,proto3" json:"trending_skins,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTrendingResponse) Reset() {
+	*x = GetTrendingResponse{}
+	mi := &file_skins_api_skins_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTrendingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTrendingResponse) ProtoMessage() {}
+
+func (x *GetTrendingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_skins_api_skins_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTrendingResponse.ProtoReflect.Descriptor instead.
+func (*GetTrendingResponse) Descriptor() ([]byte, []int) {
+	return file_skins_api_skins_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetTrendingResponse) GetTrendingSkins() []*models.TrendingSkinModel {
+	if x != nil {
+		return x.TrendingSkins
+	}
+	return nil
+}
+
+type GetMarketOverviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMarketOverviewRequest) Reset() {
+	*x = GetMarketOverviewRequest{}
+	mi := &file_skins_api_skins_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMarketOverviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMarketOverviewRequest) ProtoMessage() {}
+
+func (x *GetMarketOverviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_skins_api_skins_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMarketOverviewRequest.ProtoReflect.Descriptor instead.
+func (*GetMarketOverviewRequest) Descriptor() ([]byte, []int) {
+	return file_skins_api_skins_proto_rawDescGZIP(), []int{30}
+}
+
+type GetMarketOverviewResponse struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Overview      *models.MarketOverviewModel `protobuf:"bytes,1,opt,name=overview,proto3" json:"overview,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMarketOverviewResponse) Reset() {
+	*x = GetMarketOverviewResponse{}
+	mi := &file_skins_api_skins_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMarketOverviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMarketOverviewResponse) ProtoMessage() {}
+
+func (x *GetMarketOverviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_skins_api_skins_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMarketOverviewResponse.ProtoReflect.Descriptor instead.
+func (*GetMarketOverviewResponse) Descriptor() ([]byte, []int) {
+	return file_skins_api_skins_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetMarketOverviewResponse) GetOverview() *models.MarketOverviewModel {
+	if x != nil {
+		return x.Overview
+	}
+	return nil
+}
+
+type GetPopularSearchesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// hour или day, по умолчанию day
+	Window string `protobuf:"bytes,1,opt,name=window,proto3" json:"window,omitempty"`
+	// по умолчанию 10, не больше 100
+	Limit         int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPopularSearchesRequest) Reset() {
+	*x = GetPopularSearchesRequest{}
+	mi := &file_skins_api_skins_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPopularSearchesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPopularSearchesRequest) ProtoMessage() {}
+
+func (x *GetPopularSearchesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_skins_api_skins_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPopularSearchesRequest.ProtoReflect.Descriptor instead.
+func (*GetPopularSearchesRequest) Descriptor() ([]byte, []int) {
+	return file_skins_api_skins_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetPopularSearchesRequest) GetWindow() string {
+	if x != nil {
+		return x.Window
+	}
+	return ""
+}
+
+func (x *GetPopularSearchesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetPopularSearchesResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Window string                 `protobuf:"bytes,1,opt,name=window,proto3" json:"window,omitempty"`
+	// начало самой старой корзины окна
+	From               string                       `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	Searches           []*models.PopularSearchModel `protobuf:"bytes,3,rep,name=searches,proto3" json:"searches,omitempty"`
+	ZeroResultSearches []*models.PopularSearchModel `protobuf:"bytes,4,rep,name=zero_result_searches,json=zeroResultSearches,proto3" json:"zero_result_searches,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *GetPopularSearchesResponse) Reset() {
+	*x = GetPopularSearchesResponse{}
+	mi := &file_skins_api_skins_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPopularSearchesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPopularSearchesResponse) ProtoMessage() {}
+
+func (x *GetPopularSearchesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_skins_api_skins_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPopularSearchesResponse.ProtoReflect.Descriptor instead.
+func (*GetPopularSearchesResponse) Descriptor() ([]byte, []int) {
+	return file_skins_api_skins_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetPopularSearchesResponse) GetWindow() string {
+	if x != nil {
+		return x.Window
+	}
+	return ""
+}
+
+func (x *GetPopularSearchesResponse) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *GetPopularSearchesResponse) GetSearches() []*models.PopularSearchModel {
+	if x != nil {
+		return x.Searches
+	}
+	return nil
+}
+
+func (x *GetPopularSearchesResponse) GetZeroResultSearches() []*models.PopularSearchModel {
+	if x != nil {
+		return x.ZeroResultSearches
+	}
+	return nil
+}
+
+type GetTopGainersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTopGainersRequest) Reset() {
+	*x = GetTopGainersRequest{}
+	mi := &file_skins_api_skins_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTopGainersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTopGainersRequest) ProtoMessage() {}
+
+func (x *GetTopGainersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_skins_api_skins_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTopGainersRequest.ProtoReflect.Descriptor instead.
+func (*GetTopGainersRequest) Descriptor() ([]byte, []int) {
+	return file_skins_api_skins_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetTopGainersRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetTopGainersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Skins         []*models.SkinModel    `protobuf:"bytes,1,rep,name=skins,proto3" json:"skins,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTopGainersResponse) Reset() {
+	*x = GetTopGainersResponse{}
+	mi := &file_skins_api_skins_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTopGainersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTopGainersResponse) ProtoMessage() {}
+
+func (x *GetTopGainersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_skins_api_skins_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTopGainersResponse.ProtoReflect.Descriptor instead.
+func (*GetTopGainersResponse) Descriptor() ([]byte, []int) {
+	return file_skins_api_skins_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetTopGainersResponse) GetSkins() []*models.SkinModel {
+	if x != nil {
+		return x.Skins
+	}
+	return nil
+}
+
+type GetTopLosersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTopLosersRequest) Reset() {
+	*x = GetTopLosersRequest{}
+	mi := &file_skins_api_skins_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTopLosersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTopLosersRequest) ProtoMessage() {}
+
+func (x *GetTopLosersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_skins_api_skins_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTopLosersRequest.ProtoReflect.Descriptor instead.
+func (*GetTopLosersRequest) Descriptor() ([]byte, []int) {
+	return file_skins_api_skins_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetTopLosersRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetTopLosersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Skins         []*models.SkinModel    `protobuf:"bytes,1,rep,name=skins,proto3" json:"skins,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTopLosersResponse) Reset() {
+	*x = GetTopLosersResponse{}
+	mi := &file_skins_api_skins_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTopLosersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTopLosersResponse) ProtoMessage() {}
+
+func (x *GetTopLosersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_skins_api_skins_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTopLosersResponse.ProtoReflect.Descriptor instead.
+func (*GetTopLosersResponse) Descriptor() ([]byte, []int) {
+	return file_skins_api_skins_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetTopLosersResponse) GetSkins() []*models.SkinModel {
+	if x != nil {
+		return x.Skins
+	}
+	return nil
+}
+
+// min_price отсекает дешевые предметы, у которых разница в центы дает большой процент.
+type GetPriceSpreadsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	MaxAgeHours   int32                  `protobuf:"varint,2,opt,name=max_age_hours,json=maxAgeHours,proto3" json:"max_age_hours,omitempty"`
+	MinPrice      float64                `protobuf:"fixed64,3,opt,name=min_price,json=minPrice,proto3" json:"min_price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPriceSpreadsRequest) Reset() {
+	*x = GetPriceSpreadsRequest{}
+	mi := &file_skins_api_skins_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPriceSpreadsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPriceSpreadsRequest) ProtoMessage() {}
+
+func (x *GetPriceSpreadsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_skins_api_skins_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPriceSpreadsRequest.ProtoReflect.Descriptor instead.
+func (*GetPriceSpreadsRequest) Descriptor() ([]byte, []int) {
+	return file_skins_api_skins_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetPriceSpreadsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetPriceSpreadsRequest) GetMaxAgeHours() int32 {
+	if x != nil {
+		return x.MaxAgeHours
+	}
+	return 0
+}
+
+func (x *GetPriceSpreadsRequest) GetMinPrice() float64 {
+	if x != nil {
+		return x.MinPrice
+	}
+	return 0
+}
+
+type GetPriceSpreadsResponse struct {
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	Comparisons   []*models.PriceComparisonModel `protobuf:"bytes,1,rep,name=comparisons,proto3" json:"comparisons,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPriceSpreadsResponse) Reset() {
+	*x = GetPriceSpreadsResponse{}
+	mi := &file_skins_api_skins_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPriceSpreadsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPriceSpreadsResponse) ProtoMessage() {}
+
+func (x *GetPriceSpreadsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_skins_api_skins_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPriceSpreadsResponse.ProtoReflect.Descriptor instead.
+func (*GetPriceSpreadsResponse) Descriptor() ([]byte, []int) {
+	return file_skins_api_skins_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetPriceSpreadsResponse) GetComparisons() []*models.PriceComparisonModel {
+	if x != nil {
+		return x.Comparisons
+	}
+	return nil
+}
+
+type RecomputePriceStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecomputePriceStatsRequest) Reset() {
+	*x = RecomputePriceStatsRequest{}
+	mi := &file_skins_api_skins_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecomputePriceStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecomputePriceStatsRequest) ProtoMessage() {}
+
+func (x *RecomputePriceStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_skins_api_skins_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -475,41 +2507,34 @@ func (x *GetPopularSkinsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPopularSkinsResponse.ProtoReflect.Descriptor instead.
-func (*GetPopularSkinsResponse) Descriptor() ([]byte, []int) {
-	return file_skins_api_skins_proto_rawDescGZIP(), []int{7}
-}
-
-func (x *GetPopularSkinsResponse) GetSkins() []*models.SkinModel {
-	if x != nil {
-		return x.Skins
-	}
-	return nil
+// Deprecated: Use RecomputePriceStatsRequest.ProtoReflect.Descriptor instead.
+func (*RecomputePriceStatsRequest) Descriptor() ([]byte, []int) {
+	return file_skins_api_skins_proto_rawDescGZIP(), []int{40}
 }
 
-type GetPriceChartRequest struct {
+type RecomputePriceStatsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Slug          string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
-	Period        string                 `protobuf:"bytes,2,opt,name=period,proto3" json:"period,omitempty"`
+	UpdatedSkins  int32                  `protobuf:"varint,1,opt,name=updated_skins,json=updatedSkins,proto3" json:"updated_skins,omitempty"`
+	DurationMs    int64                  `protobuf:"varint,2,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPriceChartRequest) Reset() {
-	*x = GetPriceChartRequest{}
-	mi := &file_skins_api_skins_proto_msgTypes[8]
+func (x *RecomputePriceStatsResponse) Reset() {
+	*x = RecomputePriceStatsResponse{}
+	mi := &file_skins_api_skins_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPriceChartRequest) String() string {
+func (x *RecomputePriceStatsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPriceChartRequest) ProtoMessage() {}
+func (*RecomputePriceStatsResponse) ProtoMessage() {}
 
-func (x *GetPriceChartRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_skins_api_skins_proto_msgTypes[8]
+func (x *RecomputePriceStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_skins_api_skins_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -520,53 +2545,52 @@ func (x *GetPriceChartRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPriceChartRequest.ProtoReflect.Descriptor instead.
-func (*GetPriceChartRequest) Descriptor() ([]byte, []int) {
-	return file_skins_api_skins_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use RecomputePriceStatsResponse.ProtoReflect.Descriptor instead.
+func (*RecomputePriceStatsResponse) Descriptor() ([]byte, []int) {
+	return file_skins_api_skins_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *GetPriceChartRequest) GetSlug() string {
+func (x *RecomputePriceStatsResponse) GetUpdatedSkins() int32 {
 	if x != nil {
-		return x.Slug
+		return x.UpdatedSkins
 	}
-	return ""
+	return 0
 }
 
-func (x *GetPriceChartRequest) GetPeriod() string {
+func (x *RecomputePriceStatsResponse) GetDurationMs() int64 {
 	if x != nil {
-		return x.Period
+		return x.DurationMs
 	}
-	return ""
+	return 0
 }
 
-type GetPriceChartResponse struct {
-	state         protoimpl.MessageState        `protogen:"open.v1"`
-	SkinId        string                        `protobuf:"bytes,1,opt,name=skin_id,json=skinId,proto3" json:"skin_id,omitempty"`
-	Period        string                        `protobuf:"bytes,2,opt,name=period,proto3" json:"period,omitempty"`
-	DataPoints    []*models.PriceChartDataModel `protobuf:"bytes,3,rep,name=data_points,json=dataPoints,proto3" json:"data_points,omitempty"`
-	MinPrice      float64                       `protobuf:"fixed64,4,opt,name=min_price,json=minPrice,proto3" json:"min_price,omitempty"`
-	MaxPrice      float64                       `protobuf:"fixed64,5,opt,name=max_price,json=maxPrice,proto3" json:"max_price,omitempty"`
-	AvgPrice      float64                       `protobuf:"fixed64,6,opt,name=avg_price,json=avgPrice,proto3" json:"avg_price,omitempty"`
-	TotalVolume   int32                         `protobuf:"varint,7,opt,name=total_volume,json=totalVolume,proto3" json:"total_volume,omitempty"`
+// condition: above, below или change_percent (target_price - порог в процентах от цены на момент создания)
+type CreateAlertRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Slug        string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	Condition   string                 `protobuf:"bytes,2,opt,name=condition,proto3" json:"condition,omitempty"`
+	TargetPrice float64                `protobuf:"fixed64,3,opt,name=target_price,json=targetPrice,proto3" json:"target_price,omitempty"`
+	// площадка, по цене которой проверяется правило; по умолчанию steam_market
+	Source        string `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPriceChartResponse) Reset() {
-	*x = GetPriceChartResponse{}
-	mi := &file_skins_api_skins_proto_msgTypes[9]
+func (x *CreateAlertRequest) Reset() {
+	*x = CreateAlertRequest{}
+	mi := &file_skins_api_skins_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPriceChartResponse) String() string {
+func (x *CreateAlertRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPriceChartResponse) ProtoMessage() {}
+func (*CreateAlertRequest) ProtoMessage() {}
 
-func (x *GetPriceChartResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_skins_api_skins_proto_msgTypes[9]
+func (x *CreateAlertRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_skins_api_skins_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -577,83 +2601,105 @@ func (x *GetPriceChartResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPriceChartResponse.ProtoReflect.Descriptor instead.
-func (*GetPriceChartResponse) Descriptor() ([]byte, []int) {
-	return file_skins_api_skins_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use CreateAlertRequest.ProtoReflect.Descriptor instead.
+func (*CreateAlertRequest) Descriptor() ([]byte, []int) {
+	return file_skins_api_skins_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *GetPriceChartResponse) GetSkinId() string {
+func (x *CreateAlertRequest) GetSlug() string {
 	if x != nil {
-		return x.SkinId
+		return x.Slug
 	}
 	return ""
 }
 
-func (x *GetPriceChartResponse) GetPeriod() string {
+func (x *CreateAlertRequest) GetCondition() string {
 	if x != nil {
-		return x.Period
+		return x.Condition
 	}
 	return ""
 }
 
-func (x *GetPriceChartResponse) GetDataPoints() []*models.PriceChartDataModel {
+func (x *CreateAlertRequest) GetTargetPrice() float64 {
 	if x != nil {
-		return x.DataPoints
+		return x.TargetPrice
 	}
-	return nil
+	return 0
 }
 
-func (x *GetPriceChartResponse) GetMinPrice() float64 {
+func (x *CreateAlertRequest) GetSource() string {
 	if x != nil {
-		return x.MinPrice
+		return x.Source
 	}
-	return 0
+	return ""
 }
 
-func (x *GetPriceChartResponse) GetMaxPrice() float64 {
-	if x != nil {
-		return x.MaxPrice
-	}
-	return 0
+type CreateAlertResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Alert         *models.PriceAlertModel `protobuf:"bytes,1,opt,name=alert,proto3" json:"alert,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPriceChartResponse) GetAvgPrice() float64 {
+func (x *CreateAlertResponse) Reset() {
+	*x = CreateAlertResponse{}
+	mi := &file_skins_api_skins_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAlertResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAlertResponse) ProtoMessage() {}
+
+func (x *CreateAlertResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_skins_api_skins_proto_msgTypes[43]
 	if x != nil {
-		return x.AvgPrice
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *GetPriceChartResponse) GetTotalVolume() int32 {
+// Deprecated: Use CreateAlertResponse.ProtoReflect.Descriptor instead.
+func (*CreateAlertResponse) Descriptor() ([]byte, []int) {
+	return file_skins_api_skins_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *CreateAlertResponse) GetAlert() *models.PriceAlertModel {
 	if x != nil {
-		return x.TotalVolume
+		return x.Alert
 	}
-	return 0
+	return nil
 }
 
-type GetTrendingRequest struct {
+type ListAlertsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Period        string                 `protobuf:"bytes,1,opt,name=period,proto3" json:"period,omitempty"`
-	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Slug          string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTrendingRequest) Reset() {
-	*x = GetTrendingRequest{}
-	mi := &file_skins_api_skins_proto_msgTypes[10]
+func (x *ListAlertsRequest) Reset() {
+	*x = ListAlertsRequest{}
+	mi := &file_skins_api_skins_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTrendingRequest) String() string {
+func (x *ListAlertsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTrendingRequest) ProtoMessage() {}
+func (*ListAlertsRequest) ProtoMessage() {}
 
-func (x *GetTrendingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_skins_api_skins_proto_msgTypes[10]
+func (x *ListAlertsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_skins_api_skins_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -664,47 +2710,40 @@ func (x *GetTrendingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTrendingRequest.ProtoReflect.Descriptor instead.
-func (*GetTrendingRequest) Descriptor() ([]byte, []int) {
-	return file_skins_api_skins_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use ListAlertsRequest.ProtoReflect.Descriptor instead.
+func (*ListAlertsRequest) Descriptor() ([]byte, []int) {
+	return file_skins_api_skins_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *GetTrendingRequest) GetPeriod() string {
+func (x *ListAlertsRequest) GetSlug() string {
 	if x != nil {
-		return x.Period
+		return x.Slug
 	}
 	return ""
 }
 
-func (x *GetTrendingRequest) GetLimit() int32 {
-	if x != nil {
-		return x.Limit
-	}
-	return 0
-}
-
-type GetTrendingResponse struct {
-	state         protoimpl.MessageState      `protogen:"open.v1"`
-	TrendingSkins []*models.TrendingSkinModel `protobuf:"bytes,1,rep,name=trending_skins,json=trendingSkins,proto3" json:"trending_skins,omitempty"`
+type ListAlertsResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Alerts        []*models.PriceAlertModel `protobuf:"bytes,1,rep,name=alerts,proto3" json:"alerts,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTrendingResponse) Reset() {
-	*x = GetTrendingResponse{}
-	mi := &file_skins_api_skins_proto_msgTypes[11]
+func (x *ListAlertsResponse) Reset() {
+	*x = ListAlertsResponse{}
+	mi := &file_skins_api_skins_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTrendingResponse) String() string {
+func (x *ListAlertsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTrendingResponse) ProtoMessage() {}
+func (*ListAlertsResponse) ProtoMessage() {}
 
-func (x *GetTrendingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_skins_api_skins_proto_msgTypes[11]
+func (x *ListAlertsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_skins_api_skins_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -715,39 +2754,40 @@ func (x *GetTrendingResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTrendingResponse.ProtoReflect.Descriptor instead.
-func (*GetTrendingResponse) Descriptor() ([]byte, []int) {
-	return file_skins_api_skins_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use ListAlertsResponse.ProtoReflect.Descriptor instead.
+func (*ListAlertsResponse) Descriptor() ([]byte, []int) {
+	return file_skins_api_skins_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *GetTrendingResponse) GetTrendingSkins() []*models.TrendingSkinModel {
+func (x *ListAlertsResponse) GetAlerts() []*models.PriceAlertModel {
 	if x != nil {
-		return x.TrendingSkins
+		return x.Alerts
 	}
 	return nil
 }
 
-type GetMarketOverviewRequest struct {
+type DeleteAlertRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMarketOverviewRequest) Reset() {
-	*x = GetMarketOverviewRequest{}
-	mi := &file_skins_api_skins_proto_msgTypes[12]
+func (x *DeleteAlertRequest) Reset() {
+	*x = DeleteAlertRequest{}
+	mi := &file_skins_api_skins_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMarketOverviewRequest) String() string {
+func (x *DeleteAlertRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMarketOverviewRequest) ProtoMessage() {}
+func (*DeleteAlertRequest) ProtoMessage() {}
 
-func (x *GetMarketOverviewRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_skins_api_skins_proto_msgTypes[12]
+func (x *DeleteAlertRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_skins_api_skins_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -758,33 +2798,39 @@ func (x *GetMarketOverviewRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMarketOverviewRequest.ProtoReflect.Descriptor instead.
-func (*GetMarketOverviewRequest) Descriptor() ([]byte, []int) {
-	return file_skins_api_skins_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use DeleteAlertRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAlertRequest) Descriptor() ([]byte, []int) {
+	return file_skins_api_skins_proto_rawDescGZIP(), []int{46}
 }
 
-type GetMarketOverviewResponse struct {
-	state         protoimpl.MessageState      `protogen:"open.v1"`
-	Overview      *models.MarketOverviewModel `protobuf:"bytes,1,opt,name=overview,proto3" json:"overview,omitempty"`
+func (x *DeleteAlertRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteAlertResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMarketOverviewResponse) Reset() {
-	*x = GetMarketOverviewResponse{}
-	mi := &file_skins_api_skins_proto_msgTypes[13]
+func (x *DeleteAlertResponse) Reset() {
+	*x = DeleteAlertResponse{}
+	mi := &file_skins_api_skins_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMarketOverviewResponse) String() string {
+func (x *DeleteAlertResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMarketOverviewResponse) ProtoMessage() {}
+func (*DeleteAlertResponse) ProtoMessage() {}
 
-func (x *GetMarketOverviewResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_skins_api_skins_proto_msgTypes[13]
+func (x *DeleteAlertResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_skins_api_skins_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -795,40 +2841,35 @@ func (x *GetMarketOverviewResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMarketOverviewResponse.ProtoReflect.Descriptor instead.
-func (*GetMarketOverviewResponse) Descriptor() ([]byte, []int) {
-	return file_skins_api_skins_proto_rawDescGZIP(), []int{13}
-}
-
-func (x *GetMarketOverviewResponse) GetOverview() *models.MarketOverviewModel {
-	if x != nil {
-		return x.Overview
-	}
-	return nil
+// Deprecated: Use DeleteAlertResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAlertResponse) Descriptor() ([]byte, []int) {
+	return file_skins_api_skins_proto_rawDescGZIP(), []int{47}
 }
 
-type GetTopGainersRequest struct {
+// secret можно не передавать - тогда он будет сгенерирован; секрет возвращается только при регистрации
+type RegisterWebhookRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Secret        string                 `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTopGainersRequest) Reset() {
-	*x = GetTopGainersRequest{}
-	mi := &file_skins_api_skins_proto_msgTypes[14]
+func (x *RegisterWebhookRequest) Reset() {
+	*x = RegisterWebhookRequest{}
+	mi := &file_skins_api_skins_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTopGainersRequest) String() string {
+func (x *RegisterWebhookRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTopGainersRequest) ProtoMessage() {}
+func (*RegisterWebhookRequest) ProtoMessage() {}
 
-func (x *GetTopGainersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_skins_api_skins_proto_msgTypes[14]
+func (x *RegisterWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_skins_api_skins_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -839,40 +2880,48 @@ func (x *GetTopGainersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTopGainersRequest.ProtoReflect.Descriptor instead.
-func (*GetTopGainersRequest) Descriptor() ([]byte, []int) {
-	return file_skins_api_skins_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use RegisterWebhookRequest.ProtoReflect.Descriptor instead.
+func (*RegisterWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_skins_api_skins_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *GetTopGainersRequest) GetLimit() int32 {
+func (x *RegisterWebhookRequest) GetUrl() string {
 	if x != nil {
-		return x.Limit
+		return x.Url
 	}
-	return 0
+	return ""
 }
 
-type GetTopGainersResponse struct {
+func (x *RegisterWebhookRequest) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+type RegisterWebhookResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Skins         []*models.SkinModel    `protobuf:"bytes,1,rep,name=skins,proto3" json:"skins,omitempty"`
+	Webhook       *models.WebhookModel   `protobuf:"bytes,1,opt,name=webhook,proto3" json:"webhook,omitempty"`
+	Secret        string                 `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTopGainersResponse) Reset() {
-	*x = GetTopGainersResponse{}
-	mi := &file_skins_api_skins_proto_msgTypes[15]
+func (x *RegisterWebhookResponse) Reset() {
+	*x = RegisterWebhookResponse{}
+	mi := &file_skins_api_skins_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTopGainersResponse) String() string {
+func (x *RegisterWebhookResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTopGainersResponse) ProtoMessage() {}
+func (*RegisterWebhookResponse) ProtoMessage() {}
 
-func (x *GetTopGainersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_skins_api_skins_proto_msgTypes[15]
+func (x *RegisterWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_skins_api_skins_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -883,40 +2932,48 @@ func (x *GetTopGainersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTopGainersResponse.ProtoReflect.Descriptor instead.
-func (*GetTopGainersResponse) Descriptor() ([]byte, []int) {
-	return file_skins_api_skins_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use RegisterWebhookResponse.ProtoReflect.Descriptor instead.
+func (*RegisterWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_skins_api_skins_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *GetTopGainersResponse) GetSkins() []*models.SkinModel {
+func (x *RegisterWebhookResponse) GetWebhook() *models.WebhookModel {
 	if x != nil {
-		return x.Skins
+		return x.Webhook
 	}
 	return nil
 }
 
-type GetTopLosersRequest struct {
+func (x *RegisterWebhookResponse) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+type ListDeliveriesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	WebhookId     string                 `protobuf:"bytes,1,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTopLosersRequest) Reset() {
-	*x = GetTopLosersRequest{}
-	mi := &file_skins_api_skins_proto_msgTypes[16]
+func (x *ListDeliveriesRequest) Reset() {
+	*x = ListDeliveriesRequest{}
+	mi := &file_skins_api_skins_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTopLosersRequest) String() string {
+func (x *ListDeliveriesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTopLosersRequest) ProtoMessage() {}
+func (*ListDeliveriesRequest) ProtoMessage() {}
 
-func (x *GetTopLosersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_skins_api_skins_proto_msgTypes[16]
+func (x *ListDeliveriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_skins_api_skins_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -927,40 +2984,47 @@ func (x *GetTopLosersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTopLosersRequest.ProtoReflect.Descriptor instead.
-func (*GetTopLosersRequest) Descriptor() ([]byte, []int) {
-	return file_skins_api_skins_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use ListDeliveriesRequest.ProtoReflect.Descriptor instead.
+func (*ListDeliveriesRequest) Descriptor() ([]byte, []int) {
+	return file_skins_api_skins_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *GetTopLosersRequest) GetLimit() int32 {
+func (x *ListDeliveriesRequest) GetWebhookId() string {
+	if x != nil {
+		return x.WebhookId
+	}
+	return ""
+}
+
+func (x *ListDeliveriesRequest) GetLimit() int32 {
 	if x != nil {
 		return x.Limit
 	}
 	return 0
 }
 
-type GetTopLosersResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Skins         []*models.SkinModel    `protobuf:"bytes,1,rep,name=skins,proto3" json:"skins,omitempty"`
+type ListDeliveriesResponse struct {
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	Deliveries    []*models.WebhookDeliveryModel `protobuf:"bytes,1,rep,name=deliveries,proto3" json:"deliveries,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTopLosersResponse) Reset() {
-	*x = GetTopLosersResponse{}
-	mi := &file_skins_api_skins_proto_msgTypes[17]
+func (x *ListDeliveriesResponse) Reset() {
+	*x = ListDeliveriesResponse{}
+	mi := &file_skins_api_skins_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTopLosersResponse) String() string {
+func (x *ListDeliveriesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTopLosersResponse) ProtoMessage() {}
+func (*ListDeliveriesResponse) ProtoMessage() {}
 
-func (x *GetTopLosersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_skins_api_skins_proto_msgTypes[17]
+func (x *ListDeliveriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_skins_api_skins_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -971,14 +3035,14 @@ func (x *GetTopLosersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTopLosersResponse.ProtoReflect.Descriptor instead.
-func (*GetTopLosersResponse) Descriptor() ([]byte, []int) {
-	return file_skins_api_skins_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use ListDeliveriesResponse.ProtoReflect.Descriptor instead.
+func (*ListDeliveriesResponse) Descriptor() ([]byte, []int) {
+	return file_skins_api_skins_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *GetTopLosersResponse) GetSkins() []*models.SkinModel {
+func (x *ListDeliveriesResponse) GetDeliveries() []*models.WebhookDeliveryModel {
 	if x != nil {
-		return x.Skins
+		return x.Deliveries
 	}
 	return nil
 }
@@ -987,7 +3051,48 @@ var File_skins_api_skins_proto protoreflect.FileDescriptor
 
 const file_skins_api_skins_proto_rawDesc = "" +
 	"\n" +
-	"\x15skins_api/skins.proto\x12\x10skins.service.v1\x1a\x17models/skin_model.proto\x1a\x1cgoogle/api/annotations.proto\"\xfe\x01\n" +
+	"\x15skins_api/skins.proto\x12\x10skins.service.v1\x1a\x17models/skin_model.proto\x1a\x1cgoogle/api/annotations.proto\x1a google/protobuf/field_mask.proto\"\xf9\x01\n" +
+	"\x11CreateSkinRequest\x12(\n" +
+	"\x10market_hash_name\x18\x01 \x01(\tR\x0emarketHashName\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06weapon\x18\x03 \x01(\tR\x06weapon\x12\x18\n" +
+	"\aquality\x18\x04 \x01(\tR\aquality\x12\x16\n" +
+	"\x06rarity\x18\x05 \x01(\tR\x06rarity\x12#\n" +
+	"\rcurrent_price\x18\x06 \x01(\x01R\fcurrentPrice\x12\x1a\n" +
+	"\bcurrency\x18\a \x01(\tR\bcurrency\x12\x1b\n" +
+	"\timage_url\x18\b \x01(\tR\bimageUrl\"^\n" +
+	"\x12CreateSkinResponse\x12.\n" +
+	"\x04skin\x18\x01 \x01(\v2\x1a.skins.models.v1.SkinModelR\x04skin\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\xf2\x01\n" +
+	"\n" +
+	"SkinUpdate\x12(\n" +
+	"\x10market_hash_name\x18\x01 \x01(\tR\x0emarketHashName\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06weapon\x18\x03 \x01(\tR\x06weapon\x12\x18\n" +
+	"\aquality\x18\x04 \x01(\tR\aquality\x12\x16\n" +
+	"\x06rarity\x18\x05 \x01(\tR\x06rarity\x12#\n" +
+	"\rcurrent_price\x18\x06 \x01(\x01R\fcurrentPrice\x12\x1a\n" +
+	"\bcurrency\x18\a \x01(\tR\bcurrency\x12\x1b\n" +
+	"\timage_url\x18\b \x01(\tR\bimageUrl\"\x96\x01\n" +
+	"\x11UpdateSkinRequest\x12\x12\n" +
+	"\x04slug\x18\x01 \x01(\tR\x04slug\x120\n" +
+	"\x04skin\x18\x02 \x01(\v2\x1c.skins.service.v1.SkinUpdateR\x04skin\x12;\n" +
+	"\vupdate_mask\x18\x03 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\"D\n" +
+	"\x12UpdateSkinResponse\x12.\n" +
+	"\x04skin\x18\x01 \x01(\v2\x1a.skins.models.v1.SkinModelR\x04skin\"'\n" +
+	"\x11DeleteSkinRequest\x12\x12\n" +
+	"\x04slug\x18\x01 \x01(\tR\x04slug\"\x14\n" +
+	"\x12DeleteSkinResponse\"*\n" +
+	"\x14BatchGetSkinsRequest\x12\x12\n" +
+	"\x04keys\x18\x01 \x03(\tR\x04keys\"O\n" +
+	"\x15BatchGetSkinsResponse\x126\n" +
+	"\aresults\x18\x01 \x03(\v2\x1c.skins.service.v1.SkinLookupR\aresults\"d\n" +
+	"\n" +
+	"SkinLookup\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05found\x18\x02 \x01(\bR\x05found\x12.\n" +
+	"\x04skin\x18\x03 \x01(\v2\x1a.skins.models.v1.SkinModelR\x04skin\"\xcf\b\n" +
 	"\x0fGetSkinsRequest\x12\x16\n" +
 	"\x06weapon\x18\x01 \x01(\tR\x06weapon\x12\x18\n" +
 	"\aquality\x18\x02 \x01(\tR\aquality\x12\x1b\n" +
@@ -998,31 +3103,73 @@ const file_skins_api_skins_proto_rawDesc = "" +
 	"\n" +
 	"sort_order\x18\a \x01(\tR\tsortOrder\x12\x12\n" +
 	"\x04page\x18\b \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\t \x01(\x05R\bpageSize\"\xac\x01\n" +
+	"\tpage_size\x18\t \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\n" +
+	" \x01(\tR\tpageToken\x12\x16\n" +
+	"\x06rarity\x18\v \x01(\tR\x06rarity\x12%\n" +
+	"\x0einclude_facets\x18\f \x01(\bR\rincludeFacets\x12#\n" +
+	"\rprice_buckets\x18\r \x03(\x01R\fpriceBuckets\x12\x18\n" +
+	"\aweapons\x18\x0e \x03(\tR\aweapons\x12\x1c\n" +
+	"\tqualities\x18\x0f \x03(\tR\tqualities\x12\x1a\n" +
+	"\brarities\x18\x10 \x03(\tR\brarities\x12\x1e\n" +
+	"\n" +
+	"categories\x18\x11 \x03(\tR\n" +
+	"categories\x124\n" +
+	"\x14min_price_change_24h\x18\x12 \x01(\x01H\x00R\x11minPriceChange24h\x88\x01\x01\x124\n" +
+	"\x14max_price_change_24h\x18\x13 \x01(\x01H\x01R\x11maxPriceChange24h\x88\x01\x01\x122\n" +
+	"\x13min_price_change_7d\x18\x14 \x01(\x01H\x02R\x10minPriceChange7d\x88\x01\x01\x122\n" +
+	"\x13max_price_change_7d\x18\x15 \x01(\x01H\x03R\x10maxPriceChange7d\x88\x01\x01\x12)\n" +
+	"\x0emin_volume_24h\x18\x16 \x01(\x05H\x04R\fminVolume24h\x88\x01\x01\x12)\n" +
+	"\x0emax_volume_24h\x18\x17 \x01(\x05H\x05R\fmaxVolume24h\x88\x01\x01\x12#\n" +
+	"\rupdated_after\x18\x18 \x01(\tR\fupdatedAfter\x12%\n" +
+	"\x0eupdated_before\x18\x19 \x01(\tR\rupdatedBefore\x12\x1f\n" +
+	"\bstattrak\x18\x1a \x01(\bH\x06R\bstattrak\x88\x01\x01\x12\x1f\n" +
+	"\bsouvenir\x18\x1b \x01(\bH\aR\bsouvenir\x88\x01\x01B\x17\n" +
+	"\x15_min_price_change_24hB\x17\n" +
+	"\x15_max_price_change_24hB\x16\n" +
+	"\x14_min_price_change_7dB\x16\n" +
+	"\x14_max_price_change_7dB\x11\n" +
+	"\x0f_min_volume_24hB\x11\n" +
+	"\x0f_max_volume_24hB\v\n" +
+	"\t_stattrakB\v\n" +
+	"\t_souvenir\"\x8e\x02\n" +
 	"\x10GetSkinsResponse\x120\n" +
 	"\x05skins\x18\x01 \x03(\v2\x1a.skins.models.v1.SkinModelR\x05skins\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
 	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
 	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\x12\x1f\n" +
 	"\vtotal_pages\x18\x05 \x01(\x05R\n" +
-	"totalPages\"B\n" +
+	"totalPages\x12&\n" +
+	"\x0fnext_page_token\x18\x06 \x01(\tR\rnextPageToken\x128\n" +
+	"\x06facets\x18\a \x01(\v2 .skins.models.v1.SkinFacetsModelR\x06facets\"B\n" +
 	"\x14GetSkinBySlugRequest\x12\x12\n" +
 	"\x04slug\x18\x01 \x01(\tR\x04slug\x12\x16\n" +
 	"\x06period\x18\x02 \x01(\tR\x06period\"M\n" +
 	"\x15GetSkinBySlugResponse\x124\n" +
-	"\x04skin\x18\x01 \x01(\v2 .skins.models.v1.SkinDetailModelR\x04skin\"@\n" +
+	"\x04skin\x18\x01 \x01(\v2 .skins.models.v1.SkinDetailModelR\x04skin\"\x8c\x01\n" +
 	"\x12SearchSkinsRequest\x12\x14\n" +
 	"\x05query\x18\x01 \x01(\tR\x05query\x12\x14\n" +
-	"\x05limit\x18\x02 \x01(\x05R\x05limit\"G\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12%\n" +
+	"\x0einclude_facets\x18\x03 \x01(\bR\rincludeFacets\x12#\n" +
+	"\rprice_buckets\x18\x04 \x03(\x01R\fpriceBuckets\"\x81\x01\n" +
 	"\x13SearchSkinsResponse\x120\n" +
-	"\x05skins\x18\x01 \x03(\v2\x1a.skins.models.v1.SkinModelR\x05skins\".\n" +
+	"\x05skins\x18\x01 \x03(\v2\x1a.skins.models.v1.SkinModelR\x05skins\x128\n" +
+	"\x06facets\x18\x02 \x01(\v2 .skins.models.v1.SkinFacetsModelR\x06facets\"9\n" +
+	"\x13AutocompleteRequest\x12\f\n" +
+	"\x01q\x18\x01 \x01(\tR\x01q\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"f\n" +
+	"\x14AutocompleteResponse\x12N\n" +
+	"\vsuggestions\x18\x01 \x03(\v2,.skins.models.v1.AutocompleteSuggestionModelR\vsuggestions\".\n" +
 	"\x16GetPopularSkinsRequest\x12\x14\n" +
 	"\x05limit\x18\x01 \x01(\x05R\x05limit\"K\n" +
 	"\x17GetPopularSkinsResponse\x120\n" +
-	"\x05skins\x18\x01 \x03(\v2\x1a.skins.models.v1.SkinModelR\x05skins\"B\n" +
+	"\x05skins\x18\x01 \x03(\v2\x1a.skins.models.v1.SkinModelR\x05skins\"v\n" +
 	"\x14GetPriceChartRequest\x12\x12\n" +
 	"\x04slug\x18\x01 \x01(\tR\x04slug\x12\x16\n" +
-	"\x06period\x18\x02 \x01(\tR\x06period\"\x89\x02\n" +
+	"\x06period\x18\x02 \x01(\tR\x06period\x12\x1a\n" +
+	"\binterval\x18\x03 \x01(\tR\binterval\x12\x16\n" +
+	"\x06source\x18\x04 \x01(\tR\x06source\"\xfa\x02\n" +
 	"\x15GetPriceChartResponse\x12\x17\n" +
 	"\askin_id\x18\x01 \x01(\tR\x06skinId\x12\x16\n" +
 	"\x06period\x18\x02 \x01(\tR\x06period\x12E\n" +
@@ -1031,7 +3178,34 @@ const file_skins_api_skins_proto_rawDesc = "" +
 	"\tmin_price\x18\x04 \x01(\x01R\bminPrice\x12\x1b\n" +
 	"\tmax_price\x18\x05 \x01(\x01R\bmaxPrice\x12\x1b\n" +
 	"\tavg_price\x18\x06 \x01(\x01R\bavgPrice\x12!\n" +
-	"\ftotal_volume\x18\a \x01(\x05R\vtotalVolume\"B\n" +
+	"\ftotal_volume\x18\a \x01(\x05R\vtotalVolume\x12\x1a\n" +
+	"\binterval\x18\b \x01(\tR\binterval\x12;\n" +
+	"\acandles\x18\t \x03(\v2!.skins.models.v1.PriceCandleModelR\acandles\x12\x16\n" +
+	"\x06source\x18\n" +
+	" \x01(\tR\x06source\"S\n" +
+	"\x19GetPriceComparisonRequest\x12\x12\n" +
+	"\x04slug\x18\x01 \x01(\tR\x04slug\x12\"\n" +
+	"\rmax_age_hours\x18\x02 \x01(\x05R\vmaxAgeHours\"c\n" +
+	"\x1aGetPriceComparisonResponse\x12E\n" +
+	"\n" +
+	"comparison\x18\x01 \x01(\v2%.skins.models.v1.PriceComparisonModelR\n" +
+	"comparison\"\x80\x01\n" +
+	"\x12WatchPricesRequest\x12\x14\n" +
+	"\x05slugs\x18\x01 \x03(\tR\x05slugs\x12\x18\n" +
+	"\aweapons\x18\x02 \x03(\tR\aweapons\x12\x10\n" +
+	"\x03all\x18\x03 \x01(\bR\x03all\x12\x1b\n" +
+	"\tafter_seq\x18\x05 \x01(\x04R\bafterSeqJ\x04\b\x04\x10\x05R\x05since\"J\n" +
+	"\x13WatchPricesResponse\x123\n" +
+	"\x04tick\x18\x01 \x01(\v2\x1f.skins.models.v1.PriceTickModelR\x04tick\"\x99\x01\n" +
+	"\x11ExportDataRequest\x12\x18\n" +
+	"\adataset\x18\x01 \x01(\tR\adataset\x12\x16\n" +
+	"\x06format\x18\x02 \x01(\tR\x06format\x12\x16\n" +
+	"\x06weapon\x18\x03 \x01(\tR\x06weapon\x12\x16\n" +
+	"\x06source\x18\x04 \x01(\tR\x06source\x12\x12\n" +
+	"\x04from\x18\x05 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x06 \x01(\tR\x02to\"(\n" +
+	"\x12ExportDataResponse\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\"B\n" +
 	"\x12GetTrendingRequest\x12\x16\n" +
 	"\x06period\x18\x01 \x01(\tR\x06period\x12\x14\n" +
 	"\x05limit\x18\x02 \x01(\x05R\x05limit\"`\n" +
@@ -1039,7 +3213,15 @@ const file_skins_api_skins_proto_rawDesc = "" +
 	"\x0etrending_skins\x18\x01 \x03(\v2\".skins.models.v1.TrendingSkinModelR\rtrendingSkins\"\x1a\n" +
 	"\x18GetMarketOverviewRequest\"]\n" +
 	"\x19GetMarketOverviewResponse\x12@\n" +
-	"\boverview\x18\x01 \x01(\v2$.skins.models.v1.MarketOverviewModelR\boverview\",\n" +
+	"\boverview\x18\x01 \x01(\v2$.skins.models.v1.MarketOverviewModelR\boverview\"I\n" +
+	"\x19GetPopularSearchesRequest\x12\x16\n" +
+	"\x06window\x18\x01 \x01(\tR\x06window\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"\xe0\x01\n" +
+	"\x1aGetPopularSearchesResponse\x12\x16\n" +
+	"\x06window\x18\x01 \x01(\tR\x06window\x12\x12\n" +
+	"\x04from\x18\x02 \x01(\tR\x04from\x12?\n" +
+	"\bsearches\x18\x03 \x03(\v2#.skins.models.v1.PopularSearchModelR\bsearches\x12U\n" +
+	"\x14zero_result_searches\x18\x04 \x03(\v2#.skins.models.v1.PopularSearchModelR\x12zeroResultSearches\",\n" +
 	"\x14GetTopGainersRequest\x12\x14\n" +
 	"\x05limit\x18\x01 \x01(\x05R\x05limit\"I\n" +
 	"\x15GetTopGainersResponse\x120\n" +
@@ -1047,17 +3229,77 @@ const file_skins_api_skins_proto_rawDesc = "" +
 	"\x13GetTopLosersRequest\x12\x14\n" +
 	"\x05limit\x18\x01 \x01(\x05R\x05limit\"H\n" +
 	"\x14GetTopLosersResponse\x120\n" +
-	"\x05skins\x18\x01 \x03(\v2\x1a.skins.models.v1.SkinModelR\x05skins2\xab\t\n" +
-	"\fSkinsService\x12h\n" +
+	"\x05skins\x18\x01 \x03(\v2\x1a.skins.models.v1.SkinModelR\x05skins\"o\n" +
+	"\x16GetPriceSpreadsRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\"\n" +
+	"\rmax_age_hours\x18\x02 \x01(\x05R\vmaxAgeHours\x12\x1b\n" +
+	"\tmin_price\x18\x03 \x01(\x01R\bminPrice\"b\n" +
+	"\x17GetPriceSpreadsResponse\x12G\n" +
+	"\vcomparisons\x18\x01 \x03(\v2%.skins.models.v1.PriceComparisonModelR\vcomparisons\"\x1c\n" +
+	"\x1aRecomputePriceStatsRequest\"c\n" +
+	"\x1bRecomputePriceStatsResponse\x12#\n" +
+	"\rupdated_skins\x18\x01 \x01(\x05R\fupdatedSkins\x12\x1f\n" +
+	"\vduration_ms\x18\x02 \x01(\x03R\n" +
+	"durationMs\"\x81\x01\n" +
+	"\x12CreateAlertRequest\x12\x12\n" +
+	"\x04slug\x18\x01 \x01(\tR\x04slug\x12\x1c\n" +
+	"\tcondition\x18\x02 \x01(\tR\tcondition\x12!\n" +
+	"\ftarget_price\x18\x03 \x01(\x01R\vtargetPrice\x12\x16\n" +
+	"\x06source\x18\x04 \x01(\tR\x06source\"M\n" +
+	"\x13CreateAlertResponse\x126\n" +
+	"\x05alert\x18\x01 \x01(\v2 .skins.models.v1.PriceAlertModelR\x05alert\"'\n" +
+	"\x11ListAlertsRequest\x12\x12\n" +
+	"\x04slug\x18\x01 \x01(\tR\x04slug\"N\n" +
+	"\x12ListAlertsResponse\x128\n" +
+	"\x06alerts\x18\x01 \x03(\v2 .skins.models.v1.PriceAlertModelR\x06alerts\"$\n" +
+	"\x12DeleteAlertRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x15\n" +
+	"\x13DeleteAlertResponse\"B\n" +
+	"\x16RegisterWebhookRequest\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x16\n" +
+	"\x06secret\x18\x02 \x01(\tR\x06secret\"j\n" +
+	"\x17RegisterWebhookResponse\x127\n" +
+	"\awebhook\x18\x01 \x01(\v2\x1d.skins.models.v1.WebhookModelR\awebhook\x12\x16\n" +
+	"\x06secret\x18\x02 \x01(\tR\x06secret\"L\n" +
+	"\x15ListDeliveriesRequest\x12\x1d\n" +
+	"\n" +
+	"webhook_id\x18\x01 \x01(\tR\twebhookId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"_\n" +
+	"\x16ListDeliveriesResponse\x12E\n" +
+	"\n" +
+	"deliveries\x18\x01 \x03(\v2%.skins.models.v1.WebhookDeliveryModelR\n" +
+	"deliveries2\xc3\x19\n" +
+	"\fSkinsService\x12q\n" +
+	"\n" +
+	"CreateSkin\x12#.skins.service.v1.CreateSkinRequest\x1a$.skins.service.v1.CreateSkinResponse\"\x18\x82\xd3\xe4\x93\x02\x12:\x01*\"\r/api/v1/skins\x12{\n" +
+	"\n" +
+	"UpdateSkin\x12#.skins.service.v1.UpdateSkinRequest\x1a$.skins.service.v1.UpdateSkinResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x04skin2\x14/api/v1/skins/{slug}\x12u\n" +
+	"\n" +
+	"DeleteSkin\x12#.skins.service.v1.DeleteSkinRequest\x1a$.skins.service.v1.DeleteSkinResponse\"\x1c\x82\xd3\xe4\x93\x02\x16*\x14/api/v1/skins/{slug}\x12\x80\x01\n" +
+	"\rBatchGetSkins\x12&.skins.service.v1.BatchGetSkinsRequest\x1a'.skins.service.v1.BatchGetSkinsResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/api/v1/skins/batch\x12h\n" +
 	"\bGetSkins\x12!.skins.service.v1.GetSkinsRequest\x1a\".skins.service.v1.GetSkinsResponse\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/api/v1/skins\x12~\n" +
 	"\rGetSkinBySlug\x12&.skins.service.v1.GetSkinBySlugRequest\x1a'.skins.service.v1.GetSkinBySlugResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/api/v1/skins/{slug}\x12x\n" +
-	"\vSearchSkins\x12$.skins.service.v1.SearchSkinsRequest\x1a%.skins.service.v1.SearchSkinsResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/api/v1/skins/search\x12\x85\x01\n" +
+	"\vSearchSkins\x12$.skins.service.v1.SearchSkinsRequest\x1a%.skins.service.v1.SearchSkinsResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/api/v1/skins/search\x12\x81\x01\n" +
+	"\fAutocomplete\x12%.skins.service.v1.AutocompleteRequest\x1a&.skins.service.v1.AutocompleteResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/api/v1/skins/autocomplete\x12\x85\x01\n" +
 	"\x0fGetPopularSkins\x12(.skins.service.v1.GetPopularSkinsRequest\x1a).skins.service.v1.GetPopularSkinsResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/api/v1/skins/popular\x12\x84\x01\n" +
-	"\rGetPriceChart\x12&.skins.service.v1.GetPriceChartRequest\x1a'.skins.service.v1.GetPriceChartResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/api/v1/skins/chart/{slug}\x12~\n" +
+	"\rGetPriceChart\x12&.skins.service.v1.GetPriceChartRequest\x1a'.skins.service.v1.GetPriceChartResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/api/v1/skins/chart/{slug}\x12\x95\x01\n" +
+	"\x12GetPriceComparison\x12+.skins.service.v1.GetPriceComparisonRequest\x1a,.skins.service.v1.GetPriceComparisonResponse\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/api/v1/skins/{slug}/compare\x12z\n" +
+	"\vWatchPrices\x12$.skins.service.v1.WatchPricesRequest\x1a%.skins.service.v1.WatchPricesResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/api/v1/prices/watch0\x01\x12Y\n" +
+	"\n" +
+	"ExportData\x12#.skins.service.v1.ExportDataRequest\x1a$.skins.service.v1.ExportDataResponse0\x01\x12~\n" +
 	"\vGetTrending\x12$.skins.service.v1.GetTrendingRequest\x1a%.skins.service.v1.GetTrendingResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/api/v1/analytics/trending\x12\x97\x01\n" +
-	"\x11GetMarketOverview\x12*.skins.service.v1.GetMarketOverviewRequest\x1a+.skins.service.v1.GetMarketOverviewResponse\")\x82\xd3\xe4\x93\x02#\x12!/api/v1/analytics/market-overview\x12\x87\x01\n" +
+	"\x11GetMarketOverview\x12*.skins.service.v1.GetMarketOverviewRequest\x1a+.skins.service.v1.GetMarketOverviewResponse\")\x82\xd3\xe4\x93\x02#\x12!/api/v1/analytics/market-overview\x12\x9b\x01\n" +
+	"\x12GetPopularSearches\x12+.skins.service.v1.GetPopularSearchesRequest\x1a,.skins.service.v1.GetPopularSearchesResponse\"*\x82\xd3\xe4\x93\x02$\x12\"/api/v1/analytics/popular-searches\x12\x87\x01\n" +
 	"\rGetTopGainers\x12&.skins.service.v1.GetTopGainersRequest\x1a'.skins.service.v1.GetTopGainersResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/api/v1/analytics/top-gainers\x12\x83\x01\n" +
-	"\fGetTopLosers\x12%.skins.service.v1.GetTopLosersRequest\x1a&.skins.service.v1.GetTopLosersResponse\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/api/v1/analytics/top-losersB4Z2github.com/kedr891/cs-parser/internal/pb/skins_apib\x06proto3"
+	"\fGetTopLosers\x12%.skins.service.v1.GetTopLosersRequest\x1a&.skins.service.v1.GetTopLosersResponse\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/api/v1/analytics/top-losers\x12\x89\x01\n" +
+	"\x0fGetPriceSpreads\x12(.skins.service.v1.GetPriceSpreadsRequest\x1a).skins.service.v1.GetPriceSpreadsResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/api/v1/analytics/spreads\x12\x9c\x01\n" +
+	"\x13RecomputePriceStats\x12,.skins.service.v1.RecomputePriceStatsRequest\x1a-.skins.service.v1.RecomputePriceStatsResponse\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/api/v1/admin/recompute-stats\x12u\n" +
+	"\vCreateAlert\x12$.skins.service.v1.CreateAlertRequest\x1a%.skins.service.v1.CreateAlertResponse\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/api/v1/alerts\x12o\n" +
+	"\n" +
+	"ListAlerts\x12#.skins.service.v1.ListAlertsRequest\x1a$.skins.service.v1.ListAlertsResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/api/v1/alerts\x12w\n" +
+	"\vDeleteAlert\x12$.skins.service.v1.DeleteAlertRequest\x1a%.skins.service.v1.DeleteAlertResponse\"\x1b\x82\xd3\xe4\x93\x02\x15*\x13/api/v1/alerts/{id}\x12\x83\x01\n" +
+	"\x0fRegisterWebhook\x12(.skins.service.v1.RegisterWebhookRequest\x1a).skins.service.v1.RegisterWebhookResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/api/v1/webhooks\x12\x88\x01\n" +
+	"\x0eListDeliveries\x12'.skins.service.v1.ListDeliveriesRequest\x1a(.skins.service.v1.ListDeliveriesResponse\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/api/v1/webhooks/deliveriesB4Z2github.com/kedr891/cs-parser/internal/pb/skins_apib\x06proto3"
 
 var (
 	file_skins_api_skins_proto_rawDescOnce sync.Once
@@ -1071,65 +3313,160 @@ func file_skins_api_skins_proto_rawDescGZIP() []byte {
 	return file_skins_api_skins_proto_rawDescData
 }
 
-var file_skins_api_skins_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_skins_api_skins_proto_msgTypes = make([]protoimpl.MessageInfo, 52)
 var file_skins_api_skins_proto_goTypes = []any{
-	(*GetSkinsRequest)(nil),            // 0: skins.service.v1.GetSkinsRequest
-	(*GetSkinsResponse)(nil),           // 1: skins.service.v1.GetSkinsResponse
-	(*GetSkinBySlugRequest)(nil),       // 2: skins.service.v1.GetSkinBySlugRequest
-	(*GetSkinBySlugResponse)(nil),      // 3: skins.service.v1.GetSkinBySlugResponse
-	(*SearchSkinsRequest)(nil),         // 4: skins.service.v1.SearchSkinsRequest
-	(*SearchSkinsResponse)(nil),        // 5: skins.service.v1.SearchSkinsResponse
-	(*GetPopularSkinsRequest)(nil),     // 6: skins.service.v1.GetPopularSkinsRequest
-	(*GetPopularSkinsResponse)(nil),    // 7: skins.service.v1.GetPopularSkinsResponse
-	(*GetPriceChartRequest)(nil),       // 8: skins.service.v1.GetPriceChartRequest
-	(*GetPriceChartResponse)(nil),      // 9: skins.service.v1.GetPriceChartResponse
-	(*GetTrendingRequest)(nil),         // 10: skins.service.v1.GetTrendingRequest
-	(*GetTrendingResponse)(nil),        // 11: skins.service.v1.GetTrendingResponse
-	(*GetMarketOverviewRequest)(nil),   // 12: skins.service.v1.GetMarketOverviewRequest
-	(*GetMarketOverviewResponse)(nil),  // 13: skins.service.v1.GetMarketOverviewResponse
-	(*GetTopGainersRequest)(nil),       // 14: skins.service.v1.GetTopGainersRequest
-	(*GetTopGainersResponse)(nil),      // 15: skins.service.v1.GetTopGainersResponse
-	(*GetTopLosersRequest)(nil),        // 16: skins.service.v1.GetTopLosersRequest
-	(*GetTopLosersResponse)(nil),       // 17: skins.service.v1.GetTopLosersResponse
-	(*models.SkinModel)(nil),           // 18: skins.models.v1.SkinModel
-	(*models.SkinDetailModel)(nil),     // 19: skins.models.v1.SkinDetailModel
-	(*models.PriceChartDataModel)(nil), // 20: skins.models.v1.PriceChartDataModel
-	(*models.TrendingSkinModel)(nil),   // 21: skins.models.v1.TrendingSkinModel
-	(*models.MarketOverviewModel)(nil), // 22: skins.models.v1.MarketOverviewModel
+	(*CreateSkinRequest)(nil),                  // 0: skins.service.v1.CreateSkinRequest
+	(*CreateSkinResponse)(nil),                 // 1: skins.service.v1.CreateSkinResponse
+	(*SkinUpdate)(nil),                         // 2: skins.service.v1.SkinUpdate
+	(*UpdateSkinRequest)(nil),                  // 3: skins.service.v1.UpdateSkinRequest
+	(*UpdateSkinResponse)(nil),                 // 4: skins.service.v1.UpdateSkinResponse
+	(*DeleteSkinRequest)(nil),                  // 5: skins.service.v1.DeleteSkinRequest
+	(*DeleteSkinResponse)(nil),                 // 6: skins.service.v1.DeleteSkinResponse
+	(*BatchGetSkinsRequest)(nil),               // 7: skins.service.v1.BatchGetSkinsRequest
+	(*BatchGetSkinsResponse)(nil),              // 8: skins.service.v1.BatchGetSkinsResponse
+	(*SkinLookup)(nil),                         // 9: skins.service.v1.SkinLookup
+	(*GetSkinsRequest)(nil),                    // 10: skins.service.v1.GetSkinsRequest
+	(*GetSkinsResponse)(nil),                   // 11: skins.service.v1.GetSkinsResponse
+	(*GetSkinBySlugRequest)(nil),               // 12: skins.service.v1.GetSkinBySlugRequest
+	(*GetSkinBySlugResponse)(nil),              // 13: skins.service.v1.GetSkinBySlugResponse
+	(*SearchSkinsRequest)(nil),                 // 14: skins.service.v1.SearchSkinsRequest
+	(*SearchSkinsResponse)(nil),                // 15: skins.service.v1.SearchSkinsResponse
+	(*AutocompleteRequest)(nil),                // 16: skins.service.v1.AutocompleteRequest
+	(*AutocompleteResponse)(nil),               // 17: skins.service.v1.AutocompleteResponse
+	(*GetPopularSkinsRequest)(nil),             // 18: skins.service.v1.GetPopularSkinsRequest
+	(*GetPopularSkinsResponse)(nil),            // 19: skins.service.v1.GetPopularSkinsResponse
+	(*GetPriceChartRequest)(nil),               // 20: skins.service.v1.GetPriceChartRequest
+	(*GetPriceChartResponse)(nil),              // 21: skins.service.v1.GetPriceChartResponse
+	(*GetPriceComparisonRequest)(nil),          // 22: skins.service.v1.GetPriceComparisonRequest
+	(*GetPriceComparisonResponse)(nil),         // 23: skins.service.v1.GetPriceComparisonResponse
+	(*WatchPricesRequest)(nil),                 // 24: skins.service.v1.WatchPricesRequest
+	(*WatchPricesResponse)(nil),                // 25: skins.service.v1.WatchPricesResponse
+	(*ExportDataRequest)(nil),                  // 26: skins.service.v1.ExportDataRequest
+	(*ExportDataResponse)(nil),                 // 27: skins.service.v1.ExportDataResponse
+	(*GetTrendingRequest)(nil),                 // 28: skins.service.v1.GetTrendingRequest
+	(*GetTrendingResponse)(nil),                // 29: skins.service.v1.GetTrendingResponse
+	(*GetMarketOverviewRequest)(nil),           // 30: skins.service.v1.GetMarketOverviewRequest
+	(*GetMarketOverviewResponse)(nil),          // 31: skins.service.v1.GetMarketOverviewResponse
+	(*GetPopularSearchesRequest)(nil),          // 32: skins.service.v1.GetPopularSearchesRequest
+	(*GetPopularSearchesResponse)(nil),         // 33: skins.service.v1.GetPopularSearchesResponse
+	(*GetTopGainersRequest)(nil),               // 34: skins.service.v1.GetTopGainersRequest
+	(*GetTopGainersResponse)(nil),              // 35: skins.service.v1.GetTopGainersResponse
+	(*GetTopLosersRequest)(nil),                // 36: skins.service.v1.GetTopLosersRequest
+	(*GetTopLosersResponse)(nil),               // 37: skins.service.v1.GetTopLosersResponse
+	(*GetPriceSpreadsRequest)(nil),             // 38: skins.service.v1.GetPriceSpreadsRequest
+	(*GetPriceSpreadsResponse)(nil),            // 39: skins.service.v1.GetPriceSpreadsResponse
+	(*RecomputePriceStatsRequest)(nil),         // 40: skins.service.v1.RecomputePriceStatsRequest
+	(*RecomputePriceStatsResponse)(nil),        // 41: skins.service.v1.RecomputePriceStatsResponse
+	(*CreateAlertRequest)(nil),                 // 42: skins.service.v1.CreateAlertRequest
+	(*CreateAlertResponse)(nil),                // 43: skins.service.v1.CreateAlertResponse
+	(*ListAlertsRequest)(nil),                  // 44: skins.service.v1.ListAlertsRequest
+	(*ListAlertsResponse)(nil),                 // 45: skins.service.v1.ListAlertsResponse
+	(*DeleteAlertRequest)(nil),                 // 46: skins.service.v1.DeleteAlertRequest
+	(*DeleteAlertResponse)(nil),                // 47: skins.service.v1.DeleteAlertResponse
+	(*RegisterWebhookRequest)(nil),             // 48: skins.service.v1.RegisterWebhookRequest
+	(*RegisterWebhookResponse)(nil),            // 49: skins.service.v1.RegisterWebhookResponse
+	(*ListDeliveriesRequest)(nil),              // 50: skins.service.v1.ListDeliveriesRequest
+	(*ListDeliveriesResponse)(nil),             // 51: skins.service.v1.ListDeliveriesResponse
+	(*models.SkinModel)(nil),                   // 52: skins.models.v1.SkinModel
+	(*fieldmaskpb.FieldMask)(nil),              // 53: google.protobuf.FieldMask
+	(*models.SkinFacetsModel)(nil),             // 54: skins.models.v1.SkinFacetsModel
+	(*models.SkinDetailModel)(nil),             // 55: skins.models.v1.SkinDetailModel
+	(*models.AutocompleteSuggestionModel)(nil), // 56: skins.models.v1.AutocompleteSuggestionModel
+	(*models.PriceChartDataModel)(nil),         // 57: skins.models.v1.PriceChartDataModel
+	(*models.PriceCandleModel)(nil),            // 58: skins.models.v1.PriceCandleModel
+	(*models.PriceComparisonModel)(nil),        // 59: skins.models.v1.PriceComparisonModel
+	(*models.PriceTickModel)(nil),              // 60: skins.models.v1.PriceTickModel
+	(*models.TrendingSkinModel)(nil),           // 61: skins.models.v1.TrendingSkinModel
+	(*models.MarketOverviewModel)(nil),         // 62: skins.models.v1.MarketOverviewModel
+	(*models.PopularSearchModel)(nil),          // 63: skins.models.v1.PopularSearchModel
+	(*models.PriceAlertModel)(nil),             // 64: skins.models.v1.PriceAlertModel
+	(*models.WebhookModel)(nil),                // 65: skins.models.v1.WebhookModel
+	(*models.WebhookDeliveryModel)(nil),        // 66: skins.models.v1.WebhookDeliveryModel
 }
 var file_skins_api_skins_proto_depIdxs = []int32{
-	18, // 0: skins.service.v1.GetSkinsResponse.skins:type_name -> skins.models.v1.SkinModel
-	19, // 1: skins.service.v1.GetSkinBySlugResponse.skin:type_name -> skins.models.v1.SkinDetailModel
-	18, // 2: skins.service.v1.SearchSkinsResponse.skins:type_name -> skins.models.v1.SkinModel
-	18, // 3: skins.service.v1.GetPopularSkinsResponse.skins:type_name -> skins.models.v1.SkinModel
-	20, // 4: skins.service.v1.GetPriceChartResponse.data_points:type_name -> skins.models.v1.PriceChartDataModel
-	21, // 5: skins.service.v1.GetTrendingResponse.trending_skins:type_name -> skins.models.v1.TrendingSkinModel
-	22, // 6: skins.service.v1.GetMarketOverviewResponse.overview:type_name -> skins.models.v1.MarketOverviewModel
-	18, // 7: skins.service.v1.GetTopGainersResponse.skins:type_name -> skins.models.v1.SkinModel
-	18, // 8: skins.service.v1.GetTopLosersResponse.skins:type_name -> skins.models.v1.SkinModel
-	0,  // 9: skins.service.v1.SkinsService.GetSkins:input_type -> skins.service.v1.GetSkinsRequest
-	2,  // 10: skins.service.v1.SkinsService.GetSkinBySlug:input_type -> skins.service.v1.GetSkinBySlugRequest
-	4,  // 11: skins.service.v1.SkinsService.SearchSkins:input_type -> skins.service.v1.SearchSkinsRequest
-	6,  // 12: skins.service.v1.SkinsService.GetPopularSkins:input_type -> skins.service.v1.GetPopularSkinsRequest
-	8,  // 13: skins.service.v1.SkinsService.GetPriceChart:input_type -> skins.service.v1.GetPriceChartRequest
-	10, // 14: skins.service.v1.SkinsService.GetTrending:input_type -> skins.service.v1.GetTrendingRequest
-	12, // 15: skins.service.v1.SkinsService.GetMarketOverview:input_type -> skins.service.v1.GetMarketOverviewRequest
-	14, // 16: skins.service.v1.SkinsService.GetTopGainers:input_type -> skins.service.v1.GetTopGainersRequest
-	16, // 17: skins.service.v1.SkinsService.GetTopLosers:input_type -> skins.service.v1.GetTopLosersRequest
-	1,  // 18: skins.service.v1.SkinsService.GetSkins:output_type -> skins.service.v1.GetSkinsResponse
-	3,  // 19: skins.service.v1.SkinsService.GetSkinBySlug:output_type -> skins.service.v1.GetSkinBySlugResponse
-	5,  // 20: skins.service.v1.SkinsService.SearchSkins:output_type -> skins.service.v1.SearchSkinsResponse
-	7,  // 21: skins.service.v1.SkinsService.GetPopularSkins:output_type -> skins.service.v1.GetPopularSkinsResponse
-	9,  // 22: skins.service.v1.SkinsService.GetPriceChart:output_type -> skins.service.v1.GetPriceChartResponse
-	11, // 23: skins.service.v1.SkinsService.GetTrending:output_type -> skins.service.v1.GetTrendingResponse
-	13, // 24: skins.service.v1.SkinsService.GetMarketOverview:output_type -> skins.service.v1.GetMarketOverviewResponse
-	15, // 25: skins.service.v1.SkinsService.GetTopGainers:output_type -> skins.service.v1.GetTopGainersResponse
-	17, // 26: skins.service.v1.SkinsService.GetTopLosers:output_type -> skins.service.v1.GetTopLosersResponse
-	18, // [18:27] is the sub-list for method output_type
-	9,  // [9:18] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+	52, // 0: skins.service.v1.CreateSkinResponse.skin:type_name -> skins.models.v1.SkinModel
+	2,  // 1: skins.service.v1.UpdateSkinRequest.skin:type_name -> skins.service.v1.SkinUpdate
+	53, // 2: skins.service.v1.UpdateSkinRequest.update_mask:type_name -> google.protobuf.FieldMask
+	52, // 3: skins.service.v1.UpdateSkinResponse.skin:type_name -> skins.models.v1.SkinModel
+	9,  // 4: skins.service.v1.BatchGetSkinsResponse.results:type_name -> skins.service.v1.SkinLookup
+	52, // 5: skins.service.v1.SkinLookup.skin:type_name -> skins.models.v1.SkinModel
+	52, // 6: skins.service.v1.GetSkinsResponse.skins:type_name -> skins.models.v1.SkinModel
+	54, // 7: skins.service.v1.GetSkinsResponse.facets:type_name -> skins.models.v1.SkinFacetsModel
+	55, // 8: skins.service.v1.GetSkinBySlugResponse.skin:type_name -> skins.models.v1.SkinDetailModel
+	52, // 9: skins.service.v1.SearchSkinsResponse.skins:type_name -> skins.models.v1.SkinModel
+	54, // 10: skins.service.v1.SearchSkinsResponse.facets:type_name -> skins.models.v1.SkinFacetsModel
+	56, // 11: skins.service.v1.AutocompleteResponse.suggestions:type_name -> skins.models.v1.AutocompleteSuggestionModel
+	52, // 12: skins.service.v1.GetPopularSkinsResponse.skins:type_name -> skins.models.v1.SkinModel
+	57, // 13: skins.service.v1.GetPriceChartResponse.data_points:type_name -> skins.models.v1.PriceChartDataModel
+	58, // 14: skins.service.v1.GetPriceChartResponse.candles:type_name -> skins.models.v1.PriceCandleModel
+	59, // 15: skins.service.v1.GetPriceComparisonResponse.comparison:type_name -> skins.models.v1.PriceComparisonModel
+	60, // 16: skins.service.v1.WatchPricesResponse.tick:type_name -> skins.models.v1.PriceTickModel
+	61, // 17: skins.service.v1.GetTrendingResponse.trending_skins:type_name -> skins.models.v1.TrendingSkinModel
+	62, // 18: skins.service.v1.GetMarketOverviewResponse.overview:type_name -> skins.models.v1.MarketOverviewModel
+	63, // 19: skins.service.v1.GetPopularSearchesResponse.searches:type_name -> skins.models.v1.PopularSearchModel
+	63, // 20: skins.service.v1.GetPopularSearchesResponse.zero_result_searches:type_name -> skins.models.v1.PopularSearchModel
+	52, // 21: skins.service.v1.GetTopGainersResponse.skins:type_name -> skins.models.v1.SkinModel
+	52, // 22: skins.service.v1.GetTopLosersResponse.skins:type_name -> skins.models.v1.SkinModel
+	59, // 23: skins.service.v1.GetPriceSpreadsResponse.comparisons:type_name -> skins.models.v1.PriceComparisonModel
+	64, // 24: skins.service.v1.CreateAlertResponse.alert:type_name -> skins.models.v1.PriceAlertModel
+	64, // 25: skins.service.v1.ListAlertsResponse.alerts:type_name -> skins.models.v1.PriceAlertModel
+	65, // 26: skins.service.v1.RegisterWebhookResponse.webhook:type_name -> skins.models.v1.WebhookModel
+	66, // 27: skins.service.v1.ListDeliveriesResponse.deliveries:type_name -> skins.models.v1.WebhookDeliveryModel
+	0,  // 28: skins.service.v1.SkinsService.CreateSkin:input_type -> skins.service.v1.CreateSkinRequest
+	3,  // 29: skins.service.v1.SkinsService.UpdateSkin:input_type -> skins.service.v1.UpdateSkinRequest
+	5,  // 30: skins.service.v1.SkinsService.DeleteSkin:input_type -> skins.service.v1.DeleteSkinRequest
+	7,  // 31: skins.service.v1.SkinsService.BatchGetSkins:input_type -> skins.service.v1.BatchGetSkinsRequest
+	10, // 32: skins.service.v1.SkinsService.GetSkins:input_type -> skins.service.v1.GetSkinsRequest
+	12, // 33: skins.service.v1.SkinsService.GetSkinBySlug:input_type -> skins.service.v1.GetSkinBySlugRequest
+	14, // 34: skins.service.v1.SkinsService.SearchSkins:input_type -> skins.service.v1.SearchSkinsRequest
+	16, // 35: skins.service.v1.SkinsService.Autocomplete:input_type -> skins.service.v1.AutocompleteRequest
+	18, // 36: skins.service.v1.SkinsService.GetPopularSkins:input_type -> skins.service.v1.GetPopularSkinsRequest
+	20, // 37: skins.service.v1.SkinsService.GetPriceChart:input_type -> skins.service.v1.GetPriceChartRequest
+	22, // 38: skins.service.v1.SkinsService.GetPriceComparison:input_type -> skins.service.v1.GetPriceComparisonRequest
+	24, // 39: skins.service.v1.SkinsService.WatchPrices:input_type -> skins.service.v1.WatchPricesRequest
+	26, // 40: skins.service.v1.SkinsService.ExportData:input_type -> skins.service.v1.ExportDataRequest
+	28, // 41: skins.service.v1.SkinsService.GetTrending:input_type -> skins.service.v1.GetTrendingRequest
+	30, // 42: skins.service.v1.SkinsService.GetMarketOverview:input_type -> skins.service.v1.GetMarketOverviewRequest
+	32, // 43: skins.service.v1.SkinsService.GetPopularSearches:input_type -> skins.service.v1.GetPopularSearchesRequest
+	34, // 44: skins.service.v1.SkinsService.GetTopGainers:input_type -> skins.service.v1.GetTopGainersRequest
+	36, // 45: skins.service.v1.SkinsService.GetTopLosers:input_type -> skins.service.v1.GetTopLosersRequest
+	38, // 46: skins.service.v1.SkinsService.GetPriceSpreads:input_type -> skins.service.v1.GetPriceSpreadsRequest
+	40, // 47: skins.service.v1.SkinsService.RecomputePriceStats:input_type -> skins.service.v1.RecomputePriceStatsRequest
+	42, // 48: skins.service.v1.SkinsService.CreateAlert:input_type -> skins.service.v1.CreateAlertRequest
+	44, // 49: skins.service.v1.SkinsService.ListAlerts:input_type -> skins.service.v1.ListAlertsRequest
+	46, // 50: skins.service.v1.SkinsService.DeleteAlert:input_type -> skins.service.v1.DeleteAlertRequest
+	48, // 51: skins.service.v1.SkinsService.RegisterWebhook:input_type -> skins.service.v1.RegisterWebhookRequest
+	50, // 52: skins.service.v1.SkinsService.ListDeliveries:input_type -> skins.service.v1.ListDeliveriesRequest
+	1,  // 53: skins.service.v1.SkinsService.CreateSkin:output_type -> skins.service.v1.CreateSkinResponse
+	4,  // 54: skins.service.v1.SkinsService.UpdateSkin:output_type -> skins.service.v1.UpdateSkinResponse
+	6,  // 55: skins.service.v1.SkinsService.DeleteSkin:output_type -> skins.service.v1.DeleteSkinResponse
+	8,  // 56: skins.service.v1.SkinsService.BatchGetSkins:output_type -> skins.service.v1.BatchGetSkinsResponse
+	11, // 57: skins.service.v1.SkinsService.GetSkins:output_type -> skins.service.v1.GetSkinsResponse
+	13, // 58: skins.service.v1.SkinsService.GetSkinBySlug:output_type -> skins.service.v1.GetSkinBySlugResponse
+	15, // 59: skins.service.v1.SkinsService.SearchSkins:output_type -> skins.service.v1.SearchSkinsResponse
+	17, // 60: skins.service.v1.SkinsService.Autocomplete:output_type -> skins.service.v1.AutocompleteResponse
+	19, // 61: skins.service.v1.SkinsService.GetPopularSkins:output_type -> skins.service.v1.GetPopularSkinsResponse
+	21, // 62: skins.service.v1.SkinsService.GetPriceChart:output_type -> skins.service.v1.GetPriceChartResponse
+	23, // 63: skins.service.v1.SkinsService.GetPriceComparison:output_type -> skins.service.v1.GetPriceComparisonResponse
+	25, // 64: skins.service.v1.SkinsService.WatchPrices:output_type -> skins.service.v1.WatchPricesResponse
+	27, // 65: skins.service.v1.SkinsService.ExportData:output_type -> skins.service.v1.ExportDataResponse
+	29, // 66: skins.service.v1.SkinsService.GetTrending:output_type -> skins.service.v1.GetTrendingResponse
+	31, // 67: skins.service.v1.SkinsService.GetMarketOverview:output_type -> skins.service.v1.GetMarketOverviewResponse
+	33, // 68: skins.service.v1.SkinsService.GetPopularSearches:output_type -> skins.service.v1.GetPopularSearchesResponse
+	35, // 69: skins.service.v1.SkinsService.GetTopGainers:output_type -> skins.service.v1.GetTopGainersResponse
+	37, // 70: skins.service.v1.SkinsService.GetTopLosers:output_type -> skins.service.v1.GetTopLosersResponse
+	39, // 71: skins.service.v1.SkinsService.GetPriceSpreads:output_type -> skins.service.v1.GetPriceSpreadsResponse
+	41, // 72: skins.service.v1.SkinsService.RecomputePriceStats:output_type -> skins.service.v1.RecomputePriceStatsResponse
+	43, // 73: skins.service.v1.SkinsService.CreateAlert:output_type -> skins.service.v1.CreateAlertResponse
+	45, // 74: skins.service.v1.SkinsService.ListAlerts:output_type -> skins.service.v1.ListAlertsResponse
+	47, // 75: skins.service.v1.SkinsService.DeleteAlert:output_type -> skins.service.v1.DeleteAlertResponse
+	49, // 76: skins.service.v1.SkinsService.RegisterWebhook:output_type -> skins.service.v1.RegisterWebhookResponse
+	51, // 77: skins.service.v1.SkinsService.ListDeliveries:output_type -> skins.service.v1.ListDeliveriesResponse
+	53, // [53:78] is the sub-list for method output_type
+	28, // [28:53] is the sub-list for method input_type
+	28, // [28:28] is the sub-list for extension type_name
+	28, // [28:28] is the sub-list for extension extendee
+	0,  // [0:28] is the sub-list for field type_name
 }
 
 func init() { file_skins_api_skins_proto_init() }
@@ -1137,13 +3474,14 @@ func file_skins_api_skins_proto_init() {
 	if File_skins_api_skins_proto != nil {
 		return
 	}
+	file_skins_api_skins_proto_msgTypes[10].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_skins_api_skins_proto_rawDesc), len(file_skins_api_skins_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   18,
+			NumMessages:   52,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,30 +19,71 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	SkinsService_GetSkins_FullMethodName          = "/skins.service.v1.SkinsService/GetSkins"
-	SkinsService_GetSkinBySlug_FullMethodName     = "/skins.service.v1.SkinsService/GetSkinBySlug"
-	SkinsService_SearchSkins_FullMethodName       = "/skins.service.v1.SkinsService/SearchSkins"
-	SkinsService_GetPopularSkins_FullMethodName   = "/skins.service.v1.SkinsService/GetPopularSkins"
-	SkinsService_GetPriceChart_FullMethodName     = "/skins.service.v1.SkinsService/GetPriceChart"
-	SkinsService_GetTrending_FullMethodName       = "/skins.service.v1.SkinsService/GetTrending"
-	SkinsService_GetMarketOverview_FullMethodName = "/skins.service.v1.SkinsService/GetMarketOverview"
-	SkinsService_GetTopGainers_FullMethodName     = "/skins.service.v1.SkinsService/GetTopGainers"
-	SkinsService_GetTopLosers_FullMethodName      = "/skins.service.v1.SkinsService/GetTopLosers"
+	SkinsService_CreateSkin_FullMethodName          = "/skins.service.v1.SkinsService/CreateSkin"
+	SkinsService_UpdateSkin_FullMethodName          = "/skins.service.v1.SkinsService/UpdateSkin"
+	SkinsService_DeleteSkin_FullMethodName          = "/skins.service.v1.SkinsService/DeleteSkin"
+	SkinsService_BatchGetSkins_FullMethodName       = "/skins.service.v1.SkinsService/BatchGetSkins"
+	SkinsService_GetSkins_FullMethodName            = "/skins.service.v1.SkinsService/GetSkins"
+	SkinsService_GetSkinBySlug_FullMethodName       = "/skins.service.v1.SkinsService/GetSkinBySlug"
+	SkinsService_SearchSkins_FullMethodName         = "/skins.service.v1.SkinsService/SearchSkins"
+	SkinsService_Autocomplete_FullMethodName        = "/skins.service.v1.SkinsService/Autocomplete"
+	SkinsService_GetPopularSkins_FullMethodName     = "/skins.service.v1.SkinsService/GetPopularSkins"
+	SkinsService_GetPriceChart_FullMethodName       = "/skins.service.v1.SkinsService/GetPriceChart"
+	SkinsService_GetPriceComparison_FullMethodName  = "/skins.service.v1.SkinsService/GetPriceComparison"
+	SkinsService_WatchPrices_FullMethodName         = "/skins.service.v1.SkinsService/WatchPrices"
+	SkinsService_ExportData_FullMethodName          = "/skins.service.v1.SkinsService/ExportData"
+	SkinsService_GetTrending_FullMethodName         = "/skins.service.v1.SkinsService/GetTrending"
+	SkinsService_GetMarketOverview_FullMethodName   = "/skins.service.v1.SkinsService/GetMarketOverview"
+	SkinsService_GetPopularSearches_FullMethodName  = "/skins.service.v1.SkinsService/GetPopularSearches"
+	SkinsService_GetTopGainers_FullMethodName       = "/skins.service.v1.SkinsService/GetTopGainers"
+	SkinsService_GetTopLosers_FullMethodName        = "/skins.service.v1.SkinsService/GetTopLosers"
+	SkinsService_GetPriceSpreads_FullMethodName     = "/skins.service.v1.SkinsService/GetPriceSpreads"
+	SkinsService_RecomputePriceStats_FullMethodName = "/skins.service.v1.SkinsService/RecomputePriceStats"
+	SkinsService_CreateAlert_FullMethodName         = "/skins.service.v1.SkinsService/CreateAlert"
+	SkinsService_ListAlerts_FullMethodName          = "/skins.service.v1.SkinsService/ListAlerts"
+	SkinsService_DeleteAlert_FullMethodName         = "/skins.service.v1.SkinsService/DeleteAlert"
+	SkinsService_RegisterWebhook_FullMethodName     = "/skins.service.v1.SkinsService/RegisterWebhook"
+	SkinsService_ListDeliveries_FullMethodName      = "/skins.service.v1.SkinsService/ListDeliveries"
 )
 
 // SkinsServiceClient is the client API for SkinsService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type SkinsServiceClient interface {
+	CreateSkin(ctx context.Context, in *CreateSkinRequest, opts ...grpc.CallOption) (*CreateSkinResponse, error)
+	// Меняет только поля из update_mask. Через gateway маска заполняется по полям JSON-тела.
+	UpdateSkin(ctx context.Context, in *UpdateSkinRequest, opts ...grpc.CallOption) (*UpdateSkinResponse, error)
+	DeleteSkin(ctx context.Context, in *DeleteSkinRequest, opts ...grpc.CallOption) (*DeleteSkinResponse, error)
+	BatchGetSkins(ctx context.Context, in *BatchGetSkinsRequest, opts ...grpc.CallOption) (*BatchGetSkinsResponse, error)
 	GetSkins(ctx context.Context, in *GetSkinsRequest, opts ...grpc.CallOption) (*GetSkinsResponse, error)
 	GetSkinBySlug(ctx context.Context, in *GetSkinBySlugRequest, opts ...grpc.CallOption) (*GetSkinBySlugResponse, error)
 	SearchSkins(ctx context.Context, in *SearchSkinsRequest, opts ...grpc.CallOption) (*SearchSkinsResponse, error)
+	// Подсказки для строки поиска из префиксного индекса в Redis
+	Autocomplete(ctx context.Context, in *AutocompleteRequest, opts ...grpc.CallOption) (*AutocompleteResponse, error)
 	GetPopularSkins(ctx context.Context, in *GetPopularSkinsRequest, opts ...grpc.CallOption) (*GetPopularSkinsResponse, error)
 	GetPriceChart(ctx context.Context, in *GetPriceChartRequest, opts ...grpc.CallOption) (*GetPriceChartResponse, error)
+	// Последние цены скина на площадках и где выгоднее купить и продать.
+	GetPriceComparison(ctx context.Context, in *GetPriceComparisonRequest, opts ...grpc.CallOption) (*GetPriceComparisonResponse, error)
+	// Поток обновлений цен. Через gateway отдается как JSON, по одному сообщению на строку.
+	WatchPrices(ctx context.Context, in *WatchPricesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchPricesResponse], error)
+	// Выгрузка каталога или истории цен в CSV, NDJSON или Parquet кусками по мере чтения из шардов.
+	// Только gRPC: grpc-gateway добавляет разделитель между сообщениями потока, что портит
+	// бинарный Parquet, поэтому HTTP-выгрузка /api/v1/export/{dataset} обслуживается gateway напрямую.
+	ExportData(ctx context.Context, in *ExportDataRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportDataResponse], error)
 	GetTrending(ctx context.Context, in *GetTrendingRequest, opts ...grpc.CallOption) (*GetTrendingResponse, error)
 	GetMarketOverview(ctx context.Context, in *GetMarketOverviewRequest, opts ...grpc.CallOption) (*GetMarketOverviewResponse, error)
+	// Частые поисковые запросы за скользящий час или сутки, в том числе не нашедшие ни одного скина
+	GetPopularSearches(ctx context.Context, in *GetPopularSearchesRequest, opts ...grpc.CallOption) (*GetPopularSearchesResponse, error)
 	GetTopGainers(ctx context.Context, in *GetTopGainersRequest, opts ...grpc.CallOption) (*GetTopGainersResponse, error)
 	GetTopLosers(ctx context.Context, in *GetTopLosersRequest, opts ...grpc.CallOption) (*GetTopLosersResponse, error)
+	// Скины каталога с наибольшим спредом между площадками.
+	GetPriceSpreads(ctx context.Context, in *GetPriceSpreadsRequest, opts ...grpc.CallOption) (*GetPriceSpreadsResponse, error)
+	RecomputePriceStats(ctx context.Context, in *RecomputePriceStatsRequest, opts ...grpc.CallOption) (*RecomputePriceStatsResponse, error)
+	CreateAlert(ctx context.Context, in *CreateAlertRequest, opts ...grpc.CallOption) (*CreateAlertResponse, error)
+	ListAlerts(ctx context.Context, in *ListAlertsRequest, opts ...grpc.CallOption) (*ListAlertsResponse, error)
+	DeleteAlert(ctx context.Context, in *DeleteAlertRequest, opts ...grpc.CallOption) (*DeleteAlertResponse, error)
+	RegisterWebhook(ctx context.Context, in *RegisterWebhookRequest, opts ...grpc.CallOption) (*RegisterWebhookResponse, error)
+	ListDeliveries(ctx context.Context, in *ListDeliveriesRequest, opts ...grpc.CallOption) (*ListDeliveriesResponse, error)
 }
 
 type skinsServiceClient struct {
@@ -53,6 +94,46 @@ func NewSkinsServiceClient(cc grpc.ClientConnInterface) SkinsServiceClient {
 	return &skinsServiceClient{cc}
 }
 
+func (c *skinsServiceClient) CreateSkin(ctx context.Context, in *CreateSkinRequest, opts ...grpc.CallOption) (*CreateSkinResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateSkinResponse)
+	err := c.cc.Invoke(ctx, SkinsService_CreateSkin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *skinsServiceClient) UpdateSkin(ctx context.Context, in *UpdateSkinRequest, opts ...grpc.CallOption) (*UpdateSkinResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateSkinResponse)
+	err := c.cc.Invoke(ctx, SkinsService_UpdateSkin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *skinsServiceClient) DeleteSkin(ctx context.Context, in *DeleteSkinRequest, opts ...grpc.CallOption) (*DeleteSkinResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteSkinResponse)
+	err := c.cc.Invoke(ctx, SkinsService_DeleteSkin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *skinsServiceClient) BatchGetSkins(ctx context.Context, in *BatchGetSkinsRequest, opts ...grpc.CallOption) (*BatchGetSkinsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchGetSkinsResponse)
+	err := c.cc.Invoke(ctx, SkinsService_BatchGetSkins_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *skinsServiceClient) GetSkins(ctx context.Context, in *GetSkinsRequest, opts ...grpc.CallOption) (*GetSkinsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetSkinsResponse)
@@ -83,6 +164,16 @@ func (c *skinsServiceClient) SearchSkins(ctx context.Context, in *SearchSkinsReq
 	return out, nil
 }
 
+func (c *skinsServiceClient) Autocomplete(ctx context.Context, in *AutocompleteRequest, opts ...grpc.CallOption) (*AutocompleteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AutocompleteResponse)
+	err := c.cc.Invoke(ctx, SkinsService_Autocomplete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *skinsServiceClient) GetPopularSkins(ctx context.Context, in *GetPopularSkinsRequest, opts ...grpc.CallOption) (*GetPopularSkinsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetPopularSkinsResponse)
@@ -103,6 +194,54 @@ func (c *skinsServiceClient) GetPriceChart(ctx context.Context, in *GetPriceChar
 	return out, nil
 }
 
+func (c *skinsServiceClient) GetPriceComparison(ctx context.Context, in *GetPriceComparisonRequest, opts ...grpc.CallOption) (*GetPriceComparisonResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPriceComparisonResponse)
+	err := c.cc.Invoke(ctx, SkinsService_GetPriceComparison_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *skinsServiceClient) WatchPrices(ctx context.Context, in *WatchPricesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchPricesResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SkinsService_ServiceDesc.Streams[0], SkinsService_WatchPrices_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchPricesRequest, WatchPricesResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SkinsService_WatchPricesClient = grpc.ServerStreamingClient[WatchPricesResponse]
+
+func (c *skinsServiceClient) ExportData(ctx context.Context, in *ExportDataRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportDataResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SkinsService_ServiceDesc.Streams[1], SkinsService_ExportData_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportDataRequest, ExportDataResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SkinsService_ExportDataClient = grpc.ServerStreamingClient[ExportDataResponse]
+
 func (c *skinsServiceClient) GetTrending(ctx context.Context, in *GetTrendingRequest, opts ...grpc.CallOption) (*GetTrendingResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetTrendingResponse)
@@ -123,6 +262,16 @@ func (c *skinsServiceClient) GetMarketOverview(ctx context.Context, in *GetMarke
 	return out, nil
 }
 
+func (c *skinsServiceClient) GetPopularSearches(ctx context.Context, in *GetPopularSearchesRequest, opts ...grpc.CallOption) (*GetPopularSearchesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPopularSearchesResponse)
+	err := c.cc.Invoke(ctx, SkinsService_GetPopularSearches_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *skinsServiceClient) GetTopGainers(ctx context.Context, in *GetTopGainersRequest, opts ...grpc.CallOption) (*GetTopGainersResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetTopGainersResponse)
@@ -143,19 +292,114 @@ func (c *skinsServiceClient) GetTopLosers(ctx context.Context, in *GetTopLosersR
 	return out, nil
 }
 
+func (c *skinsServiceClient) GetPriceSpreads(ctx context.Context, in *GetPriceSpreadsRequest, opts ...grpc.CallOption) (*GetPriceSpreadsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPriceSpreadsResponse)
+	err := c.cc.Invoke(ctx, SkinsService_GetPriceSpreads_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *skinsServiceClient) RecomputePriceStats(ctx context.Context, in *RecomputePriceStatsRequest, opts ...grpc.CallOption) (*RecomputePriceStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecomputePriceStatsResponse)
+	err := c.cc.Invoke(ctx, SkinsService_RecomputePriceStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *skinsServiceClient) CreateAlert(ctx context.Context, in *CreateAlertRequest, opts ...grpc.CallOption) (*CreateAlertResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateAlertResponse)
+	err := c.cc.Invoke(ctx, SkinsService_CreateAlert_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *skinsServiceClient) ListAlerts(ctx context.Context, in *ListAlertsRequest, opts ...grpc.CallOption) (*ListAlertsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAlertsResponse)
+	err := c.cc.Invoke(ctx, SkinsService_ListAlerts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *skinsServiceClient) DeleteAlert(ctx context.Context, in *DeleteAlertRequest, opts ...grpc.CallOption) (*DeleteAlertResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteAlertResponse)
+	err := c.cc.Invoke(ctx, SkinsService_DeleteAlert_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *skinsServiceClient) RegisterWebhook(ctx context.Context, in *RegisterWebhookRequest, opts ...grpc.CallOption) (*RegisterWebhookResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RegisterWebhookResponse)
+	err := c.cc.Invoke(ctx, SkinsService_RegisterWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *skinsServiceClient) ListDeliveries(ctx context.Context, in *ListDeliveriesRequest, opts ...grpc.CallOption) (*ListDeliveriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDeliveriesResponse)
+	err := c.cc.Invoke(ctx, SkinsService_ListDeliveries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SkinsServiceServer is the server API for SkinsService service.
 // All implementations must embed UnimplementedSkinsServiceServer
 // for forward compatibility.
 type SkinsServiceServer interface {
+	CreateSkin(context.Context, *CreateSkinRequest) (*CreateSkinResponse, error)
+	// Меняет только поля из update_mask. Через gateway маска заполняется по полям JSON-тела.
+	UpdateSkin(context.Context, *UpdateSkinRequest) (*UpdateSkinResponse, error)
+	DeleteSkin(context.Context, *DeleteSkinRequest) (*DeleteSkinResponse, error)
+	BatchGetSkins(context.Context, *BatchGetSkinsRequest) (*BatchGetSkinsResponse, error)
 	GetSkins(context.Context, *GetSkinsRequest) (*GetSkinsResponse, error)
 	GetSkinBySlug(context.Context, *GetSkinBySlugRequest) (*GetSkinBySlugResponse, error)
 	SearchSkins(context.Context, *SearchSkinsRequest) (*SearchSkinsResponse, error)
+	// Подсказки для строки поиска из префиксного индекса в Redis
+	Autocomplete(context.Context, *AutocompleteRequest) (*AutocompleteResponse, error)
 	GetPopularSkins(context.Context, *GetPopularSkinsRequest) (*GetPopularSkinsResponse, error)
 	GetPriceChart(context.Context, *GetPriceChartRequest) (*GetPriceChartResponse, error)
+	// Последние цены скина на площадках и где выгоднее купить и продать.
+	GetPriceComparison(context.Context, *GetPriceComparisonRequest) (*GetPriceComparisonResponse, error)
+	// Поток обновлений цен. Через gateway отдается как JSON, по одному сообщению на строку.
+	WatchPrices(*WatchPricesRequest, grpc.ServerStreamingServer[WatchPricesResponse]) error
+	// Выгрузка каталога или истории цен в CSV, NDJSON или Parquet кусками по мере чтения из шардов.
+	// Только gRPC: grpc-gateway добавляет разделитель между сообщениями потока, что портит
+	// бинарный Parquet, поэтому HTTP-выгрузка /api/v1/export/{dataset} обслуживается gateway напрямую.
+	ExportData(*ExportDataRequest, grpc.ServerStreamingServer[ExportDataResponse]) error
 	GetTrending(context.Context, *GetTrendingRequest) (*GetTrendingResponse, error)
 	GetMarketOverview(context.Context, *GetMarketOverviewRequest) (*GetMarketOverviewResponse, error)
+	// Частые поисковые запросы за скользящий час или сутки, в том числе не нашедшие ни одного скина
+	GetPopularSearches(context.Context, *GetPopularSearchesRequest) (*GetPopularSearchesResponse, error)
 	GetTopGainers(context.Context, *GetTopGainersRequest) (*GetTopGainersResponse, error)
 	GetTopLosers(context.Context, *GetTopLosersRequest) (*GetTopLosersResponse, error)
+	// Скины каталога с наибольшим спредом между площадками.
+	GetPriceSpreads(context.Context, *GetPriceSpreadsRequest) (*GetPriceSpreadsResponse, error)
+	RecomputePriceStats(context.Context, *RecomputePriceStatsRequest) (*RecomputePriceStatsResponse, error)
+	CreateAlert(context.Context, *CreateAlertRequest) (*CreateAlertResponse, error)
+	ListAlerts(context.Context, *ListAlertsRequest) (*ListAlertsResponse, error)
+	DeleteAlert(context.Context, *DeleteAlertRequest) (*DeleteAlertResponse, error)
+	RegisterWebhook(context.Context, *RegisterWebhookRequest) (*RegisterWebhookResponse, error)
+	ListDeliveries(context.Context, *ListDeliveriesRequest) (*ListDeliveriesResponse, error)
 	mustEmbedUnimplementedSkinsServiceServer()
 }
 
@@ -166,6 +410,18 @@ type SkinsServiceServer interface {
 // pointer dereference when methods are called.
 type UnimplementedSkinsServiceServer struct{}
 
+func (UnimplementedSkinsServiceServer) CreateSkin(context.Context, *CreateSkinRequest) (*CreateSkinResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateSkin not implemented")
+}
+func (UnimplementedSkinsServiceServer) UpdateSkin(context.Context, *UpdateSkinRequest) (*UpdateSkinResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateSkin not implemented")
+}
+func (UnimplementedSkinsServiceServer) DeleteSkin(context.Context, *DeleteSkinRequest) (*DeleteSkinResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteSkin not implemented")
+}
+func (UnimplementedSkinsServiceServer) BatchGetSkins(context.Context, *BatchGetSkinsRequest) (*BatchGetSkinsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method BatchGetSkins not implemented")
+}
 func (UnimplementedSkinsServiceServer) GetSkins(context.Context, *GetSkinsRequest) (*GetSkinsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetSkins not implemented")
 }
@@ -175,24 +431,60 @@ func (UnimplementedSkinsServiceServer) GetSkinBySlug(context.Context, *GetSkinBy
 func (UnimplementedSkinsServiceServer) SearchSkins(context.Context, *SearchSkinsRequest) (*SearchSkinsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method SearchSkins not implemented")
 }
+func (UnimplementedSkinsServiceServer) Autocomplete(context.Context, *AutocompleteRequest) (*AutocompleteResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Autocomplete not implemented")
+}
 func (UnimplementedSkinsServiceServer) GetPopularSkins(context.Context, *GetPopularSkinsRequest) (*GetPopularSkinsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetPopularSkins not implemented")
 }
 func (UnimplementedSkinsServiceServer) GetPriceChart(context.Context, *GetPriceChartRequest) (*GetPriceChartResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetPriceChart not implemented")
 }
+func (UnimplementedSkinsServiceServer) GetPriceComparison(context.Context, *GetPriceComparisonRequest) (*GetPriceComparisonResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPriceComparison not implemented")
+}
+func (UnimplementedSkinsServiceServer) WatchPrices(*WatchPricesRequest, grpc.ServerStreamingServer[WatchPricesResponse]) error {
+	return status.Error(codes.Unimplemented, "method WatchPrices not implemented")
+}
+func (UnimplementedSkinsServiceServer) ExportData(*ExportDataRequest, grpc.ServerStreamingServer[ExportDataResponse]) error {
+	return status.Error(codes.Unimplemented, "method ExportData not implemented")
+}
 func (UnimplementedSkinsServiceServer) GetTrending(context.Context, *GetTrendingRequest) (*GetTrendingResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetTrending not implemented")
 }
 func (UnimplementedSkinsServiceServer) GetMarketOverview(context.Context, *GetMarketOverviewRequest) (*GetMarketOverviewResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetMarketOverview not implemented")
 }
+func (UnimplementedSkinsServiceServer) GetPopularSearches(context.Context, *GetPopularSearchesRequest) (*GetPopularSearchesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPopularSearches not implemented")
+}
 func (UnimplementedSkinsServiceServer) GetTopGainers(context.Context, *GetTopGainersRequest) (*GetTopGainersResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetTopGainers not implemented")
 }
 func (UnimplementedSkinsServiceServer) GetTopLosers(context.Context, *GetTopLosersRequest) (*GetTopLosersResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetTopLosers not implemented")
 }
+func (UnimplementedSkinsServiceServer) GetPriceSpreads(context.Context, *GetPriceSpreadsRequest) (*GetPriceSpreadsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPriceSpreads not implemented")
+}
+func (UnimplementedSkinsServiceServer) RecomputePriceStats(context.Context, *RecomputePriceStatsRequest) (*RecomputePriceStatsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RecomputePriceStats not implemented")
+}
+func (UnimplementedSkinsServiceServer) CreateAlert(context.Context, *CreateAlertRequest) (*CreateAlertResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateAlert not implemented")
+}
+func (UnimplementedSkinsServiceServer) ListAlerts(context.Context, *ListAlertsRequest) (*ListAlertsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListAlerts not implemented")
+}
+func (UnimplementedSkinsServiceServer) DeleteAlert(context.Context, *DeleteAlertRequest) (*DeleteAlertResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteAlert not implemented")
+}
+func (UnimplementedSkinsServiceServer) RegisterWebhook(context.Context, *RegisterWebhookRequest) (*RegisterWebhookResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RegisterWebhook not implemented")
+}
+func (UnimplementedSkinsServiceServer) ListDeliveries(context.Context, *ListDeliveriesRequest) (*ListDeliveriesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListDeliveries not implemented")
+}
 func (UnimplementedSkinsServiceServer) mustEmbedUnimplementedSkinsServiceServer() {}
 func (UnimplementedSkinsServiceServer) testEmbeddedByValue()                      {}
 
@@ -214,6 +506,78 @@ func RegisterSkinsServiceServer(s grpc.ServiceRegistrar, srv SkinsServiceServer)
 	s.RegisterService(&SkinsService_ServiceDesc, srv)
 }
 
+func _SkinsService_CreateSkin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSkinRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SkinsServiceServer).CreateSkin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SkinsService_CreateSkin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SkinsServiceServer).CreateSkin(ctx, req.(*CreateSkinRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SkinsService_UpdateSkin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSkinRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SkinsServiceServer).UpdateSkin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SkinsService_UpdateSkin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SkinsServiceServer).UpdateSkin(ctx, req.(*UpdateSkinRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SkinsService_DeleteSkin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSkinRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SkinsServiceServer).DeleteSkin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SkinsService_DeleteSkin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SkinsServiceServer).DeleteSkin(ctx, req.(*DeleteSkinRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SkinsService_BatchGetSkins_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetSkinsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SkinsServiceServer).BatchGetSkins(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SkinsService_BatchGetSkins_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SkinsServiceServer).BatchGetSkins(ctx, req.(*BatchGetSkinsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SkinsService_GetSkins_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetSkinsRequest)
 	if err := dec(in); err != nil {
@@ -268,6 +632,24 @@ func _SkinsService_SearchSkins_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SkinsService_Autocomplete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AutocompleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SkinsServiceServer).Autocomplete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SkinsService_Autocomplete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SkinsServiceServer).Autocomplete(ctx, req.(*AutocompleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SkinsService_GetPopularSkins_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetPopularSkinsRequest)
 	if err := dec(in); err != nil {
@@ -304,6 +686,46 @@ func _SkinsService_GetPriceChart_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SkinsService_GetPriceComparison_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPriceComparisonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SkinsServiceServer).GetPriceComparison(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SkinsService_GetPriceComparison_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SkinsServiceServer).GetPriceComparison(ctx, req.(*GetPriceComparisonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SkinsService_WatchPrices_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchPricesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SkinsServiceServer).WatchPrices(m, &grpc.GenericServerStream[WatchPricesRequest, WatchPricesResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SkinsService_WatchPricesServer = grpc.ServerStreamingServer[WatchPricesResponse]
+
+func _SkinsService_ExportData_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportDataRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SkinsServiceServer).ExportData(m, &grpc.GenericServerStream[ExportDataRequest, ExportDataResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SkinsService_ExportDataServer = grpc.ServerStreamingServer[ExportDataResponse]
+
 func _SkinsService_GetTrending_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetTrendingRequest)
 	if err := dec(in); err != nil {
@@ -340,6 +762,24 @@ func _SkinsService_GetMarketOverview_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SkinsService_GetPopularSearches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPopularSearchesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SkinsServiceServer).GetPopularSearches(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SkinsService_GetPopularSearches_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SkinsServiceServer).GetPopularSearches(ctx, req.(*GetPopularSearchesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SkinsService_GetTopGainers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetTopGainersRequest)
 	if err := dec(in); err != nil {
@@ -376,6 +816,132 @@ func _SkinsService_GetTopLosers_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SkinsService_GetPriceSpreads_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPriceSpreadsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SkinsServiceServer).GetPriceSpreads(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SkinsService_GetPriceSpreads_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SkinsServiceServer).GetPriceSpreads(ctx, req.(*GetPriceSpreadsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SkinsService_RecomputePriceStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecomputePriceStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SkinsServiceServer).RecomputePriceStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SkinsService_RecomputePriceStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SkinsServiceServer).RecomputePriceStats(ctx, req.(*RecomputePriceStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SkinsService_CreateAlert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAlertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SkinsServiceServer).CreateAlert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SkinsService_CreateAlert_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SkinsServiceServer).CreateAlert(ctx, req.(*CreateAlertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SkinsService_ListAlerts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAlertsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SkinsServiceServer).ListAlerts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SkinsService_ListAlerts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SkinsServiceServer).ListAlerts(ctx, req.(*ListAlertsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SkinsService_DeleteAlert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAlertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SkinsServiceServer).DeleteAlert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SkinsService_DeleteAlert_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SkinsServiceServer).DeleteAlert(ctx, req.(*DeleteAlertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SkinsService_RegisterWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SkinsServiceServer).RegisterWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SkinsService_RegisterWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SkinsServiceServer).RegisterWebhook(ctx, req.(*RegisterWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SkinsService_ListDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeliveriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SkinsServiceServer).ListDeliveries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SkinsService_ListDeliveries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SkinsServiceServer).ListDeliveries(ctx, req.(*ListDeliveriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SkinsService_ServiceDesc is the grpc.ServiceDesc for SkinsService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -383,6 +949,22 @@ var SkinsService_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "skins.service.v1.SkinsService",
 	HandlerType: (*SkinsServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateSkin",
+			Handler:    _SkinsService_CreateSkin_Handler,
+		},
+		{
+			MethodName: "UpdateSkin",
+			Handler:    _SkinsService_UpdateSkin_Handler,
+		},
+		{
+			MethodName: "DeleteSkin",
+			Handler:    _SkinsService_DeleteSkin_Handler,
+		},
+		{
+			MethodName: "BatchGetSkins",
+			Handler:    _SkinsService_BatchGetSkins_Handler,
+		},
 		{
 			MethodName: "GetSkins",
 			Handler:    _SkinsService_GetSkins_Handler,
@@ -395,6 +977,10 @@ var SkinsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SearchSkins",
 			Handler:    _SkinsService_SearchSkins_Handler,
 		},
+		{
+			MethodName: "Autocomplete",
+			Handler:    _SkinsService_Autocomplete_Handler,
+		},
 		{
 			MethodName: "GetPopularSkins",
 			Handler:    _SkinsService_GetPopularSkins_Handler,
@@ -403,6 +989,10 @@ var SkinsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetPriceChart",
 			Handler:    _SkinsService_GetPriceChart_Handler,
 		},
+		{
+			MethodName: "GetPriceComparison",
+			Handler:    _SkinsService_GetPriceComparison_Handler,
+		},
 		{
 			MethodName: "GetTrending",
 			Handler:    _SkinsService_GetTrending_Handler,
@@ -411,6 +1001,10 @@ var SkinsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetMarketOverview",
 			Handler:    _SkinsService_GetMarketOverview_Handler,
 		},
+		{
+			MethodName: "GetPopularSearches",
+			Handler:    _SkinsService_GetPopularSearches_Handler,
+		},
 		{
 			MethodName: "GetTopGainers",
 			Handler:    _SkinsService_GetTopGainers_Handler,
@@ -419,7 +1013,46 @@ var SkinsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetTopLosers",
 			Handler:    _SkinsService_GetTopLosers_Handler,
 		},
+		{
+			MethodName: "GetPriceSpreads",
+			Handler:    _SkinsService_GetPriceSpreads_Handler,
+		},
+		{
+			MethodName: "RecomputePriceStats",
+			Handler:    _SkinsService_RecomputePriceStats_Handler,
+		},
+		{
+			MethodName: "CreateAlert",
+			Handler:    _SkinsService_CreateAlert_Handler,
+		},
+		{
+			MethodName: "ListAlerts",
+			Handler:    _SkinsService_ListAlerts_Handler,
+		},
+		{
+			MethodName: "DeleteAlert",
+			Handler:    _SkinsService_DeleteAlert_Handler,
+		},
+		{
+			MethodName: "RegisterWebhook",
+			Handler:    _SkinsService_RegisterWebhook_Handler,
+		},
+		{
+			MethodName: "ListDeliveries",
+			Handler:    _SkinsService_ListDeliveries_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchPrices",
+			Handler:       _SkinsService_WatchPrices_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ExportData",
+			Handler:       _SkinsService_ExportData_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "skins_api/skins.proto",
 }
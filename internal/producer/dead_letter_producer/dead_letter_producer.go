@@ -0,0 +1,31 @@
+package deadletterproducer
+
+import (
+	"github.com/segmentio/kafka-go"
+)
+
+// Заголовки сообщения в DLQ.
+const (
+	HeaderError         = "x-dlq-error"
+	HeaderAttempts      = "x-dlq-attempts"
+	HeaderOriginalTopic = "x-dlq-original-topic"
+)
+
+type DeadLetterProducer struct {
+	writer *kafka.Writer
+}
+
+func NewDeadLetterProducer(kafkaBroker []string, topicName string) *DeadLetterProducer {
+	return &DeadLetterProducer{
+		writer: &kafka.Writer{
+			Addr:         kafka.TCP(kafkaBroker...),
+			Topic:        topicName,
+			Balancer:     &kafka.Hash{},
+			RequiredAcks: kafka.RequireAll,
+		},
+	}
+}
+
+func (p *DeadLetterProducer) Close() error {
+	return p.writer.Close()
+}
@@ -0,0 +1,38 @@
+package deadletterproducer
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/segmentio/kafka-go"
+)
+
+// PublishDeadLetter сохраняет исходные ключ и payload сообщения без изменений,
+// а причину ошибки и число попыток передает в заголовках.
+func (p *DeadLetterProducer) PublishDeadLetter(ctx context.Context, msg kafka.Message, cause error, attempts int) error {
+	headers := make([]kafka.Header, 0, len(msg.Headers)+3)
+	for _, h := range msg.Headers {
+		switch h.Key {
+		case HeaderError, HeaderAttempts, HeaderOriginalTopic:
+			continue
+		}
+		headers = append(headers, h)
+	}
+	headers = append(headers,
+		kafka.Header{Key: HeaderError, Value: []byte(cause.Error())},
+		kafka.Header{Key: HeaderAttempts, Value: []byte(strconv.Itoa(attempts))},
+		kafka.Header{Key: HeaderOriginalTopic, Value: []byte(msg.Topic)},
+	)
+
+	err := p.writer.WriteMessages(ctx, kafka.Message{
+		Key:     msg.Key,
+		Value:   msg.Value,
+		Headers: headers,
+	})
+	if err != nil {
+		return fmt.Errorf("write dead letter: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,24 @@
+package pricealertproducer
+
+import (
+	"github.com/segmentio/kafka-go"
+)
+
+type PriceAlertProducer struct {
+	writer *kafka.Writer
+}
+
+func NewPriceAlertProducer(kafkaBroker []string, topicName string) *PriceAlertProducer {
+	return &PriceAlertProducer{
+		writer: &kafka.Writer{
+			Addr:         kafka.TCP(kafkaBroker...),
+			Topic:        topicName,
+			Balancer:     &kafka.Hash{},
+			RequiredAcks: kafka.RequireAll,
+		},
+	}
+}
+
+func (p *PriceAlertProducer) Close() error {
+	return p.writer.Close()
+}
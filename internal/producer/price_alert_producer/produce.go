@@ -0,0 +1,28 @@
+package pricealertproducer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/kedr891/cs-parser/internal/models"
+	"github.com/segmentio/kafka-go"
+)
+
+// PublishPriceAlert отправляет сработавшее правило с ключом alert_id.
+func (p *PriceAlertProducer) PublishPriceAlert(ctx context.Context, event *models.PriceAlertEvent) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("marshal price alert event: %w", err)
+	}
+
+	err = p.writer.WriteMessages(ctx, kafka.Message{
+		Key:   []byte(event.AlertID.String()),
+		Value: data,
+	})
+	if err != nil {
+		return fmt.Errorf("write price alert event: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,24 @@
+package priceupdateproducer
+
+import (
+	"github.com/segmentio/kafka-go"
+)
+
+type PriceUpdateProducer struct {
+	writer *kafka.Writer
+}
+
+func NewPriceUpdateProducer(kafkaBroker []string, topicName string) *PriceUpdateProducer {
+	return &PriceUpdateProducer{
+		writer: &kafka.Writer{
+			Addr:         kafka.TCP(kafkaBroker...),
+			Topic:        topicName,
+			Balancer:     &kafka.Hash{},
+			RequiredAcks: kafka.RequireAll,
+		},
+	}
+}
+
+func (p *PriceUpdateProducer) Close() error {
+	return p.writer.Close()
+}
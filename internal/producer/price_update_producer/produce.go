@@ -0,0 +1,29 @@
+package priceupdateproducer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/kedr891/cs-parser/internal/models"
+	"github.com/segmentio/kafka-go"
+)
+
+// PublishPriceUpdate отправляет событие с ключом skin_id, чтобы все обновления
+// одного скина попадали в одну партицию и обрабатывались по порядку.
+func (p *PriceUpdateProducer) PublishPriceUpdate(ctx context.Context, event *models.PriceUpdateEvent) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("marshal price update event: %w", err)
+	}
+
+	err = p.writer.WriteMessages(ctx, kafka.Message{
+		Key:   []byte(event.SkinID.String()),
+		Value: data,
+	})
+	if err != nil {
+		return fmt.Errorf("write price update event: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,29 @@
+package skindiscoveredproducer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/kedr891/cs-parser/internal/models"
+	"github.com/segmentio/kafka-go"
+)
+
+// PublishSkinDiscovered отправляет событие с ключом market_hash_name: у нового предмета
+// еще нет skin_id, а повторные находки одного предмета должны идти в одну партицию.
+func (p *SkinDiscoveredProducer) PublishSkinDiscovered(ctx context.Context, event *models.SkinDiscoveredEvent) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("marshal skin discovered event: %w", err)
+	}
+
+	err = p.writer.WriteMessages(ctx, kafka.Message{
+		Key:   []byte(event.MarketHashName),
+		Value: data,
+	})
+	if err != nil {
+		return fmt.Errorf("write skin discovered event: %w", err)
+	}
+
+	return nil
+}
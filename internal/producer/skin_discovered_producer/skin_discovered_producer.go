@@ -0,0 +1,24 @@
+package skindiscoveredproducer
+
+import (
+	"github.com/segmentio/kafka-go"
+)
+
+type SkinDiscoveredProducer struct {
+	writer *kafka.Writer
+}
+
+func NewSkinDiscoveredProducer(kafkaBroker []string, topicName string) *SkinDiscoveredProducer {
+	return &SkinDiscoveredProducer{
+		writer: &kafka.Writer{
+			Addr:         kafka.TCP(kafkaBroker...),
+			Topic:        topicName,
+			Balancer:     &kafka.Hash{},
+			RequiredAcks: kafka.RequireAll,
+		},
+	}
+}
+
+func (p *SkinDiscoveredProducer) Close() error {
+	return p.writer.Close()
+}
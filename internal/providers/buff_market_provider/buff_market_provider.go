@@ -0,0 +1,47 @@
+package buffmarketprovider
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"github.com/kedr891/cs-parser/internal/models"
+	"github.com/kedr891/cs-parser/internal/providers"
+)
+
+const (
+	_defaultBaseURL   = "https://buff.163.com"
+	_defaultRateLimit = 20
+	_defaultTimeout   = 10 * time.Second
+	_game             = "csgo"
+	_currencyCNY      = "CNY"
+)
+
+type BuffMarketProvider struct {
+	baseURL    string
+	httpClient *http.Client
+	limiter    *rate.Limiter
+	converter  *providers.CurrencyConverter
+}
+
+func NewBuffMarketProvider(baseURL string, rateLimitPerMinute int, converter *providers.CurrencyConverter) *BuffMarketProvider {
+	if baseURL == "" {
+		baseURL = _defaultBaseURL
+	}
+	if rateLimitPerMinute <= 0 {
+		rateLimitPerMinute = _defaultRateLimit
+	}
+
+	return &BuffMarketProvider{
+		baseURL:    strings.TrimRight(baseURL, "/"),
+		httpClient: &http.Client{Timeout: _defaultTimeout},
+		limiter:    providers.NewLimiter(rateLimitPerMinute),
+		converter:  converter,
+	}
+}
+
+func (p *BuffMarketProvider) Source() models.PriceSource {
+	return models.SourceBuffMarket
+}
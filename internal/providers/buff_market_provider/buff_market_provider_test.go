@@ -0,0 +1,76 @@
+package buffmarketprovider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/kedr891/cs-parser/internal/models"
+	"github.com/kedr891/cs-parser/internal/providers"
+	"github.com/stretchr/testify/suite"
+)
+
+type BuffMarketProviderSuite struct {
+	suite.Suite
+	ctx      context.Context
+	fixture  string
+	query    map[string]string
+	server   *httptest.Server
+	provider *BuffMarketProvider
+}
+
+func (suite *BuffMarketProviderSuite) SetupTest() {
+	suite.ctx = context.Background()
+	suite.fixture = "goods.json"
+	suite.query = map[string]string{}
+	suite.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		suite.Equal("/api/market/goods", r.URL.Path)
+		for key := range r.URL.Query() {
+			suite.query[key] = r.URL.Query().Get(key)
+		}
+
+		data, err := os.ReadFile("testdata/" + suite.fixture)
+		suite.Require().NoError(err)
+		_, _ = w.Write(data)
+	}))
+	converter := providers.NewCurrencyConverter(map[string]float64{"CNY": 0.14})
+	suite.provider = NewBuffMarketProvider(suite.server.URL, 6000, converter)
+}
+
+func (suite *BuffMarketProviderSuite) TearDownTest() {
+	suite.server.Close()
+}
+
+func TestBuffMarketProviderSuite(t *testing.T) {
+	suite.Run(t, new(BuffMarketProviderSuite))
+}
+
+func (suite *BuffMarketProviderSuite) TestFetchPrice_ConvertsFromCNY() {
+	price, err := suite.provider.FetchPrice(suite.ctx, "AK-47 | Redline (Field-Tested)")
+
+	suite.Require().NoError(err)
+	suite.Equal(models.SourceBuffMarket, price.Source)
+	suite.InDelta(11.97, price.Price, 0.001)
+	suite.Equal("USD", price.Currency)
+	suite.Equal(5071, price.Volume)
+	suite.Equal("csgo", suite.query["game"])
+	suite.Equal("AK-47 | Redline (Field-Tested)", suite.query["search"])
+}
+
+func (suite *BuffMarketProviderSuite) TestFetchPrice_NotFound() {
+	price, err := suite.provider.FetchPrice(suite.ctx, "AK-47 | Redline (Minimal Wear)")
+
+	suite.ErrorIs(err, providers.ErrPriceNotFound)
+	suite.Nil(price)
+}
+
+func (suite *BuffMarketProviderSuite) TestFetchPrice_RateLimitedByCode() {
+	suite.fixture = "goods_rate_limited.json"
+
+	price, err := suite.provider.FetchPrice(suite.ctx, "AK-47 | Redline (Field-Tested)")
+
+	suite.ErrorIs(err, providers.ErrRateLimited)
+	suite.Nil(price)
+}
@@ -0,0 +1,104 @@
+package buffmarketprovider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/kedr891/cs-parser/internal/models"
+	"github.com/kedr891/cs-parser/internal/providers"
+)
+
+type goodsResponse struct {
+	Code  string `json:"code"`
+	Error string `json:"error"`
+	Data  struct {
+		Items []goodsItem `json:"items"`
+	} `json:"data"`
+}
+
+type goodsItem struct {
+	MarketHashName string `json:"market_hash_name"`
+	SellMinPrice   string `json:"sell_min_price"`
+	SellNum        int    `json:"sell_num"`
+}
+
+// FetchPrice ищет предмет через /api/market/goods. Buff возвращает цены в юанях
+// строками, а ошибки лимита - кодом в теле ответа при статусе 200.
+func (p *BuffMarketProvider) FetchPrice(ctx context.Context, marketHashName string) (*models.MarketPrice, error) {
+	if err := p.limiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+
+	query := url.Values{}
+	query.Set("game", _game)
+	query.Set("page_num", "1")
+	query.Set("search", marketHashName)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.baseURL+"/api/market/goods?"+query.Encode(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("build request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("request goods: %w", err)
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusTooManyRequests:
+		return nil, fmt.Errorf("buff market: %w", providers.ErrRateLimited)
+	default:
+		return nil, fmt.Errorf("buff market: unexpected status %d", resp.StatusCode)
+	}
+
+	var body goodsResponse
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return nil, fmt.Errorf("decode goods: %w", err)
+	}
+
+	return p.parseGoods(marketHashName, &body)
+}
+
+func (p *BuffMarketProvider) parseGoods(marketHashName string, body *goodsResponse) (*models.MarketPrice, error) {
+	switch body.Code {
+	case "OK":
+	case "Action Frequency Limited":
+		return nil, fmt.Errorf("buff market: %w", providers.ErrRateLimited)
+	default:
+		return nil, fmt.Errorf("buff market: api error %s: %s", body.Code, body.Error)
+	}
+
+	for _, item := range body.Data.Items {
+		if item.MarketHashName != marketHashName {
+			continue
+		}
+
+		price, err := providers.ParseAmount(item.SellMinPrice)
+		if err != nil || price == 0 {
+			return nil, fmt.Errorf("buff market: %w", providers.ErrPriceNotFound)
+		}
+
+		priceUSD, err := p.converter.ToUSD(price, _currencyCNY)
+		if err != nil {
+			return nil, fmt.Errorf("buff market: %w", err)
+		}
+
+		return &models.MarketPrice{
+			MarketHashName: marketHashName,
+			Source:         models.SourceBuffMarket,
+			Price:          priceUSD,
+			Currency:       "USD",
+			Volume:         item.SellNum,
+			FetchedAt:      time.Now(),
+		}, nil
+	}
+
+	return nil, fmt.Errorf("buff market: %w", providers.ErrPriceNotFound)
+}
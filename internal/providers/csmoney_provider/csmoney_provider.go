@@ -0,0 +1,46 @@
+package csmoneyprovider
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"github.com/kedr891/cs-parser/internal/models"
+	"github.com/kedr891/cs-parser/internal/providers"
+)
+
+const (
+	_defaultBaseURL   = "https://cs.money"
+	_defaultRateLimit = 30
+	_defaultTimeout   = 10 * time.Second
+	_pageLimit        = "60"
+)
+
+type CSMoneyProvider struct {
+	baseURL    string
+	httpClient *http.Client
+	limiter    *rate.Limiter
+	converter  *providers.CurrencyConverter
+}
+
+func NewCSMoneyProvider(baseURL string, rateLimitPerMinute int, converter *providers.CurrencyConverter) *CSMoneyProvider {
+	if baseURL == "" {
+		baseURL = _defaultBaseURL
+	}
+	if rateLimitPerMinute <= 0 {
+		rateLimitPerMinute = _defaultRateLimit
+	}
+
+	return &CSMoneyProvider{
+		baseURL:    strings.TrimRight(baseURL, "/"),
+		httpClient: &http.Client{Timeout: _defaultTimeout},
+		limiter:    providers.NewLimiter(rateLimitPerMinute),
+		converter:  converter,
+	}
+}
+
+func (p *CSMoneyProvider) Source() models.PriceSource {
+	return models.SourceCSMoney
+}
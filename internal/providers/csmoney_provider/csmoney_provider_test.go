@@ -0,0 +1,82 @@
+package csmoneyprovider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/kedr891/cs-parser/internal/models"
+	"github.com/kedr891/cs-parser/internal/providers"
+	"github.com/stretchr/testify/suite"
+)
+
+type CSMoneyProviderSuite struct {
+	suite.Suite
+	ctx      context.Context
+	fixture  string
+	status   int
+	query    map[string]string
+	server   *httptest.Server
+	provider *CSMoneyProvider
+}
+
+func (suite *CSMoneyProviderSuite) SetupTest() {
+	suite.ctx = context.Background()
+	suite.status = http.StatusOK
+	suite.query = map[string]string{}
+	suite.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		suite.Equal("/1.0/market/sell-orders", r.URL.Path)
+		for key := range r.URL.Query() {
+			suite.query[key] = r.URL.Query().Get(key)
+		}
+
+		w.WriteHeader(suite.status)
+		if suite.fixture != "" {
+			data, err := os.ReadFile("testdata/" + suite.fixture)
+			suite.Require().NoError(err)
+			_, _ = w.Write(data)
+		}
+	}))
+	suite.provider = NewCSMoneyProvider(suite.server.URL, 6000, providers.NewCurrencyConverter(nil))
+}
+
+func (suite *CSMoneyProviderSuite) TearDownTest() {
+	suite.server.Close()
+}
+
+func TestCSMoneyProviderSuite(t *testing.T) {
+	suite.Run(t, new(CSMoneyProviderSuite))
+}
+
+func (suite *CSMoneyProviderSuite) TestFetchPrice_MinPriceOfExactMatches() {
+	suite.fixture = "sell_orders.json"
+
+	price, err := suite.provider.FetchPrice(suite.ctx, "AK-47 | Redline (Field-Tested)")
+
+	suite.Require().NoError(err)
+	suite.Equal(models.SourceCSMoney, price.Source)
+	suite.InDelta(12.87, price.Price, 0.001)
+	suite.Equal("USD", price.Currency)
+	suite.Equal(3, price.Volume)
+	suite.Equal("AK-47 | Redline (Field-Tested)", suite.query["name"])
+}
+
+func (suite *CSMoneyProviderSuite) TestFetchPrice_NoListings() {
+	suite.fixture = "sell_orders_empty.json"
+
+	price, err := suite.provider.FetchPrice(suite.ctx, "AK-47 | Redline (Field-Tested)")
+
+	suite.ErrorIs(err, providers.ErrPriceNotFound)
+	suite.Nil(price)
+}
+
+func (suite *CSMoneyProviderSuite) TestFetchPrice_RateLimited() {
+	suite.status = http.StatusTooManyRequests
+
+	price, err := suite.provider.FetchPrice(suite.ctx, "AK-47 | Redline (Field-Tested)")
+
+	suite.ErrorIs(err, providers.ErrRateLimited)
+	suite.Nil(price)
+}
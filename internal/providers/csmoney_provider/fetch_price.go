@@ -0,0 +1,108 @@
+package csmoneyprovider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/kedr891/cs-parser/internal/models"
+	"github.com/kedr891/cs-parser/internal/providers"
+)
+
+type sellOrdersResponse struct {
+	Items []sellOrder `json:"items"`
+}
+
+type sellOrder struct {
+	Asset struct {
+		Names struct {
+			Full string `json:"full"`
+		} `json:"names"`
+	} `json:"asset"`
+	Pricing struct {
+		Computed float64 `json:"computed"`
+	} `json:"pricing"`
+}
+
+// FetchPrice ищет активные лоты по названию и берет минимальную цену среди точных совпадений.
+// Поиск CS.Money нечеткий, поэтому лоты других предметов из выдачи отбрасываются.
+func (p *CSMoneyProvider) FetchPrice(ctx context.Context, marketHashName string) (*models.MarketPrice, error) {
+	if err := p.limiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+
+	query := url.Values{}
+	query.Set("limit", _pageLimit)
+	query.Set("offset", "0")
+	query.Set("name", marketHashName)
+	query.Set("order", "asc")
+	query.Set("sort", "price")
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.baseURL+"/1.0/market/sell-orders?"+query.Encode(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("build request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("request sell orders: %w", err)
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusTooManyRequests:
+		return nil, fmt.Errorf("csmoney: %w", providers.ErrRateLimited)
+	case http.StatusNotFound:
+		return nil, fmt.Errorf("csmoney: %w", providers.ErrPriceNotFound)
+	default:
+		return nil, fmt.Errorf("csmoney: unexpected status %d", resp.StatusCode)
+	}
+
+	var body sellOrdersResponse
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return nil, fmt.Errorf("decode sell orders: %w", err)
+	}
+
+	return p.parseSellOrders(marketHashName, &body)
+}
+
+func (p *CSMoneyProvider) parseSellOrders(marketHashName string, body *sellOrdersResponse) (*models.MarketPrice, error) {
+	var (
+		minPrice float64
+		count    int
+	)
+
+	for _, item := range body.Items {
+		if item.Asset.Names.Full != marketHashName || item.Pricing.Computed <= 0 {
+			continue
+		}
+		if count == 0 || item.Pricing.Computed < minPrice {
+			minPrice = item.Pricing.Computed
+		}
+		count++
+	}
+
+	if count == 0 {
+		return nil, fmt.Errorf("csmoney: %w", providers.ErrPriceNotFound)
+	}
+
+	// CS.Money отдает цены в USD, конвертация только округляет до центов.
+	priceUSD, err := p.converter.ToUSD(minPrice, "USD")
+	if err != nil {
+		return nil, fmt.Errorf("csmoney: %w", err)
+	}
+
+	return &models.MarketPrice{
+		MarketHashName: marketHashName,
+		Source:         models.SourceCSMoney,
+		Price:          priceUSD,
+		Currency:       "USD",
+		Volume:         count,
+		FetchedAt:      time.Now(),
+	}, nil
+}
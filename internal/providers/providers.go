@@ -0,0 +1,90 @@
+package providers
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+var (
+	ErrPriceNotFound       = errors.New("price not found")
+	ErrRateLimited         = errors.New("rate limited by marketplace")
+	ErrUnsupportedCurrency = errors.New("unsupported currency")
+)
+
+// NewLimiter создает лимитер на perMinute запросов в минуту без накопления burst,
+// чтобы запросы к маркетплейсу шли равномерно.
+func NewLimiter(perMinute int) *rate.Limiter {
+	if perMinute <= 0 {
+		perMinute = 1
+	}
+	return rate.NewLimiter(rate.Every(time.Minute/time.Duration(perMinute)), 1)
+}
+
+type CurrencyConverter struct {
+	ratesToUSD map[string]float64
+}
+
+func NewCurrencyConverter(ratesToUSD map[string]float64) *CurrencyConverter {
+	rates := make(map[string]float64, len(ratesToUSD)+1)
+	for currency, rate := range ratesToUSD {
+		rates[strings.ToUpper(currency)] = rate
+	}
+	rates["USD"] = 1
+
+	return &CurrencyConverter{ratesToUSD: rates}
+}
+
+// ToUSD переводит сумму в USD и округляет до центов, как хранится в skins.current_price.
+func (c *CurrencyConverter) ToUSD(amount float64, currency string) (float64, error) {
+	rate, ok := c.ratesToUSD[strings.ToUpper(currency)]
+	if !ok || rate <= 0 {
+		return 0, fmt.Errorf("%w: %s", ErrUnsupportedCurrency, currency)
+	}
+
+	usd := amount * rate
+	return float64(int64(usd*100+0.5)) / 100, nil
+}
+
+// ParseAmount разбирает цены в форматах маркетплейсов: "$1,234.56", "1.234,56€", "¥ 85.5", "12,--€".
+func ParseAmount(raw string) (float64, error) {
+	cleaned := strings.Map(func(r rune) rune {
+		if (r >= '0' && r <= '9') || r == '.' || r == ',' {
+			return r
+		}
+		if r == '-' {
+			return '0'
+		}
+		return -1
+	}, raw)
+
+	if cleaned == "" {
+		return 0, fmt.Errorf("empty amount %q", raw)
+	}
+
+	lastDot := strings.LastIndex(cleaned, ".")
+	lastComma := strings.LastIndex(cleaned, ",")
+
+	if lastComma > lastDot {
+		// Запятая - десятичный разделитель, если после нее не три цифры тысячной группы.
+		if len(cleaned)-lastComma-1 != 3 || lastDot >= 0 {
+			cleaned = strings.ReplaceAll(cleaned, ".", "")
+			cleaned = strings.Replace(cleaned, ",", ".", 1)
+		} else {
+			cleaned = strings.ReplaceAll(cleaned, ",", "")
+		}
+	} else {
+		cleaned = strings.ReplaceAll(cleaned, ",", "")
+	}
+
+	amount, err := strconv.ParseFloat(cleaned, 64)
+	if err != nil {
+		return 0, fmt.Errorf("parse amount %q: %w", raw, err)
+	}
+
+	return amount, nil
+}
@@ -0,0 +1,50 @@
+package providers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type ProvidersSuite struct {
+	suite.Suite
+}
+
+func TestProvidersSuite(t *testing.T) {
+	suite.Run(t, new(ProvidersSuite))
+}
+
+func (suite *ProvidersSuite) TestParseAmount() {
+	cases := map[string]float64{
+		"$1,234.56": 1234.56,
+		"1.234,56€": 1234.56,
+		"¥ 85.5":    85.5,
+		"12,--€":    12,
+		"0,41€":     0.41,
+		"1,504":     1504,
+	}
+
+	for raw, expected := range cases {
+		amount, err := ParseAmount(raw)
+		suite.Require().NoError(err, raw)
+		suite.InDelta(expected, amount, 0.001, raw)
+	}
+
+	_, err := ParseAmount("N/A")
+	suite.Error(err)
+}
+
+func (suite *ProvidersSuite) TestCurrencyConverter() {
+	converter := NewCurrencyConverter(map[string]float64{"eur": 1.08})
+
+	usd, err := converter.ToUSD(10, "EUR")
+	suite.NoError(err)
+	suite.InDelta(10.8, usd, 0.001)
+
+	usd, err = converter.ToUSD(12.345, "USD")
+	suite.NoError(err)
+	suite.InDelta(12.35, usd, 0.001)
+
+	_, err = converter.ToUSD(10, "JPY")
+	suite.ErrorIs(err, ErrUnsupportedCurrency)
+}
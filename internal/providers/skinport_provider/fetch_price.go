@@ -0,0 +1,117 @@
+package skinportprovider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/kedr891/cs-parser/internal/models"
+	"github.com/kedr891/cs-parser/internal/providers"
+)
+
+type skinportItem struct {
+	MarketHashName string   `json:"market_hash_name"`
+	Currency       string   `json:"currency"`
+	SuggestedPrice *float64 `json:"suggested_price"`
+	MinPrice       *float64 `json:"min_price"`
+	MedianPrice    *float64 `json:"median_price"`
+	Quantity       int      `json:"quantity"`
+}
+
+// FetchPrice берет предмет из кэшированного списка; минимальная цена лота
+// предпочтительнее, рекомендованная цена используется, если лотов нет.
+func (p *SkinportProvider) FetchPrice(ctx context.Context, marketHashName string) (*models.MarketPrice, error) {
+	items, err := p.loadItems(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	item, ok := items[marketHashName]
+	if !ok {
+		return nil, fmt.Errorf("skinport: %w", providers.ErrPriceNotFound)
+	}
+
+	var price float64
+	switch {
+	case item.MinPrice != nil && *item.MinPrice > 0:
+		price = *item.MinPrice
+	case item.SuggestedPrice != nil && *item.SuggestedPrice > 0:
+		price = *item.SuggestedPrice
+	default:
+		return nil, fmt.Errorf("skinport: %w", providers.ErrPriceNotFound)
+	}
+
+	currency := item.Currency
+	if currency == "" {
+		currency = p.currency
+	}
+
+	priceUSD, err := p.converter.ToUSD(price, currency)
+	if err != nil {
+		return nil, fmt.Errorf("skinport: %w", err)
+	}
+
+	return &models.MarketPrice{
+		MarketHashName: marketHashName,
+		Source:         models.SourceSkinport,
+		Price:          priceUSD,
+		Currency:       "USD",
+		Volume:         item.Quantity,
+		FetchedAt:      time.Now(),
+	}, nil
+}
+
+func (p *SkinportProvider) loadItems(ctx context.Context) (map[string]skinportItem, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.items != nil && time.Since(p.fetchedAt) < p.cacheTTL {
+		return p.items, nil
+	}
+
+	if err := p.limiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+
+	query := url.Values{}
+	query.Set("app_id", _appID)
+	query.Set("currency", p.currency)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.baseURL+"/v1/items?"+query.Encode(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("build request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("request items: %w", err)
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusTooManyRequests:
+		return nil, fmt.Errorf("skinport: %w", providers.ErrRateLimited)
+	default:
+		return nil, fmt.Errorf("skinport: unexpected status %d", resp.StatusCode)
+	}
+
+	var list []skinportItem
+	if err := json.NewDecoder(resp.Body).Decode(&list); err != nil {
+		return nil, fmt.Errorf("decode items: %w", err)
+	}
+
+	items := make(map[string]skinportItem, len(list))
+	for _, item := range list {
+		items[item.MarketHashName] = item
+	}
+
+	p.items = items
+	p.fetchedAt = time.Now()
+
+	return items, nil
+}
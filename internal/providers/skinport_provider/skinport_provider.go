@@ -0,0 +1,66 @@
+package skinportprovider
+
+import (
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"github.com/kedr891/cs-parser/internal/models"
+	"github.com/kedr891/cs-parser/internal/providers"
+)
+
+const (
+	_defaultBaseURL   = "https://api.skinport.com"
+	_defaultRateLimit = 1
+	_defaultTimeout   = 30 * time.Second
+	_defaultCacheTTL  = 5 * time.Minute
+	_appID            = "730"
+)
+
+// SkinportProvider отдает цены из одного bulk-запроса /v1/items: лимит Skinport
+// слишком мал для запросов по каждому предмету, поэтому список кэшируется на cacheTTL.
+type SkinportProvider struct {
+	baseURL    string
+	currency   string
+	httpClient *http.Client
+	limiter    *rate.Limiter
+	converter  *providers.CurrencyConverter
+	cacheTTL   time.Duration
+
+	mu        sync.Mutex
+	items     map[string]skinportItem
+	fetchedAt time.Time
+}
+
+func NewSkinportProvider(
+	baseURL string,
+	rateLimitPerMinute int,
+	currency string,
+	converter *providers.CurrencyConverter,
+) *SkinportProvider {
+	if baseURL == "" {
+		baseURL = _defaultBaseURL
+	}
+	if rateLimitPerMinute <= 0 {
+		rateLimitPerMinute = _defaultRateLimit
+	}
+	if currency == "" {
+		currency = "EUR"
+	}
+
+	return &SkinportProvider{
+		baseURL:    strings.TrimRight(baseURL, "/"),
+		currency:   strings.ToUpper(currency),
+		httpClient: &http.Client{Timeout: _defaultTimeout},
+		limiter:    providers.NewLimiter(rateLimitPerMinute),
+		converter:  converter,
+		cacheTTL:   _defaultCacheTTL,
+	}
+}
+
+func (p *SkinportProvider) Source() models.PriceSource {
+	return models.SourceSkinport
+}
@@ -0,0 +1,103 @@
+package skinportprovider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/kedr891/cs-parser/internal/models"
+	"github.com/kedr891/cs-parser/internal/providers"
+	"github.com/stretchr/testify/suite"
+)
+
+type SkinportProviderSuite struct {
+	suite.Suite
+	ctx      context.Context
+	status   int
+	requests int
+	query    map[string]string
+	server   *httptest.Server
+	provider *SkinportProvider
+}
+
+func (suite *SkinportProviderSuite) SetupTest() {
+	suite.ctx = context.Background()
+	suite.status = http.StatusOK
+	suite.requests = 0
+	suite.query = map[string]string{}
+	suite.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		suite.Equal("/v1/items", r.URL.Path)
+		suite.requests++
+		for key := range r.URL.Query() {
+			suite.query[key] = r.URL.Query().Get(key)
+		}
+
+		w.WriteHeader(suite.status)
+		if suite.status == http.StatusOK {
+			data, err := os.ReadFile("testdata/items.json")
+			suite.Require().NoError(err)
+			_, _ = w.Write(data)
+		}
+	}))
+	converter := providers.NewCurrencyConverter(map[string]float64{"EUR": 1.1})
+	suite.provider = NewSkinportProvider(suite.server.URL, 6000, "EUR", converter)
+}
+
+func (suite *SkinportProviderSuite) TearDownTest() {
+	suite.server.Close()
+}
+
+func TestSkinportProviderSuite(t *testing.T) {
+	suite.Run(t, new(SkinportProviderSuite))
+}
+
+func (suite *SkinportProviderSuite) TestFetchPrice_UsesMinPriceInUSD() {
+	price, err := suite.provider.FetchPrice(suite.ctx, "AK-47 | Redline (Field-Tested)")
+
+	suite.Require().NoError(err)
+	suite.Equal(models.SourceSkinport, price.Source)
+	suite.InDelta(12.65, price.Price, 0.001)
+	suite.Equal("USD", price.Currency)
+	suite.Equal(217, price.Volume)
+	suite.Equal("730", suite.query["app_id"])
+	suite.Equal("EUR", suite.query["currency"])
+}
+
+func (suite *SkinportProviderSuite) TestFetchPrice_FallsBackToSuggestedPrice() {
+	price, err := suite.provider.FetchPrice(suite.ctx, "AWP | Asiimov (Field-Tested)")
+
+	suite.Require().NoError(err)
+	suite.InDelta(101.64, price.Price, 0.001)
+	suite.Equal(0, price.Volume)
+}
+
+func (suite *SkinportProviderSuite) TestFetchPrice_CachesItemList() {
+	_, err := suite.provider.FetchPrice(suite.ctx, "AK-47 | Redline (Field-Tested)")
+	suite.Require().NoError(err)
+
+	_, err = suite.provider.FetchPrice(suite.ctx, "AWP | Asiimov (Field-Tested)")
+	suite.Require().NoError(err)
+
+	suite.Equal(1, suite.requests)
+}
+
+func (suite *SkinportProviderSuite) TestFetchPrice_NotFound() {
+	price, err := suite.provider.FetchPrice(suite.ctx, "Sticker | Crown (Foil)")
+	suite.ErrorIs(err, providers.ErrPriceNotFound)
+	suite.Nil(price)
+
+	price, err = suite.provider.FetchPrice(suite.ctx, "Unknown Item")
+	suite.ErrorIs(err, providers.ErrPriceNotFound)
+	suite.Nil(price)
+}
+
+func (suite *SkinportProviderSuite) TestFetchPrice_RateLimited() {
+	suite.status = http.StatusTooManyRequests
+
+	price, err := suite.provider.FetchPrice(suite.ctx, "AK-47 | Redline (Field-Tested)")
+
+	suite.ErrorIs(err, providers.ErrRateLimited)
+	suite.Nil(price)
+}
@@ -0,0 +1,96 @@
+package steammarketprovider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kedr891/cs-parser/internal/models"
+	"github.com/kedr891/cs-parser/internal/providers"
+)
+
+type priceOverviewResponse struct {
+	Success     bool   `json:"success"`
+	LowestPrice string `json:"lowest_price"`
+	MedianPrice string `json:"median_price"`
+	Volume      string `json:"volume"`
+}
+
+// FetchPrice запрашивает /market/priceoverview для одного предмета.
+// Медианная цена предпочтительнее минимальной, так как меньше подвержена выбросам.
+func (p *SteamMarketProvider) FetchPrice(ctx context.Context, marketHashName string) (*models.MarketPrice, error) {
+	if err := p.limiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+
+	query := url.Values{}
+	query.Set("appid", _appID)
+	query.Set("currency", steamCurrencyCodes[p.currency])
+	query.Set("market_hash_name", marketHashName)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.baseURL+"/market/priceoverview/?"+query.Encode(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("build request: %w", err)
+	}
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("request price overview: %w", err)
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusTooManyRequests:
+		return nil, fmt.Errorf("steam market: %w", providers.ErrRateLimited)
+	case http.StatusNotFound:
+		return nil, fmt.Errorf("steam market: %w", providers.ErrPriceNotFound)
+	default:
+		return nil, fmt.Errorf("steam market: unexpected status %d", resp.StatusCode)
+	}
+
+	var body priceOverviewResponse
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return nil, fmt.Errorf("decode price overview: %w", err)
+	}
+
+	return p.parsePriceOverview(marketHashName, &body)
+}
+
+func (p *SteamMarketProvider) parsePriceOverview(marketHashName string, body *priceOverviewResponse) (*models.MarketPrice, error) {
+	if !body.Success {
+		return nil, fmt.Errorf("steam market: %w", providers.ErrPriceNotFound)
+	}
+
+	price, err := providers.ParseAmount(body.MedianPrice)
+	if err != nil || price == 0 {
+		price, err = providers.ParseAmount(body.LowestPrice)
+		if err != nil {
+			return nil, fmt.Errorf("steam market: %w", err)
+		}
+	}
+	if price == 0 {
+		return nil, fmt.Errorf("steam market: %w", providers.ErrPriceNotFound)
+	}
+
+	priceUSD, err := p.converter.ToUSD(price, p.currency)
+	if err != nil {
+		return nil, fmt.Errorf("steam market: %w", err)
+	}
+
+	volume, _ := strconv.Atoi(strings.ReplaceAll(strings.TrimSpace(body.Volume), ",", ""))
+
+	return &models.MarketPrice{
+		MarketHashName: marketHashName,
+		Source:         models.SourceSteamMarket,
+		Price:          priceUSD,
+		Currency:       "USD",
+		Volume:         volume,
+		FetchedAt:      time.Now(),
+	}, nil
+}
@@ -0,0 +1,123 @@
+package steammarketprovider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/kedr891/cs-parser/internal/models"
+	"github.com/kedr891/cs-parser/internal/providers"
+)
+
+const _imageBaseURL = "https://community.cloudflare.steamstatic.com/economy/image/"
+
+// Редкости в порядке проверки префикса поля type ("Classified Rifle", "Base Grade Container").
+var steamRarities = []string{
+	"Consumer Grade", "Industrial Grade", "Mil-Spec Grade", "Restricted", "Classified",
+	"Covert", "Contraband", "Base Grade", "High Grade", "Remarkable", "Exotic", "Extraordinary",
+}
+
+type searchRenderResponse struct {
+	Success    bool           `json:"success"`
+	TotalCount int            `json:"total_count"`
+	Results    []searchResult `json:"results"`
+}
+
+type searchResult struct {
+	HashName         string `json:"hash_name"`
+	SellListings     int    `json:"sell_listings"`
+	SellPrice        int    `json:"sell_price"`
+	AssetDescription struct {
+		IconURL string `json:"icon_url"`
+		Type    string `json:"type"`
+	} `json:"asset_description"`
+}
+
+// SearchListings возвращает страницу поисковой выдачи /market/search/render по популярности
+// и общее количество предметов. Цена sell_price приходит в минимальных единицах валюты.
+func (p *SteamMarketProvider) SearchListings(ctx context.Context, start, count int) ([]models.MarketListing, int, error) {
+	if err := p.limiter.Wait(ctx); err != nil {
+		return nil, 0, err
+	}
+
+	query := url.Values{}
+	query.Set("appid", _appID)
+	query.Set("norender", "1")
+	query.Set("search_descriptions", "0")
+	query.Set("sort_column", "popular")
+	query.Set("sort_dir", "desc")
+	query.Set("start", strconv.Itoa(start))
+	query.Set("count", strconv.Itoa(count))
+	query.Set("currency", steamCurrencyCodes[p.currency])
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.baseURL+"/market/search/render/?"+query.Encode(), nil)
+	if err != nil {
+		return nil, 0, fmt.Errorf("build request: %w", err)
+	}
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return nil, 0, fmt.Errorf("request market search: %w", err)
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusTooManyRequests:
+		return nil, 0, fmt.Errorf("steam market: %w", providers.ErrRateLimited)
+	default:
+		return nil, 0, fmt.Errorf("steam market: unexpected status %d", resp.StatusCode)
+	}
+
+	var body searchRenderResponse
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return nil, 0, fmt.Errorf("decode market search: %w", err)
+	}
+	if !body.Success {
+		return nil, 0, fmt.Errorf("steam market: search request failed")
+	}
+
+	listings := make([]models.MarketListing, 0, len(body.Results))
+	for _, result := range body.Results {
+		if result.HashName == "" {
+			continue
+		}
+
+		price, err := p.converter.ToUSD(float64(result.SellPrice)/100, p.currency)
+		if err != nil {
+			return nil, 0, fmt.Errorf("steam market: %w", err)
+		}
+
+		listing := models.MarketListing{
+			MarketHashName: result.HashName,
+			Rarity:         parseRarity(result.AssetDescription.Type),
+			Price:          price,
+			Currency:       "USD",
+			Listings:       result.SellListings,
+		}
+		if result.AssetDescription.IconURL != "" {
+			listing.ImageURL = _imageBaseURL + result.AssetDescription.IconURL
+		}
+
+		listings = append(listings, listing)
+	}
+
+	return listings, body.TotalCount, nil
+}
+
+func parseRarity(itemType string) string {
+	itemType = strings.TrimPrefix(itemType, "★ ")
+	itemType = strings.TrimPrefix(itemType, "StatTrak™ ")
+	itemType = strings.TrimPrefix(itemType, "Souvenir ")
+
+	for _, rarity := range steamRarities {
+		if strings.HasPrefix(itemType, rarity) {
+			return rarity
+		}
+	}
+	return ""
+}
@@ -0,0 +1,66 @@
+package steammarketprovider
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"github.com/kedr891/cs-parser/internal/models"
+	"github.com/kedr891/cs-parser/internal/providers"
+)
+
+const (
+	_defaultBaseURL   = "https://steamcommunity.com"
+	_defaultRateLimit = 20
+	_defaultTimeout   = 10 * time.Second
+	_appID            = "730"
+)
+
+// Коды валют Steam (параметр currency в /market/priceoverview).
+var steamCurrencyCodes = map[string]string{
+	"USD": "1",
+	"GBP": "2",
+	"EUR": "3",
+	"RUB": "5",
+	"CNY": "23",
+}
+
+type SteamMarketProvider struct {
+	baseURL    string
+	currency   string
+	httpClient *http.Client
+	limiter    *rate.Limiter
+	converter  *providers.CurrencyConverter
+}
+
+func NewSteamMarketProvider(
+	baseURL string,
+	rateLimitPerMinute int,
+	currency string,
+	converter *providers.CurrencyConverter,
+) *SteamMarketProvider {
+	if baseURL == "" {
+		baseURL = _defaultBaseURL
+	}
+	if rateLimitPerMinute <= 0 {
+		rateLimitPerMinute = _defaultRateLimit
+	}
+	currency = strings.ToUpper(currency)
+	if _, ok := steamCurrencyCodes[currency]; !ok {
+		currency = "USD"
+	}
+
+	return &SteamMarketProvider{
+		baseURL:    strings.TrimRight(baseURL, "/"),
+		currency:   currency,
+		httpClient: &http.Client{Timeout: _defaultTimeout},
+		limiter:    providers.NewLimiter(rateLimitPerMinute),
+		converter:  converter,
+	}
+}
+
+func (p *SteamMarketProvider) Source() models.PriceSource {
+	return models.SourceSteamMarket
+}
@@ -0,0 +1,137 @@
+package steammarketprovider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/kedr891/cs-parser/internal/models"
+	"github.com/kedr891/cs-parser/internal/providers"
+	"github.com/stretchr/testify/suite"
+)
+
+type SteamMarketProviderSuite struct {
+	suite.Suite
+	ctx     context.Context
+	fixture string
+	status  int
+	path    string
+	query   map[string]string
+	server  *httptest.Server
+}
+
+func (suite *SteamMarketProviderSuite) SetupTest() {
+	suite.ctx = context.Background()
+	suite.status = http.StatusOK
+	suite.query = map[string]string{}
+	suite.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		suite.path = r.URL.Path
+		for key := range r.URL.Query() {
+			suite.query[key] = r.URL.Query().Get(key)
+		}
+
+		w.WriteHeader(suite.status)
+		if suite.fixture != "" {
+			data, err := os.ReadFile("testdata/" + suite.fixture)
+			suite.Require().NoError(err)
+			_, _ = w.Write(data)
+		}
+	}))
+}
+
+func (suite *SteamMarketProviderSuite) TearDownTest() {
+	suite.server.Close()
+}
+
+func TestSteamMarketProviderSuite(t *testing.T) {
+	suite.Run(t, new(SteamMarketProviderSuite))
+}
+
+func (suite *SteamMarketProviderSuite) provider() *SteamMarketProvider {
+	return NewSteamMarketProvider(suite.server.URL, 6000, "USD", providers.NewCurrencyConverter(nil))
+}
+
+func (suite *SteamMarketProviderSuite) TestFetchPrice_UsesMedianPrice() {
+	suite.fixture = "price_overview.json"
+
+	price, err := suite.provider().FetchPrice(suite.ctx, "AK-47 | Redline (Field-Tested)")
+
+	suite.Require().NoError(err)
+	suite.Equal(models.SourceSteamMarket, price.Source)
+	suite.Equal("AK-47 | Redline (Field-Tested)", price.MarketHashName)
+	suite.InDelta(1199.90, price.Price, 0.001)
+	suite.Equal(4133, price.Volume)
+	suite.Equal("USD", price.Currency)
+	suite.Equal("/market/priceoverview/", suite.path)
+	suite.Equal("730", suite.query["appid"])
+	suite.Equal("1", suite.query["currency"])
+	suite.Equal("AK-47 | Redline (Field-Tested)", suite.query["market_hash_name"])
+}
+
+func (suite *SteamMarketProviderSuite) TestFetchPrice_FallsBackToLowestPrice() {
+	suite.fixture = "price_overview_no_median.json"
+
+	price, err := suite.provider().FetchPrice(suite.ctx, "P250 | Sand Dune (Field-Tested)")
+
+	suite.Require().NoError(err)
+	suite.InDelta(0.41, price.Price, 0.001)
+	suite.Equal(12, price.Volume)
+}
+
+func (suite *SteamMarketProviderSuite) TestFetchPrice_NotFound() {
+	suite.fixture = "price_overview_not_found.json"
+
+	price, err := suite.provider().FetchPrice(suite.ctx, "Unknown Item")
+
+	suite.ErrorIs(err, providers.ErrPriceNotFound)
+	suite.Nil(price)
+}
+
+func (suite *SteamMarketProviderSuite) TestFetchPrice_RateLimited() {
+	suite.status = http.StatusTooManyRequests
+
+	price, err := suite.provider().FetchPrice(suite.ctx, "AK-47 | Redline (Field-Tested)")
+
+	suite.ErrorIs(err, providers.ErrRateLimited)
+	suite.Nil(price)
+}
+
+func (suite *SteamMarketProviderSuite) TestFetchPrice_ConvertsToUSD() {
+	suite.fixture = "price_overview_eur.json"
+	converter := providers.NewCurrencyConverter(map[string]float64{"EUR": 1.1})
+
+	price, err := NewSteamMarketProvider(suite.server.URL, 6000, "EUR", converter).
+		FetchPrice(suite.ctx, "AWP | Asiimov (Field-Tested)")
+
+	suite.Require().NoError(err)
+	suite.Equal("3", suite.query["currency"])
+	suite.InDelta(99.0, price.Price, 0.001)
+	suite.Equal("USD", price.Currency)
+	suite.Equal(1504, price.Volume)
+}
+
+func (suite *SteamMarketProviderSuite) TestSearchListings() {
+	suite.fixture = "search_render.json"
+
+	listings, total, err := suite.provider().SearchListings(suite.ctx, 100, 3)
+
+	suite.Require().NoError(err)
+	suite.Equal("/market/search/render/", suite.path)
+	suite.Equal("1", suite.query["norender"])
+	suite.Equal("100", suite.query["start"])
+	suite.Equal("3", suite.query["count"])
+	suite.Equal(24531, total)
+	suite.Require().Len(listings, 3)
+
+	suite.Equal("Kilowatt Case", listings[0].MarketHashName)
+	suite.Equal("Base Grade", listings[0].Rarity)
+	suite.InDelta(1.12, listings[0].Price, 0.001)
+	suite.Equal(118402, listings[0].Listings)
+
+	suite.Equal("StatTrak™ AK-47 | Redline (Field-Tested)", listings[1].MarketHashName)
+	suite.Equal("Classified", listings[1].Rarity)
+	suite.InDelta(31.45, listings[1].Price, 0.001)
+	suite.Contains(listings[1].ImageURL, "/economy/image/")
+}
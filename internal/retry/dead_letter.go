@@ -0,0 +1,36 @@
+package retry
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/segmentio/kafka-go"
+)
+
+type DeadLetterPublisher interface {
+	PublishDeadLetter(ctx context.Context, msg kafka.Message, cause error, attempts int) error
+}
+
+// SendToDeadLetter повторяет запись в DLQ до успеха или остановки контекста,
+// иначе коммит оффсета потеряет сообщение. attempts - число сделанных попыток обработки.
+func (p Policy) SendToDeadLetter(ctx context.Context, publisher DeadLetterPublisher, msg kafka.Message, cause error, attempts int) error {
+	for attempt := 1; ; attempt++ {
+		err := publisher.PublishDeadLetter(ctx, msg, cause, attempts)
+		if err == nil {
+			return nil
+		}
+
+		delay := p.Backoff(attempt)
+		slog.Error("Failed to publish dead letter",
+			"error", err,
+			"topic", msg.Topic,
+			"partition", msg.Partition,
+			"offset", msg.Offset,
+			"backoff", delay,
+		)
+
+		if err := Wait(ctx, delay); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package retry
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ErrPermanent помечает ошибку, которую бесполезно повторять.
+var ErrPermanent = errors.New("permanent failure")
+
+// Policy задает число попыток и экспоненциальную задержку между ними.
+type Policy struct {
+	MaxAttempts    int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+}
+
+// WithDefaults возвращает политику хотя бы с одной попыткой и ненулевой начальной задержкой.
+func (p Policy) WithDefaults(initialBackoff time.Duration) Policy {
+	if p.MaxAttempts <= 0 {
+		p.MaxAttempts = 1
+	}
+	if p.InitialBackoff <= 0 {
+		p.InitialBackoff = initialBackoff
+	}
+	return p
+}
+
+// Backoff возвращает задержку после попытки attempt (с единицы), не больше MaxBackoff.
+func (p Policy) Backoff(attempt int) time.Duration {
+	delay := p.InitialBackoff
+	for i := 1; i < attempt; i++ {
+		delay *= 2
+		if p.MaxBackoff > 0 && delay >= p.MaxBackoff {
+			return p.MaxBackoff
+		}
+	}
+	return delay
+}
+
+// Do вызывает fn, пока она не завершится успешно или с ErrPermanent, не кончатся попытки
+// или не будет отменен ctx. Перед каждой задержкой вызывается onRetry, если он задан.
+// Возвращает номер последней попытки и ее ошибку; при отмене ctx во время задержки - ctx.Err().
+func (p Policy) Do(ctx context.Context, fn func(attempt int) error, onRetry func(attempt int, delay time.Duration, err error)) (int, error) {
+	var err error
+
+	for attempt := 1; attempt <= p.MaxAttempts; attempt++ {
+		if err = fn(attempt); err == nil || errors.Is(err, ErrPermanent) || attempt == p.MaxAttempts {
+			return attempt, err
+		}
+
+		delay := p.Backoff(attempt)
+		if onRetry != nil {
+			onRetry(attempt, delay, err)
+		}
+
+		if err := Wait(ctx, delay); err != nil {
+			return attempt, err
+		}
+	}
+
+	return p.MaxAttempts, err
+}
+
+// Wait ждет delay или отмены ctx.
+func Wait(ctx context.Context, delay time.Duration) error {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
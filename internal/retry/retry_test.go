@@ -0,0 +1,60 @@
+package retry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPolicy_BackoffIsCapped(t *testing.T) {
+	policy := Policy{MaxAttempts: 5, InitialBackoff: 100 * time.Millisecond, MaxBackoff: 300 * time.Millisecond}
+
+	assert.Equal(t, 100*time.Millisecond, policy.Backoff(1))
+	assert.Equal(t, 200*time.Millisecond, policy.Backoff(2))
+	assert.Equal(t, 300*time.Millisecond, policy.Backoff(3))
+	assert.Equal(t, 300*time.Millisecond, policy.Backoff(4))
+}
+
+func TestPolicy_DoRetriesUntilSuccess(t *testing.T) {
+	policy := Policy{MaxAttempts: 3, InitialBackoff: time.Millisecond}
+	var retries []int
+
+	attempts, err := policy.Do(context.Background(), func(attempt int) error {
+		if attempt < 3 {
+			return errors.New("connection refused")
+		}
+		return nil
+	}, func(attempt int, _ time.Duration, _ error) {
+		retries = append(retries, attempt)
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 3, attempts)
+	assert.Equal(t, []int{1, 2}, retries)
+}
+
+func TestPolicy_DoStopsOnPermanentError(t *testing.T) {
+	policy := Policy{MaxAttempts: 3, InitialBackoff: time.Millisecond}
+
+	attempts, err := policy.Do(context.Background(), func(int) error {
+		return fmt.Errorf("%w: status 410", ErrPermanent)
+	}, nil)
+
+	assert.ErrorIs(t, err, ErrPermanent)
+	assert.Equal(t, 1, attempts)
+}
+
+func TestPolicy_DoStopsOnContextCancel(t *testing.T) {
+	policy := Policy{MaxAttempts: 3, InitialBackoff: time.Hour}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	attempts, err := policy.Do(ctx, func(int) error { return errors.New("timeout") }, nil)
+
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, 1, attempts)
+}
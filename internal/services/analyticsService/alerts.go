@@ -0,0 +1,127 @@
+package analyticsservice
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kedr891/cs-parser/internal/models"
+)
+
+// CreateAlert создает правило для цены скина на площадке source (по умолчанию Steam Market).
+func (s *Service) CreateAlert(ctx context.Context, slug, condition string, target float64, source string) (*models.PriceAlert, error) {
+	if source == "" {
+		source = string(models.SourceSteamMarket)
+	}
+	alert := &models.PriceAlert{
+		ID:          uuid.New(),
+		Slug:        slug,
+		Condition:   condition,
+		Source:      source,
+		TargetPrice: target,
+		CreatedAt:   time.Now().UTC(),
+	}
+	if slug == "" {
+		return nil, fmt.Errorf("%w: slug is required", models.ErrInvalidAlert)
+	}
+	if err := alert.Validate(); err != nil {
+		return nil, err
+	}
+
+	skin, err := s.storage.GetSkinBySlug(ctx, slug)
+	if err != nil {
+		return nil, fmt.Errorf("get skin by slug: %w", err)
+	}
+
+	prices, _, err := s.storage.GetLatestSourcePrices(ctx, skin, models.DefaultComparisonMaxAge)
+	if err != nil {
+		return nil, fmt.Errorf("get latest source prices: %w", err)
+	}
+	price, ok := prices[source]
+	if !ok {
+		// Свежей цены площадки нет - отсчитываем от текущей цены скина.
+		price = skin.CurrentPrice
+	}
+
+	alert.SkinID = skin.ID
+	alert.Weapon = skin.Weapon
+	alert.BasePrice = price
+	alert.CurrentPrice = price
+	// Правило, условие которого уже выполнено, срабатывает только после следующего пересечения порога.
+	alert.Triggered = alert.ShouldTrigger()
+
+	if err := s.storage.CreatePriceAlert(ctx, alert); err != nil {
+		return nil, fmt.Errorf("create price alert: %w", err)
+	}
+
+	s.log.Info("Price alert created",
+		"alert_id", alert.ID,
+		"slug", slug,
+		"condition", condition,
+		"source", source,
+		"target", target,
+	)
+
+	return alert, nil
+}
+
+func (s *Service) ListAlerts(ctx context.Context, slug string) ([]models.PriceAlert, error) {
+	alerts, err := s.storage.ListPriceAlerts(ctx, slug)
+	if err != nil {
+		return nil, fmt.Errorf("list price alerts: %w", err)
+	}
+	return alerts, nil
+}
+
+func (s *Service) DeleteAlert(ctx context.Context, id uuid.UUID) error {
+	if err := s.storage.DeletePriceAlert(ctx, id); err != nil {
+		return fmt.Errorf("delete price alert: %w", err)
+	}
+	return nil
+}
+
+// evaluateAlerts проверяет правила скина на площадке события по новой цене. Правило срабатывает
+// один раз при выполнении условия и снова становится активным, когда условие перестает выполняться.
+func (s *Service) evaluateAlerts(ctx context.Context, event *models.PriceUpdateEvent) error {
+	alerts, err := s.storage.GetSkinPriceAlerts(ctx, event.SkinID, event.Source)
+	if err != nil {
+		return fmt.Errorf("get skin price alerts: %w", err)
+	}
+
+	for i := range alerts {
+		alert := &alerts[i]
+		alert.CurrentPrice = event.NewPrice
+
+		hit := alert.ShouldTrigger()
+		if hit == alert.Triggered {
+			continue
+		}
+
+		changed, err := s.storage.SetPriceAlertTriggered(ctx, alert, hit)
+		if err != nil {
+			return fmt.Errorf("set price alert triggered: %w", err)
+		}
+		if !changed || !hit {
+			continue
+		}
+
+		if err := s.alertPublisher.PublishPriceAlert(ctx, models.NewPriceAlertEvent(alert, event)); err != nil {
+			// Возвращаем правило в исходное состояние, чтобы оно сработало при повторной обработке события.
+			if _, resetErr := s.storage.SetPriceAlertTriggered(ctx, alert, false); resetErr != nil {
+				s.log.Error("Failed to reset price alert", "alert_id", alert.ID, "error", resetErr)
+			}
+			return fmt.Errorf("publish price alert: %w", err)
+		}
+
+		s.log.Info("Price alert triggered",
+			"alert_id", alert.ID,
+			"slug", alert.Slug,
+			"condition", alert.Condition,
+			"target", alert.TargetPrice,
+			"price", event.NewPrice,
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,244 @@
+package analyticsservice
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kedr891/cs-parser/internal/models"
+	"github.com/stretchr/testify/mock"
+)
+
+func (suite *AnalyticsServiceSuite) TestCreateAlert_UsesSkinShardAndBasePrice() {
+	skin := &models.Skin{ID: uuid.New(), Slug: "awp_asiimov_ft", Weapon: "AWP", CurrentPrice: 100}
+
+	suite.mockStorage.On("GetSkinBySlug", suite.ctx, "awp_asiimov_ft").
+		Return(skin, nil)
+
+	suite.mockStorage.On("GetLatestSourcePrices", suite.ctx, skin, models.DefaultComparisonMaxAge).
+		Return(map[string]float64{}, time.Time{}, nil)
+
+	suite.mockStorage.On("CreatePriceAlert", suite.ctx, mock.MatchedBy(func(a *models.PriceAlert) bool {
+		return a.SkinID == skin.ID && a.Weapon == "AWP" && a.BasePrice == 100 && !a.Triggered &&
+			a.Source == string(models.SourceSteamMarket)
+	})).Return(nil)
+
+	alert, err := suite.service.CreateAlert(suite.ctx, "awp_asiimov_ft", models.AlertConditionAbove, 120, "")
+
+	suite.NoError(err)
+	suite.Equal(skin.ID, alert.SkinID)
+}
+
+func (suite *AnalyticsServiceSuite) TestCreateAlert_UsesSourcePriceAsBase() {
+	skin := &models.Skin{ID: uuid.New(), Slug: "awp_asiimov_ft", Weapon: "AWP", CurrentPrice: 100}
+
+	suite.mockStorage.On("GetSkinBySlug", suite.ctx, "awp_asiimov_ft").
+		Return(skin, nil)
+
+	suite.mockStorage.On("GetLatestSourcePrices", suite.ctx, skin, models.DefaultComparisonMaxAge).
+		Return(map[string]float64{"steam_market": 100, "buff_market": 90}, time.Now(), nil)
+
+	suite.mockStorage.On("CreatePriceAlert", suite.ctx, mock.MatchedBy(func(a *models.PriceAlert) bool {
+		return a.Source == "buff_market" && a.BasePrice == 90 && a.Triggered
+	})).Return(nil)
+
+	_, err := suite.service.CreateAlert(suite.ctx, "awp_asiimov_ft", models.AlertConditionBelow, 95, "buff_market")
+
+	suite.NoError(err)
+}
+
+func (suite *AnalyticsServiceSuite) TestCreateAlert_UnknownSource() {
+	_, err := suite.service.CreateAlert(suite.ctx, "awp_asiimov_ft", models.AlertConditionAbove, 120, "ebay")
+
+	suite.ErrorIs(err, models.ErrInvalidAlert)
+	suite.mockStorage.AssertNotCalled(suite.T(), "GetSkinBySlug", suite.ctx, "awp_asiimov_ft")
+}
+
+func (suite *AnalyticsServiceSuite) TestCreateAlert_AlreadySatisfiedConditionIsArmedAsTriggered() {
+	skin := &models.Skin{ID: uuid.New(), Slug: "awp_asiimov_ft", Weapon: "AWP", CurrentPrice: 100}
+
+	suite.mockStorage.On("GetSkinBySlug", suite.ctx, "awp_asiimov_ft").
+		Return(skin, nil)
+
+	suite.mockStorage.On("GetLatestSourcePrices", suite.ctx, skin, models.DefaultComparisonMaxAge).
+		Return(map[string]float64{}, time.Time{}, nil)
+
+	suite.mockStorage.On("CreatePriceAlert", suite.ctx, mock.MatchedBy(func(a *models.PriceAlert) bool {
+		return a.Triggered
+	})).Return(nil)
+
+	_, err := suite.service.CreateAlert(suite.ctx, "awp_asiimov_ft", models.AlertConditionBelow, 150, "")
+
+	suite.NoError(err)
+}
+
+func (suite *AnalyticsServiceSuite) TestCreateAlert_InvalidCondition() {
+	_, err := suite.service.CreateAlert(suite.ctx, "awp_asiimov_ft", "sideways", 10, "")
+
+	suite.ErrorIs(err, models.ErrInvalidAlert)
+	suite.mockStorage.AssertNotCalled(suite.T(), "GetSkinBySlug", suite.ctx, "awp_asiimov_ft")
+}
+
+func (suite *AnalyticsServiceSuite) TestCreateAlert_NonPositiveTarget() {
+	_, err := suite.service.CreateAlert(suite.ctx, "awp_asiimov_ft", models.AlertConditionAbove, 0, "")
+
+	suite.ErrorIs(err, models.ErrInvalidAlert)
+}
+
+func (suite *AnalyticsServiceSuite) TestProcessPriceUpdate_FiresCrossedAlertOnce() {
+	event := models.NewPriceUpdateEvent(uuid.New(), "awp_asiimov_ft", "AWP | Asiimov (Field-Tested)", "steam_market", 100, 101, 25)
+	alert := models.PriceAlert{ID: uuid.New(), SkinID: event.SkinID, Slug: "awp_asiimov_ft", Condition: models.AlertConditionAbove, TargetPrice: 100}
+
+	suite.mockStorage.On("RecordPriceUpdate", suite.ctx, event).
+		Return(true, nil)
+
+	suite.mockStorage.On("GetSkinPriceAlerts", suite.ctx, event.SkinID, event.Source).
+		Return([]models.PriceAlert{alert}, nil)
+
+	suite.mockStorage.On("SetPriceAlertTriggered", suite.ctx, mock.AnythingOfType("*models.PriceAlert"), true).
+		Return(true, nil)
+
+	suite.mockPublisher.On("PublishPriceAlert", suite.ctx, mock.MatchedBy(func(e *models.PriceAlertEvent) bool {
+		return e.AlertID == alert.ID && e.Price == 101 && e.Source == "steam_market"
+	})).Return(nil)
+
+	suite.mockPriceAnalytics.On("UpdateTrending", suite.ctx, event).
+		Return(nil)
+
+	err := suite.service.ProcessPriceUpdate(suite.ctx, event)
+
+	suite.NoError(err)
+}
+
+func (suite *AnalyticsServiceSuite) TestProcessPriceUpdate_DoesNotRefireTriggeredAlert() {
+	event := models.NewPriceUpdateEvent(uuid.New(), "awp_asiimov_ft", "AWP | Asiimov (Field-Tested)", "steam_market", 101, 102, 25)
+	alert := models.PriceAlert{ID: uuid.New(), SkinID: event.SkinID, Condition: models.AlertConditionAbove, TargetPrice: 100, Triggered: true}
+
+	suite.mockStorage.On("RecordPriceUpdate", suite.ctx, event).
+		Return(true, nil)
+
+	suite.mockStorage.On("GetSkinPriceAlerts", suite.ctx, event.SkinID, event.Source).
+		Return([]models.PriceAlert{alert}, nil)
+
+	suite.mockPriceAnalytics.On("UpdateTrending", suite.ctx, event).
+		Return(nil)
+
+	err := suite.service.ProcessPriceUpdate(suite.ctx, event)
+
+	suite.NoError(err)
+	suite.mockPublisher.AssertNotCalled(suite.T(), "PublishPriceAlert", mock.Anything, mock.Anything)
+}
+
+func (suite *AnalyticsServiceSuite) TestProcessPriceUpdate_RearmsAlertWhenConditionClears() {
+	event := models.NewPriceUpdateEvent(uuid.New(), "awp_asiimov_ft", "AWP | Asiimov (Field-Tested)", "steam_market", 101, 99, 25)
+	alert := models.PriceAlert{ID: uuid.New(), SkinID: event.SkinID, Condition: models.AlertConditionAbove, TargetPrice: 100, Triggered: true}
+
+	suite.mockStorage.On("RecordPriceUpdate", suite.ctx, event).
+		Return(true, nil)
+
+	suite.mockStorage.On("GetSkinPriceAlerts", suite.ctx, event.SkinID, event.Source).
+		Return([]models.PriceAlert{alert}, nil)
+
+	suite.mockStorage.On("SetPriceAlertTriggered", suite.ctx, mock.AnythingOfType("*models.PriceAlert"), false).
+		Return(true, nil)
+
+	suite.mockPriceAnalytics.On("UpdateTrending", suite.ctx, event).
+		Return(nil)
+
+	err := suite.service.ProcessPriceUpdate(suite.ctx, event)
+
+	suite.NoError(err)
+	suite.mockPublisher.AssertNotCalled(suite.T(), "PublishPriceAlert", mock.Anything, mock.Anything)
+}
+
+func (suite *AnalyticsServiceSuite) TestProcessPriceUpdate_PercentChangeAlert() {
+	event := models.NewPriceUpdateEvent(uuid.New(), "awp_asiimov_ft", "AWP | Asiimov (Field-Tested)", "steam_market", 100, 89, 25)
+	alert := models.PriceAlert{ID: uuid.New(), SkinID: event.SkinID, Condition: models.AlertConditionChangePercent, TargetPrice: 10, BasePrice: 100}
+
+	suite.mockStorage.On("RecordPriceUpdate", suite.ctx, event).
+		Return(true, nil)
+
+	suite.mockStorage.On("GetSkinPriceAlerts", suite.ctx, event.SkinID, event.Source).
+		Return([]models.PriceAlert{alert}, nil)
+
+	suite.mockStorage.On("SetPriceAlertTriggered", suite.ctx, mock.AnythingOfType("*models.PriceAlert"), true).
+		Return(true, nil)
+
+	suite.mockPublisher.On("PublishPriceAlert", suite.ctx, mock.AnythingOfType("*models.PriceAlertEvent")).
+		Return(nil)
+
+	suite.mockPriceAnalytics.On("UpdateTrending", suite.ctx, event).
+		Return(nil)
+
+	suite.mockPriceAnalytics.On("InvalidateMarketOverview", suite.ctx).
+		Return(nil)
+
+	err := suite.service.ProcessPriceUpdate(suite.ctx, event)
+
+	suite.NoError(err)
+}
+
+func (suite *AnalyticsServiceSuite) TestProcessPriceUpdate_ResetsAlertWhenPublishFails() {
+	event := models.NewPriceUpdateEvent(uuid.New(), "awp_asiimov_ft", "AWP | Asiimov (Field-Tested)", "steam_market", 100, 95, 25)
+	alert := models.PriceAlert{ID: uuid.New(), SkinID: event.SkinID, Condition: models.AlertConditionBelow, TargetPrice: 96}
+
+	suite.mockStorage.On("RecordPriceUpdate", suite.ctx, event).
+		Return(true, nil)
+
+	suite.mockStorage.On("GetSkinPriceAlerts", suite.ctx, event.SkinID, event.Source).
+		Return([]models.PriceAlert{alert}, nil)
+
+	suite.mockStorage.On("SetPriceAlertTriggered", suite.ctx, mock.AnythingOfType("*models.PriceAlert"), true).
+		Return(true, nil).Once()
+
+	suite.mockPublisher.On("PublishPriceAlert", suite.ctx, mock.AnythingOfType("*models.PriceAlertEvent")).
+		Return(errors.New("broker not available"))
+
+	suite.mockStorage.On("SetPriceAlertTriggered", suite.ctx, mock.AnythingOfType("*models.PriceAlert"), false).
+		Return(true, nil).Once()
+
+	suite.mockStorage.On("ForgetPriceUpdate", suite.ctx, event).
+		Return(nil)
+
+	err := suite.service.ProcessPriceUpdate(suite.ctx, event)
+
+	suite.Error(err)
+	suite.Contains(err.Error(), "publish price alert")
+	suite.mockStorage.AssertCalled(suite.T(), "ForgetPriceUpdate", suite.ctx, event)
+	suite.mockPriceAnalytics.AssertNotCalled(suite.T(), "UpdateTrending", suite.ctx, event)
+}
+
+func (suite *AnalyticsServiceSuite) TestProcessPriceUpdate_SkipsAlertsForDuplicateEvent() {
+	event := models.NewPriceUpdateEvent(uuid.New(), "awp_asiimov_ft", "AWP | Asiimov (Field-Tested)", "steam_market", 100, 101, 25)
+
+	suite.mockStorage.On("RecordPriceUpdate", suite.ctx, event).
+		Return(false, nil)
+
+	err := suite.service.ProcessPriceUpdate(suite.ctx, event)
+
+	suite.NoError(err)
+	suite.mockStorage.AssertNotCalled(suite.T(), "GetSkinPriceAlerts", mock.Anything, mock.Anything, mock.Anything)
+	suite.mockPublisher.AssertNotCalled(suite.T(), "PublishPriceAlert", mock.Anything, mock.Anything)
+}
+
+func (suite *AnalyticsServiceSuite) TestProcessPriceUpdate_EvaluatesOnlyAlertsOfEventSource() {
+	event := models.NewPriceUpdateEvent(uuid.New(), "awp_asiimov_ft", "AWP | Asiimov (Field-Tested)", "buff_market", 101, 95, 25)
+
+	suite.mockStorage.On("RecordPriceUpdate", suite.ctx, event).
+		Return(true, nil)
+
+	// правило steam_market "above 100" не видит тик buff_market и не переармируется
+	suite.mockStorage.On("GetSkinPriceAlerts", suite.ctx, event.SkinID, "buff_market").
+		Return(nil, nil)
+
+	suite.mockPriceAnalytics.On("UpdateTrending", suite.ctx, event).
+		Return(nil)
+
+	suite.mockPriceAnalytics.On("InvalidateMarketOverview", suite.ctx).
+		Return(nil)
+
+	err := suite.service.ProcessPriceUpdate(suite.ctx, event)
+
+	suite.NoError(err)
+	suite.mockStorage.AssertNotCalled(suite.T(), "SetPriceAlertTriggered", mock.Anything, mock.Anything, mock.Anything)
+}
@@ -12,47 +12,84 @@ import (
 
 type AnalyticsStorage interface {
 	GetTrendingSkins(ctx context.Context, period string, limit int) ([]models.Skin, error)
-	GetTotalSkinsCount(ctx context.Context) (int, error)
-	GetAveragePrice(ctx context.Context) (float64, error)
-	GetTotalVolume24h(ctx context.Context) (int, error)
+	GetMarketStats(ctx context.Context) ([]models.MarketStatsRow, error)
 	GetTopGainers(ctx context.Context, limit int) ([]models.Skin, error)
 	GetTopLosers(ctx context.Context, limit int) ([]models.Skin, error)
 	GetMostPopularSkins(ctx context.Context, limit int) ([]models.Skin, error)
 	GetRecentlyUpdatedSkins(ctx context.Context, limit int) ([]models.Skin, error)
 	GetPriceStatsByPeriod(ctx context.Context, skinID uuid.UUID, period models.PriceStatsPeriod) (*models.SkinStatistics, error)
+	RecordPriceUpdate(ctx context.Context, event *models.PriceUpdateEvent) (bool, error)
+	ForgetPriceUpdate(ctx context.Context, event *models.PriceUpdateEvent) error
+	RecomputePriceStats(ctx context.Context) (int, error)
+	GetSkinBySlug(ctx context.Context, slug string) (*models.Skin, error)
+	CreatePriceAlert(ctx context.Context, alert *models.PriceAlert) error
+	ListPriceAlerts(ctx context.Context, slug string) ([]models.PriceAlert, error)
+	GetSkinPriceAlerts(ctx context.Context, skinID uuid.UUID, source string) ([]models.PriceAlert, error)
+	DeletePriceAlert(ctx context.Context, id uuid.UUID) error
+	SetPriceAlertTriggered(ctx context.Context, alert *models.PriceAlert, triggered bool) (bool, error)
+	GetLatestSourcePrices(ctx context.Context, skin *models.Skin, maxAge time.Duration) (map[string]float64, time.Time, error)
+	GetPriceSpreads(ctx context.Context, maxAge time.Duration, minPrice float64, limit int) ([]models.PriceComparison, error)
+}
+
+type AlertPublisher interface {
+	PublishPriceAlert(ctx context.Context, event *models.PriceAlertEvent) error
 }
 
 type PriceAnalytics interface {
 	UpdateTrending(ctx context.Context, event *models.PriceUpdateEvent) error
+	GetMarketOverview(ctx context.Context) (*models.MarketOverview, bool)
+	SetMarketOverview(ctx context.Context, overview *models.MarketOverview, ttl time.Duration) error
 	InvalidateMarketOverview(ctx context.Context) error
 }
 
+type SearchAnalytics interface {
+	GetPopularSearches(ctx context.Context, window models.SearchWindow, limit int, now time.Time) (*models.PopularSearches, error)
+}
+
+// AutocompleteWeights - индекс подсказок, вес которых зависит от объема продаж за сутки.
+type AutocompleteWeights interface {
+	UpdateVolume(ctx context.Context, slug string, volume24h int) error
+}
+
 type CacheStorage interface {
 	Set(ctx context.Context, key string, value string, ttl time.Duration) error
 	Delete(ctx context.Context, key string) error
 }
 
 type Service struct {
-	storage        AnalyticsStorage
-	cache          CacheStorage
-	priceAnalytics PriceAnalytics
-	log            *slog.Logger
+	storage         AnalyticsStorage
+	cache           CacheStorage
+	priceAnalytics  PriceAnalytics
+	searchAnalytics SearchAnalytics
+	alertPublisher  AlertPublisher
+	autocomplete    AutocompleteWeights
+	log             *slog.Logger
 }
 
 func New(
 	storage AnalyticsStorage,
 	cache CacheStorage,
 	priceAnalytics PriceAnalytics,
+	searchAnalytics SearchAnalytics,
+	alertPublisher AlertPublisher,
 	log *slog.Logger,
 ) *Service {
 	return &Service{
-		storage:        storage,
-		cache:          cache,
-		priceAnalytics: priceAnalytics,
-		log:            log,
+		storage:         storage,
+		cache:           cache,
+		priceAnalytics:  priceAnalytics,
+		searchAnalytics: searchAnalytics,
+		alertPublisher:  alertPublisher,
+		log:             log,
 	}
 }
 
+// WithAutocomplete включает пересчет весов подсказок по объему из обновлений цен.
+func (s *Service) WithAutocomplete(autocomplete AutocompleteWeights) *Service {
+	s.autocomplete = autocomplete
+	return s
+}
+
 func (s *Service) GetTrending(ctx context.Context, period string, limit int) ([]models.Skin, error) {
 	skins, err := s.storage.GetTrendingSkins(ctx, period, limit)
 	if err != nil {
@@ -61,35 +98,14 @@ func (s *Service) GetTrending(ctx context.Context, period string, limit int) ([]
 	return skins, nil
 }
 
-func (s *Service) GetMarketOverview(ctx context.Context) (*models.MarketOverview, error) {
-	totalSkins, err := s.storage.GetTotalSkinsCount(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("get total skins count: %w", err)
-	}
-
-	avgPrice, err := s.storage.GetAveragePrice(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("get average price: %w", err)
-	}
-
-	totalVolume, err := s.storage.GetTotalVolume24h(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("get total volume: %w", err)
-	}
-
-	return &models.MarketOverview{
-		TotalSkins:     totalSkins,
-		AvgPrice:       avgPrice,
-		TotalVolume24h: totalVolume,
-	}, nil
-}
-
-func (s *Service) GetPopularSearches(ctx context.Context, limit int) ([]models.Skin, error) {
-	skins, err := s.storage.GetMostPopularSkins(ctx, limit)
+// GetPopularSearches возвращает самые частые запросы поиска за скользящее окно
+// и отдельно самые частые запросы, не нашедшие ни одного скина.
+func (s *Service) GetPopularSearches(ctx context.Context, window models.SearchWindow, limit int) (*models.PopularSearches, error) {
+	searches, err := s.searchAnalytics.GetPopularSearches(ctx, window, limit, time.Now())
 	if err != nil {
-		return nil, fmt.Errorf("get popular skins: %w", err)
+		return nil, fmt.Errorf("get popular searches: %w", err)
 	}
-	return skins, nil
+	return searches, nil
 }
 
 func (s *Service) GetTopGainers(ctx context.Context, limit int) ([]models.Skin, error) {
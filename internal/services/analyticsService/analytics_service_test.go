@@ -0,0 +1,170 @@
+package analyticsservice
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/kedr891/cs-parser/internal/models"
+	"github.com/kedr891/cs-parser/internal/services/analyticsService/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type AnalyticsServiceSuite struct {
+	suite.Suite
+	ctx                context.Context
+	service            *Service
+	mockStorage        *mocks.MockAnalyticsStorage
+	mockCache          *mocks.MockCacheStorage
+	mockPriceAnalytics *mocks.MockPriceAnalytics
+	mockSearch         *mocks.MockSearchAnalytics
+	mockPublisher      *mocks.MockAlertPublisher
+}
+
+func (suite *AnalyticsServiceSuite) SetupTest() {
+	suite.ctx = context.Background()
+	suite.mockStorage = mocks.NewMockAnalyticsStorage(suite.T())
+	suite.mockCache = mocks.NewMockCacheStorage(suite.T())
+	suite.mockPriceAnalytics = mocks.NewMockPriceAnalytics(suite.T())
+	suite.mockSearch = mocks.NewMockSearchAnalytics(suite.T())
+	suite.mockPublisher = mocks.NewMockAlertPublisher(suite.T())
+	log := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelError}))
+	suite.service = New(suite.mockStorage, suite.mockCache, suite.mockPriceAnalytics, suite.mockSearch, suite.mockPublisher, log)
+}
+
+func TestAnalyticsServiceSuite(t *testing.T) {
+	suite.Run(t, new(AnalyticsServiceSuite))
+}
+
+func (suite *AnalyticsServiceSuite) TestProcessPriceUpdate_PersistsEvent() {
+	event := models.NewPriceUpdateEvent(uuid.New(), "awp_asiimov_ft", "AWP | Asiimov (Field-Tested)", "steam_market", 100, 110, 25)
+
+	suite.mockStorage.On("RecordPriceUpdate", suite.ctx, event).
+		Return(true, nil)
+
+	suite.mockStorage.On("GetSkinPriceAlerts", suite.ctx, event.SkinID, event.Source).
+		Return(nil, nil)
+
+	suite.mockPriceAnalytics.On("UpdateTrending", suite.ctx, event).
+		Return(nil)
+
+	suite.mockPriceAnalytics.On("InvalidateMarketOverview", suite.ctx).
+		Return(nil)
+
+	err := suite.service.ProcessPriceUpdate(suite.ctx, event)
+
+	suite.NoError(err)
+}
+
+func (suite *AnalyticsServiceSuite) TestProcessPriceUpdate_SkipsOverviewInvalidationForSmallChange() {
+	event := models.NewPriceUpdateEvent(uuid.New(), "awp_asiimov_ft", "AWP | Asiimov (Field-Tested)", "skinport", 100, 101, 25)
+
+	suite.mockStorage.On("RecordPriceUpdate", suite.ctx, event).
+		Return(true, nil)
+
+	suite.mockStorage.On("GetSkinPriceAlerts", suite.ctx, event.SkinID, event.Source).
+		Return(nil, nil)
+
+	suite.mockPriceAnalytics.On("UpdateTrending", suite.ctx, event).
+		Return(nil)
+
+	err := suite.service.ProcessPriceUpdate(suite.ctx, event)
+
+	suite.NoError(err)
+	suite.mockPriceAnalytics.AssertNotCalled(suite.T(), "InvalidateMarketOverview", suite.ctx)
+}
+
+func (suite *AnalyticsServiceSuite) TestProcessPriceUpdate_UpdatesAutocompleteWeight() {
+	autocomplete := mocks.NewMockAutocompleteWeights(suite.T())
+	suite.service.WithAutocomplete(autocomplete)
+	event := models.NewPriceUpdateEvent(uuid.New(), "awp_asiimov_ft", "AWP | Asiimov (Field-Tested)", "steam_market", 100, 101, 25)
+
+	suite.mockStorage.On("RecordPriceUpdate", suite.ctx, event).
+		Return(true, nil)
+
+	suite.mockStorage.On("GetSkinPriceAlerts", suite.ctx, event.SkinID, event.Source).
+		Return(nil, nil)
+
+	// ошибка индекса не должна ронять обработку цены
+	autocomplete.On("UpdateVolume", suite.ctx, "awp_asiimov_ft", 25).
+		Return(errors.New("redis down"))
+
+	suite.mockPriceAnalytics.On("UpdateTrending", suite.ctx, event).
+		Return(nil)
+
+	err := suite.service.ProcessPriceUpdate(suite.ctx, event)
+
+	suite.NoError(err)
+	autocomplete.AssertExpectations(suite.T())
+}
+
+func (suite *AnalyticsServiceSuite) TestProcessPriceUpdate_StorageError() {
+	event := models.NewPriceUpdateEvent(uuid.New(), "awp_asiimov_ft", "AWP | Asiimov (Field-Tested)", "steam_market", 100, 110, 25)
+
+	suite.mockStorage.On("RecordPriceUpdate", suite.ctx, event).
+		Return(false, errors.New("skin not found"))
+
+	err := suite.service.ProcessPriceUpdate(suite.ctx, event)
+
+	suite.Error(err)
+	suite.Contains(err.Error(), "record price update")
+	suite.mockPriceAnalytics.AssertNotCalled(suite.T(), "UpdateTrending", suite.ctx, event)
+}
+
+func (suite *AnalyticsServiceSuite) TestRecomputePriceStats_InvalidatesMarketOverview() {
+	suite.mockStorage.On("RecomputePriceStats", suite.ctx).
+		Return(42, nil)
+
+	suite.mockPriceAnalytics.On("InvalidateMarketOverview", suite.ctx).
+		Return(nil)
+
+	updated, err := suite.service.RecomputePriceStats(suite.ctx)
+
+	suite.NoError(err)
+	suite.Equal(42, updated)
+}
+
+func (suite *AnalyticsServiceSuite) TestRecomputePriceStats_StorageError() {
+	suite.mockStorage.On("RecomputePriceStats", suite.ctx).
+		Return(0, errors.New("shard unavailable"))
+
+	_, err := suite.service.RecomputePriceStats(suite.ctx)
+
+	suite.Error(err)
+	suite.Contains(err.Error(), "recompute price stats")
+	suite.mockPriceAnalytics.AssertNotCalled(suite.T(), "InvalidateMarketOverview", suite.ctx)
+}
+
+func (suite *AnalyticsServiceSuite) TestGetPopularSearches_Success() {
+	expected := &models.PopularSearches{
+		Window: models.SearchWindowHour,
+		Searches: []models.PopularSearch{
+			{Query: "ak 47 redline", Count: 12},
+			{Query: "awp dragon lore", Count: 7, ZeroResults: 7},
+		},
+		ZeroResultSearches: []models.PopularSearch{
+			{Query: "awp dragon lore", Count: 7, ZeroResults: 7},
+		},
+	}
+
+	suite.mockSearch.On("GetPopularSearches", suite.ctx, models.SearchWindowHour, 10, mock.AnythingOfType("time.Time")).
+		Return(expected, nil)
+
+	result, err := suite.service.GetPopularSearches(suite.ctx, models.SearchWindowHour, 10)
+
+	suite.NoError(err)
+	suite.Equal(expected, result)
+}
+
+func (suite *AnalyticsServiceSuite) TestGetPopularSearches_CacheError() {
+	suite.mockSearch.On("GetPopularSearches", suite.ctx, models.SearchWindowDay, 10, mock.Anything).
+		Return(nil, errors.New("redis down"))
+
+	_, err := suite.service.GetPopularSearches(suite.ctx, models.SearchWindowDay, 10)
+
+	suite.Error(err)
+}
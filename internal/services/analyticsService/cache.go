@@ -0,0 +1,165 @@
+package analyticsservice
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/kedr891/cs-parser/internal/models"
+)
+
+const (
+	_trendingKey       = "analytics:trending:24h"
+	_trendingTTL       = 24 * time.Hour
+	_marketOverviewKey = "analytics:market:overview"
+	_searchWindowTTL   = time.Minute
+)
+
+// AnalyticsCache реализует CacheStorage и PriceAnalytics поверх Redis.
+type AnalyticsCache struct {
+	client *redis.Client
+}
+
+func NewAnalyticsCache(client *redis.Client) *AnalyticsCache {
+	return &AnalyticsCache{client: client}
+}
+
+func (c *AnalyticsCache) Set(ctx context.Context, key string, value string, ttl time.Duration) error {
+	if c == nil || c.client == nil {
+		return fmt.Errorf("cache not initialized")
+	}
+	return c.client.Set(ctx, key, value, ttl).Err()
+}
+
+func (c *AnalyticsCache) Delete(ctx context.Context, key string) error {
+	if c == nil || c.client == nil {
+		return fmt.Errorf("cache not initialized")
+	}
+	return c.client.Del(ctx, key).Err()
+}
+
+// UpdateTrending хранит последнее изменение цены скина в sorted set, отсортированном по price_change.
+func (c *AnalyticsCache) UpdateTrending(ctx context.Context, event *models.PriceUpdateEvent) error {
+	if c == nil || c.client == nil {
+		return fmt.Errorf("cache not initialized")
+	}
+
+	pipe := c.client.Pipeline()
+	pipe.ZAdd(ctx, _trendingKey, redis.Z{Score: event.PriceChange, Member: event.SkinID.String()})
+	pipe.Expire(ctx, _trendingKey, _trendingTTL)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+func (c *AnalyticsCache) GetMarketOverview(ctx context.Context) (*models.MarketOverview, bool) {
+	if c == nil || c.client == nil {
+		return nil, false
+	}
+
+	data, err := c.client.Get(ctx, _marketOverviewKey).Bytes()
+	if err != nil {
+		return nil, false
+	}
+
+	var overview models.MarketOverview
+	if err := json.Unmarshal(data, &overview); err != nil {
+		return nil, false
+	}
+	return &overview, true
+}
+
+func (c *AnalyticsCache) SetMarketOverview(ctx context.Context, overview *models.MarketOverview, ttl time.Duration) error {
+	data, err := json.Marshal(overview)
+	if err != nil {
+		return err
+	}
+	return c.Set(ctx, _marketOverviewKey, string(data), ttl)
+}
+
+func (c *AnalyticsCache) InvalidateMarketOverview(ctx context.Context) error {
+	return c.Delete(ctx, _marketOverviewKey)
+}
+
+// GetPopularSearches складывает корзины окна в отдельные ключи (все поиски и поиски без результатов)
+// и берет из них топ. Сумма пересчитывается при каждом запросе, TTL только убирает ключи устаревших окон.
+func (c *AnalyticsCache) GetPopularSearches(ctx context.Context, window models.SearchWindow, limit int, now time.Time) (*models.PopularSearches, error) {
+	if c == nil || c.client == nil {
+		return nil, fmt.Errorf("cache not initialized")
+	}
+
+	buckets := window.Buckets(now)
+	if len(buckets) == 0 {
+		return nil, fmt.Errorf("%w: %q", models.ErrInvalidSearchWindow, window)
+	}
+
+	allKey := models.SearchStatsKey(window, buckets[0], false) + ":window"
+	zeroKey := models.SearchStatsKey(window, buckets[0], true) + ":window"
+
+	pipe := c.client.Pipeline()
+	for _, dest := range []string{allKey, zeroKey} {
+		keys := make([]string, len(buckets))
+		for i, bucket := range buckets {
+			keys[i] = models.SearchStatsKey(window, bucket, dest == zeroKey)
+		}
+		pipe.ZUnionStore(ctx, dest, &redis.ZStore{Keys: keys})
+		pipe.Expire(ctx, dest, _searchWindowTTL)
+	}
+	topAll := pipe.ZRevRangeWithScores(ctx, allKey, 0, int64(limit-1))
+	topZero := pipe.ZRevRangeWithScores(ctx, zeroKey, 0, int64(limit-1))
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, fmt.Errorf("sum search buckets: %w", err)
+	}
+
+	searches := popularSearches(topAll.Val())
+	zeroResultSearches := popularSearches(topZero.Val())
+
+	// для топа всех поисков дочитываем число пустых выдач, для топа пустых - общее число поисков
+	pipe = c.client.Pipeline()
+	var zeroCounts, allCounts *redis.FloatSliceCmd
+	if len(searches) > 0 {
+		zeroCounts = pipe.ZMScore(ctx, zeroKey, searchQueries(searches)...)
+	}
+	if len(zeroResultSearches) > 0 {
+		allCounts = pipe.ZMScore(ctx, allKey, searchQueries(zeroResultSearches)...)
+	}
+	if zeroCounts != nil || allCounts != nil {
+		if _, err := pipe.Exec(ctx); err != nil {
+			return nil, fmt.Errorf("get search counts: %w", err)
+		}
+	}
+
+	for i := range searches {
+		searches[i].ZeroResults = int64(zeroCounts.Val()[i])
+	}
+	for i := range zeroResultSearches {
+		zeroResultSearches[i].ZeroResults = zeroResultSearches[i].Count
+		zeroResultSearches[i].Count = int64(allCounts.Val()[i])
+	}
+
+	return &models.PopularSearches{
+		Window:             window,
+		From:               buckets[len(buckets)-1],
+		Searches:           searches,
+		ZeroResultSearches: zeroResultSearches,
+	}, nil
+}
+
+func popularSearches(members []redis.Z) []models.PopularSearch {
+	searches := make([]models.PopularSearch, 0, len(members))
+	for _, member := range members {
+		query, _ := member.Member.(string)
+		searches = append(searches, models.PopularSearch{Query: query, Count: int64(member.Score)})
+	}
+	return searches
+}
+
+func searchQueries(searches []models.PopularSearch) []string {
+	queries := make([]string, len(searches))
+	for i, search := range searches {
+		queries[i] = search.Query
+	}
+	return queries
+}
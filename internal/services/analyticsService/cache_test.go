@@ -0,0 +1,94 @@
+package analyticsservice
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kedr891/cs-parser/internal/models"
+)
+
+func newTestAnalyticsCache(t *testing.T) (*AnalyticsCache, *redis.Client) {
+	t.Helper()
+	server := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: server.Addr()})
+	t.Cleanup(func() { _ = client.Close() })
+	return NewAnalyticsCache(client), client
+}
+
+func recordSearches(t *testing.T, client *redis.Client, window models.SearchWindow, bucket time.Time, zeroResults bool, counts map[string]float64) {
+	t.Helper()
+	key := models.SearchStatsKey(window, bucket, zeroResults)
+	for query, count := range counts {
+		require.NoError(t, client.ZIncrBy(context.Background(), key, count, query).Err())
+	}
+}
+
+func TestAnalyticsCache_GetPopularSearches_SumsWindowBuckets(t *testing.T) {
+	cache, client := newTestAnalyticsCache(t)
+	now := time.Date(2026, 3, 1, 12, 7, 0, 0, time.UTC)
+	current := time.Date(2026, 3, 1, 12, 5, 0, 0, time.UTC)
+	oldest := current.Add(-time.Hour)
+
+	recordSearches(t, client, models.SearchWindowHour, current, false, map[string]float64{"ak 47": 3, "awp": 1})
+	recordSearches(t, client, models.SearchWindowHour, current, true, map[string]float64{"ak 47": 1, "awp": 1})
+	recordSearches(t, client, models.SearchWindowHour, oldest, false, map[string]float64{"ak 47": 2, "m4a4": 1})
+	recordSearches(t, client, models.SearchWindowHour, oldest, true, map[string]float64{"m4a4": 1})
+	// корзина за пределами окна не учитывается
+	recordSearches(t, client, models.SearchWindowHour, oldest.Add(-5*time.Minute), false, map[string]float64{"awp": 10})
+	recordSearches(t, client, models.SearchWindowHour, oldest.Add(-5*time.Minute), true, map[string]float64{"awp": 10})
+
+	result, err := cache.GetPopularSearches(context.Background(), models.SearchWindowHour, 10, now)
+	require.NoError(t, err)
+
+	assert.Equal(t, models.SearchWindowHour, result.Window)
+	assert.Equal(t, oldest, result.From)
+	// при равном счете ZREVRANGE упорядочивает запросы по убыванию строки
+	assert.Equal(t, []models.PopularSearch{
+		{Query: "ak 47", Count: 5, ZeroResults: 1},
+		{Query: "m4a4", Count: 1, ZeroResults: 1},
+		{Query: "awp", Count: 1, ZeroResults: 1},
+	}, result.Searches)
+	// в топе пустых выдач Count - все поиски запроса, ZeroResults - пустые
+	assert.Equal(t, []models.PopularSearch{
+		{Query: "m4a4", Count: 1, ZeroResults: 1},
+		{Query: "awp", Count: 1, ZeroResults: 1},
+		{Query: "ak 47", Count: 5, ZeroResults: 1},
+	}, result.ZeroResultSearches)
+}
+
+func TestAnalyticsCache_GetPopularSearches_Limit(t *testing.T) {
+	cache, client := newTestAnalyticsCache(t)
+	now := time.Date(2026, 3, 1, 12, 30, 0, 0, time.UTC)
+	bucket := now.Truncate(time.Hour)
+
+	recordSearches(t, client, models.SearchWindowDay, bucket, false, map[string]float64{"ak 47": 3, "awp": 2, "m4a4": 1})
+	recordSearches(t, client, models.SearchWindowDay, bucket, true, map[string]float64{"m4a4": 1})
+
+	result, err := cache.GetPopularSearches(context.Background(), models.SearchWindowDay, 2, now)
+	require.NoError(t, err)
+
+	assert.Equal(t, bucket.Add(-24*time.Hour), result.From)
+	assert.Equal(t, []models.PopularSearch{
+		{Query: "ak 47", Count: 3},
+		{Query: "awp", Count: 2},
+	}, result.Searches)
+	assert.Equal(t, []models.PopularSearch{
+		{Query: "m4a4", Count: 1, ZeroResults: 1},
+	}, result.ZeroResultSearches)
+}
+
+func TestAnalyticsCache_GetPopularSearches_Empty(t *testing.T) {
+	cache, _ := newTestAnalyticsCache(t)
+
+	result, err := cache.GetPopularSearches(context.Background(), models.SearchWindowHour, 10, time.Now())
+	require.NoError(t, err)
+
+	assert.Empty(t, result.Searches)
+	assert.Empty(t, result.ZeroResultSearches)
+}
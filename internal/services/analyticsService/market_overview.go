@@ -0,0 +1,94 @@
+package analyticsservice
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/kedr891/cs-parser/internal/models"
+)
+
+const (
+	_overviewListLimit = 10
+	// значимые изменения цены сбрасывают кэш сразу, TTL ограничивает отставание от мелких изменений
+	_marketOverviewTTL = 5 * time.Minute
+)
+
+func (s *Service) GetMarketOverview(ctx context.Context) (*models.MarketOverview, error) {
+	if overview, ok := s.priceAnalytics.GetMarketOverview(ctx); ok {
+		return overview, nil
+	}
+
+	overview, err := s.buildMarketOverview(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.priceAnalytics.SetMarketOverview(ctx, overview, _marketOverviewTTL); err != nil {
+		s.log.Warn("Failed to cache market overview", "error", err)
+	}
+
+	return overview, nil
+}
+
+// buildMarketOverview выполняет агрегаты и выборки топов параллельно; каждая из них сама обходит шарды.
+func (s *Service) buildMarketOverview(ctx context.Context) (*models.MarketOverview, error) {
+	var (
+		stats                            []models.MarketStatsRow
+		gainers, losers, popular, recent []models.Skin
+	)
+
+	tasks := []struct {
+		name string
+		run  func() error
+	}{
+		{"get market stats", func() (err error) {
+			stats, err = s.storage.GetMarketStats(ctx)
+			return err
+		}},
+		{"get top gainers", func() (err error) {
+			gainers, err = s.storage.GetTopGainers(ctx, _overviewListLimit)
+			return err
+		}},
+		{"get top losers", func() (err error) {
+			losers, err = s.storage.GetTopLosers(ctx, _overviewListLimit)
+			return err
+		}},
+		{"get popular skins", func() (err error) {
+			popular, err = s.storage.GetMostPopularSkins(ctx, _overviewListLimit)
+			return err
+		}},
+		{"get recently updated", func() (err error) {
+			recent, err = s.storage.GetRecentlyUpdatedSkins(ctx, _overviewListLimit)
+			return err
+		}},
+	}
+
+	errs := make([]error, len(tasks))
+	var wg sync.WaitGroup
+	for i, task := range tasks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := task.run(); err != nil {
+				errs[i] = fmt.Errorf("%s: %w", task.name, err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	overview := models.NewMarketOverview(stats, time.Now().UTC())
+	overview.TopGainers = gainers
+	overview.TopLosers = losers
+	overview.MostPopular = popular
+	overview.RecentlyUpdated = recent
+
+	return overview, nil
+}
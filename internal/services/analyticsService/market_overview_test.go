@@ -0,0 +1,96 @@
+package analyticsservice
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/kedr891/cs-parser/internal/models"
+	"github.com/stretchr/testify/mock"
+)
+
+func (suite *AnalyticsServiceSuite) TestGetMarketOverview_ReturnsCached() {
+	cached := &models.MarketOverview{TotalSkins: 42}
+
+	suite.mockPriceAnalytics.On("GetMarketOverview", suite.ctx).
+		Return(cached, true)
+
+	overview, err := suite.service.GetMarketOverview(suite.ctx)
+
+	suite.NoError(err)
+	suite.Same(cached, overview)
+	suite.mockStorage.AssertNotCalled(suite.T(), "GetMarketStats", mock.Anything)
+}
+
+func (suite *AnalyticsServiceSuite) TestGetMarketOverview_BuildsAndCaches() {
+	stats := []models.MarketStatsRow{
+		{Shard: 0, Weapon: "AK-47", Rarity: "Covert", SkinCount: 3, PricedCount: 2, PriceSum: 60, Volume24h: 10},
+		{Shard: 1, Weapon: "AWP", Rarity: "Covert", SkinCount: 2, PricedCount: 2, PriceSum: 200, Volume24h: 5},
+		{Shard: 1, Weapon: "M4A4", Rarity: "", SkinCount: 1, PricedCount: 0, PriceSum: 0, Volume24h: 0},
+	}
+	gainers := []models.Skin{{ID: uuid.New(), PriceChange24h: 12}}
+	losers := []models.Skin{{ID: uuid.New(), PriceChange24h: -7}}
+	popular := []models.Skin{{ID: uuid.New(), Volume24h: 500}}
+	recent := []models.Skin{{ID: uuid.New()}}
+
+	suite.mockPriceAnalytics.On("GetMarketOverview", suite.ctx).
+		Return(nil, false)
+	suite.mockStorage.On("GetMarketStats", suite.ctx).
+		Return(stats, nil)
+	suite.mockStorage.On("GetTopGainers", suite.ctx, _overviewListLimit).
+		Return(gainers, nil)
+	suite.mockStorage.On("GetTopLosers", suite.ctx, _overviewListLimit).
+		Return(losers, nil)
+	suite.mockStorage.On("GetMostPopularSkins", suite.ctx, _overviewListLimit).
+		Return(popular, nil)
+	suite.mockStorage.On("GetRecentlyUpdatedSkins", suite.ctx, _overviewListLimit).
+		Return(recent, nil)
+	suite.mockPriceAnalytics.On("SetMarketOverview", suite.ctx, mock.AnythingOfType("*models.MarketOverview"), _marketOverviewTTL).
+		Return(nil)
+
+	overview, err := suite.service.GetMarketOverview(suite.ctx)
+
+	suite.NoError(err)
+	suite.Equal(6, overview.TotalSkins)
+	// средняя только по скинам с ценой: (60 + 200) / 4
+	suite.Equal(65.0, overview.AvgPrice)
+	suite.Equal(15, overview.TotalVolume24h)
+	suite.Equal(gainers, overview.TopGainers)
+	suite.Equal(losers, overview.TopLosers)
+	suite.Equal(popular, overview.MostPopular)
+	suite.Equal(recent, overview.RecentlyUpdated)
+
+	suite.Equal([]models.MarketSegment{
+		{Name: "Rifle", SkinCount: 4, AvgPrice: 30, TotalVolume24h: 10},
+		{Name: "Sniper", SkinCount: 2, AvgPrice: 100, TotalVolume24h: 5},
+	}, overview.ByCategory)
+	suite.Equal([]models.MarketSegment{
+		{Name: "Covert", SkinCount: 5, AvgPrice: 65, TotalVolume24h: 15},
+		{Name: models.UnknownRarity, SkinCount: 1},
+	}, overview.ByRarity)
+	suite.Equal([]models.MarketSegment{
+		{Name: "shard-0", SkinCount: 3, AvgPrice: 30, TotalVolume24h: 10},
+		{Name: "shard-1", SkinCount: 3, AvgPrice: 100, TotalVolume24h: 5},
+	}, overview.ByShard)
+	suite.False(overview.UpdatedAt.IsZero())
+}
+
+func (suite *AnalyticsServiceSuite) TestGetMarketOverview_StorageErrorIsNotCached() {
+	suite.mockPriceAnalytics.On("GetMarketOverview", suite.ctx).
+		Return(nil, false)
+	suite.mockStorage.On("GetMarketStats", suite.ctx).
+		Return(nil, errors.New("shard down"))
+	suite.mockStorage.On("GetTopGainers", suite.ctx, _overviewListLimit).
+		Return(nil, nil)
+	suite.mockStorage.On("GetTopLosers", suite.ctx, _overviewListLimit).
+		Return(nil, nil)
+	suite.mockStorage.On("GetMostPopularSkins", suite.ctx, _overviewListLimit).
+		Return(nil, nil)
+	suite.mockStorage.On("GetRecentlyUpdatedSkins", suite.ctx, _overviewListLimit).
+		Return(nil, nil)
+
+	overview, err := suite.service.GetMarketOverview(suite.ctx)
+
+	suite.Error(err)
+	suite.Nil(overview)
+	suite.mockPriceAnalytics.AssertNotCalled(suite.T(), "SetMarketOverview", mock.Anything, mock.Anything, mock.Anything)
+}
@@ -4,35 +4,1200 @@ package mocks
 
 import (
 	context "context"
+	time "time"
 
+	uuid "github.com/google/uuid"
 	models "github.com/kedr891/cs-parser/internal/models"
 	mock "github.com/stretchr/testify/mock"
 )
 
+// MockAnalyticsStorage is an autogenerated mock type for the AnalyticsStorage type
+type MockAnalyticsStorage struct {
+	mock.Mock
+}
+
+type MockAnalyticsStorage_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAnalyticsStorage) EXPECT() *MockAnalyticsStorage_Expecter {
+	return &MockAnalyticsStorage_Expecter{mock: &_m.Mock}
+}
+
+// CreatePriceAlert provides a mock function with given fields: ctx, alert
+func (_m *MockAnalyticsStorage) CreatePriceAlert(ctx context.Context, alert *models.PriceAlert) error {
+	ret := _m.Called(ctx, alert)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreatePriceAlert")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.PriceAlert) error); ok {
+		r0 = rf(ctx, alert)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAnalyticsStorage_CreatePriceAlert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreatePriceAlert'
+type MockAnalyticsStorage_CreatePriceAlert_Call struct {
+	*mock.Call
+}
+
+// CreatePriceAlert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - alert *models.PriceAlert
+func (_e *MockAnalyticsStorage_Expecter) CreatePriceAlert(ctx interface{}, alert interface{}) *MockAnalyticsStorage_CreatePriceAlert_Call {
+	return &MockAnalyticsStorage_CreatePriceAlert_Call{Call: _e.mock.On("CreatePriceAlert", ctx, alert)}
+}
+
+func (_c *MockAnalyticsStorage_CreatePriceAlert_Call) Run(run func(ctx context.Context, alert *models.PriceAlert)) *MockAnalyticsStorage_CreatePriceAlert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.PriceAlert))
+	})
+	return _c
+}
+
+func (_c *MockAnalyticsStorage_CreatePriceAlert_Call) Return(_a0 error) *MockAnalyticsStorage_CreatePriceAlert_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAnalyticsStorage_CreatePriceAlert_Call) RunAndReturn(run func(context.Context, *models.PriceAlert) error) *MockAnalyticsStorage_CreatePriceAlert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeletePriceAlert provides a mock function with given fields: ctx, id
+func (_m *MockAnalyticsStorage) DeletePriceAlert(ctx context.Context, id uuid.UUID) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeletePriceAlert")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAnalyticsStorage_DeletePriceAlert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeletePriceAlert'
+type MockAnalyticsStorage_DeletePriceAlert_Call struct {
+	*mock.Call
+}
+
+// DeletePriceAlert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockAnalyticsStorage_Expecter) DeletePriceAlert(ctx interface{}, id interface{}) *MockAnalyticsStorage_DeletePriceAlert_Call {
+	return &MockAnalyticsStorage_DeletePriceAlert_Call{Call: _e.mock.On("DeletePriceAlert", ctx, id)}
+}
+
+func (_c *MockAnalyticsStorage_DeletePriceAlert_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockAnalyticsStorage_DeletePriceAlert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockAnalyticsStorage_DeletePriceAlert_Call) Return(_a0 error) *MockAnalyticsStorage_DeletePriceAlert_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAnalyticsStorage_DeletePriceAlert_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockAnalyticsStorage_DeletePriceAlert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ForgetPriceUpdate provides a mock function with given fields: ctx, event
+func (_m *MockAnalyticsStorage) ForgetPriceUpdate(ctx context.Context, event *models.PriceUpdateEvent) error {
+	ret := _m.Called(ctx, event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ForgetPriceUpdate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.PriceUpdateEvent) error); ok {
+		r0 = rf(ctx, event)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAnalyticsStorage_ForgetPriceUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ForgetPriceUpdate'
+type MockAnalyticsStorage_ForgetPriceUpdate_Call struct {
+	*mock.Call
+}
+
+// ForgetPriceUpdate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - event *models.PriceUpdateEvent
+func (_e *MockAnalyticsStorage_Expecter) ForgetPriceUpdate(ctx interface{}, event interface{}) *MockAnalyticsStorage_ForgetPriceUpdate_Call {
+	return &MockAnalyticsStorage_ForgetPriceUpdate_Call{Call: _e.mock.On("ForgetPriceUpdate", ctx, event)}
+}
+
+func (_c *MockAnalyticsStorage_ForgetPriceUpdate_Call) Run(run func(ctx context.Context, event *models.PriceUpdateEvent)) *MockAnalyticsStorage_ForgetPriceUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.PriceUpdateEvent))
+	})
+	return _c
+}
+
+func (_c *MockAnalyticsStorage_ForgetPriceUpdate_Call) Return(_a0 error) *MockAnalyticsStorage_ForgetPriceUpdate_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAnalyticsStorage_ForgetPriceUpdate_Call) RunAndReturn(run func(context.Context, *models.PriceUpdateEvent) error) *MockAnalyticsStorage_ForgetPriceUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLatestSourcePrices provides a mock function with given fields: ctx, skin, maxAge
+func (_m *MockAnalyticsStorage) GetLatestSourcePrices(ctx context.Context, skin *models.Skin, maxAge time.Duration) (map[string]float64, time.Time, error) {
+	ret := _m.Called(ctx, skin, maxAge)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLatestSourcePrices")
+	}
+
+	var r0 map[string]float64
+	var r1 time.Time
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.Skin, time.Duration) (map[string]float64, time.Time, error)); ok {
+		return rf(ctx, skin, maxAge)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *models.Skin, time.Duration) map[string]float64); ok {
+		r0 = rf(ctx, skin, maxAge)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]float64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *models.Skin, time.Duration) time.Time); ok {
+		r1 = rf(ctx, skin, maxAge)
+	} else {
+		r1 = ret.Get(1).(time.Time)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, *models.Skin, time.Duration) error); ok {
+		r2 = rf(ctx, skin, maxAge)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockAnalyticsStorage_GetLatestSourcePrices_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLatestSourcePrices'
+type MockAnalyticsStorage_GetLatestSourcePrices_Call struct {
+	*mock.Call
+}
+
+// GetLatestSourcePrices is a helper method to define mock.On call
+//   - ctx context.Context
+//   - skin *models.Skin
+//   - maxAge time.Duration
+func (_e *MockAnalyticsStorage_Expecter) GetLatestSourcePrices(ctx interface{}, skin interface{}, maxAge interface{}) *MockAnalyticsStorage_GetLatestSourcePrices_Call {
+	return &MockAnalyticsStorage_GetLatestSourcePrices_Call{Call: _e.mock.On("GetLatestSourcePrices", ctx, skin, maxAge)}
+}
+
+func (_c *MockAnalyticsStorage_GetLatestSourcePrices_Call) Run(run func(ctx context.Context, skin *models.Skin, maxAge time.Duration)) *MockAnalyticsStorage_GetLatestSourcePrices_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.Skin), args[2].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *MockAnalyticsStorage_GetLatestSourcePrices_Call) Return(_a0 map[string]float64, _a1 time.Time, _a2 error) *MockAnalyticsStorage_GetLatestSourcePrices_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockAnalyticsStorage_GetLatestSourcePrices_Call) RunAndReturn(run func(context.Context, *models.Skin, time.Duration) (map[string]float64, time.Time, error)) *MockAnalyticsStorage_GetLatestSourcePrices_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMarketStats provides a mock function with given fields: ctx
+func (_m *MockAnalyticsStorage) GetMarketStats(ctx context.Context) ([]models.MarketStatsRow, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMarketStats")
+	}
+
+	var r0 []models.MarketStatsRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]models.MarketStatsRow, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []models.MarketStatsRow); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.MarketStatsRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAnalyticsStorage_GetMarketStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMarketStats'
+type MockAnalyticsStorage_GetMarketStats_Call struct {
+	*mock.Call
+}
+
+// GetMarketStats is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockAnalyticsStorage_Expecter) GetMarketStats(ctx interface{}) *MockAnalyticsStorage_GetMarketStats_Call {
+	return &MockAnalyticsStorage_GetMarketStats_Call{Call: _e.mock.On("GetMarketStats", ctx)}
+}
+
+func (_c *MockAnalyticsStorage_GetMarketStats_Call) Run(run func(ctx context.Context)) *MockAnalyticsStorage_GetMarketStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockAnalyticsStorage_GetMarketStats_Call) Return(_a0 []models.MarketStatsRow, _a1 error) *MockAnalyticsStorage_GetMarketStats_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAnalyticsStorage_GetMarketStats_Call) RunAndReturn(run func(context.Context) ([]models.MarketStatsRow, error)) *MockAnalyticsStorage_GetMarketStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMostPopularSkins provides a mock function with given fields: ctx, limit
+func (_m *MockAnalyticsStorage) GetMostPopularSkins(ctx context.Context, limit int) ([]models.Skin, error) {
+	ret := _m.Called(ctx, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMostPopularSkins")
+	}
+
+	var r0 []models.Skin
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]models.Skin, error)); ok {
+		return rf(ctx, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []models.Skin); ok {
+		r0 = rf(ctx, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Skin)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAnalyticsStorage_GetMostPopularSkins_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMostPopularSkins'
+type MockAnalyticsStorage_GetMostPopularSkins_Call struct {
+	*mock.Call
+}
+
+// GetMostPopularSkins is a helper method to define mock.On call
+//   - ctx context.Context
+//   - limit int
+func (_e *MockAnalyticsStorage_Expecter) GetMostPopularSkins(ctx interface{}, limit interface{}) *MockAnalyticsStorage_GetMostPopularSkins_Call {
+	return &MockAnalyticsStorage_GetMostPopularSkins_Call{Call: _e.mock.On("GetMostPopularSkins", ctx, limit)}
+}
+
+func (_c *MockAnalyticsStorage_GetMostPopularSkins_Call) Run(run func(ctx context.Context, limit int)) *MockAnalyticsStorage_GetMostPopularSkins_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MockAnalyticsStorage_GetMostPopularSkins_Call) Return(_a0 []models.Skin, _a1 error) *MockAnalyticsStorage_GetMostPopularSkins_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAnalyticsStorage_GetMostPopularSkins_Call) RunAndReturn(run func(context.Context, int) ([]models.Skin, error)) *MockAnalyticsStorage_GetMostPopularSkins_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPriceSpreads provides a mock function with given fields: ctx, maxAge, minPrice, limit
+func (_m *MockAnalyticsStorage) GetPriceSpreads(ctx context.Context, maxAge time.Duration, minPrice float64, limit int) ([]models.PriceComparison, error) {
+	ret := _m.Called(ctx, maxAge, minPrice, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPriceSpreads")
+	}
+
+	var r0 []models.PriceComparison
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Duration, float64, int) ([]models.PriceComparison, error)); ok {
+		return rf(ctx, maxAge, minPrice, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Duration, float64, int) []models.PriceComparison); ok {
+		r0 = rf(ctx, maxAge, minPrice, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.PriceComparison)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Duration, float64, int) error); ok {
+		r1 = rf(ctx, maxAge, minPrice, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAnalyticsStorage_GetPriceSpreads_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPriceSpreads'
+type MockAnalyticsStorage_GetPriceSpreads_Call struct {
+	*mock.Call
+}
+
+// GetPriceSpreads is a helper method to define mock.On call
+//   - ctx context.Context
+//   - maxAge time.Duration
+//   - minPrice float64
+//   - limit int
+func (_e *MockAnalyticsStorage_Expecter) GetPriceSpreads(ctx interface{}, maxAge interface{}, minPrice interface{}, limit interface{}) *MockAnalyticsStorage_GetPriceSpreads_Call {
+	return &MockAnalyticsStorage_GetPriceSpreads_Call{Call: _e.mock.On("GetPriceSpreads", ctx, maxAge, minPrice, limit)}
+}
+
+func (_c *MockAnalyticsStorage_GetPriceSpreads_Call) Run(run func(ctx context.Context, maxAge time.Duration, minPrice float64, limit int)) *MockAnalyticsStorage_GetPriceSpreads_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Duration), args[2].(float64), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *MockAnalyticsStorage_GetPriceSpreads_Call) Return(_a0 []models.PriceComparison, _a1 error) *MockAnalyticsStorage_GetPriceSpreads_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAnalyticsStorage_GetPriceSpreads_Call) RunAndReturn(run func(context.Context, time.Duration, float64, int) ([]models.PriceComparison, error)) *MockAnalyticsStorage_GetPriceSpreads_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPriceStatsByPeriod provides a mock function with given fields: ctx, skinID, period
+func (_m *MockAnalyticsStorage) GetPriceStatsByPeriod(ctx context.Context, skinID uuid.UUID, period models.PriceStatsPeriod) (*models.SkinStatistics, error) {
+	ret := _m.Called(ctx, skinID, period)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPriceStatsByPeriod")
+	}
+
+	var r0 *models.SkinStatistics
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.PriceStatsPeriod) (*models.SkinStatistics, error)); ok {
+		return rf(ctx, skinID, period)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.PriceStatsPeriod) *models.SkinStatistics); ok {
+		r0 = rf(ctx, skinID, period)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.SkinStatistics)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, models.PriceStatsPeriod) error); ok {
+		r1 = rf(ctx, skinID, period)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAnalyticsStorage_GetPriceStatsByPeriod_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPriceStatsByPeriod'
+type MockAnalyticsStorage_GetPriceStatsByPeriod_Call struct {
+	*mock.Call
+}
+
+// GetPriceStatsByPeriod is a helper method to define mock.On call
+//   - ctx context.Context
+//   - skinID uuid.UUID
+//   - period models.PriceStatsPeriod
+func (_e *MockAnalyticsStorage_Expecter) GetPriceStatsByPeriod(ctx interface{}, skinID interface{}, period interface{}) *MockAnalyticsStorage_GetPriceStatsByPeriod_Call {
+	return &MockAnalyticsStorage_GetPriceStatsByPeriod_Call{Call: _e.mock.On("GetPriceStatsByPeriod", ctx, skinID, period)}
+}
+
+func (_c *MockAnalyticsStorage_GetPriceStatsByPeriod_Call) Run(run func(ctx context.Context, skinID uuid.UUID, period models.PriceStatsPeriod)) *MockAnalyticsStorage_GetPriceStatsByPeriod_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.PriceStatsPeriod))
+	})
+	return _c
+}
+
+func (_c *MockAnalyticsStorage_GetPriceStatsByPeriod_Call) Return(_a0 *models.SkinStatistics, _a1 error) *MockAnalyticsStorage_GetPriceStatsByPeriod_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAnalyticsStorage_GetPriceStatsByPeriod_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.PriceStatsPeriod) (*models.SkinStatistics, error)) *MockAnalyticsStorage_GetPriceStatsByPeriod_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRecentlyUpdatedSkins provides a mock function with given fields: ctx, limit
+func (_m *MockAnalyticsStorage) GetRecentlyUpdatedSkins(ctx context.Context, limit int) ([]models.Skin, error) {
+	ret := _m.Called(ctx, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRecentlyUpdatedSkins")
+	}
+
+	var r0 []models.Skin
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]models.Skin, error)); ok {
+		return rf(ctx, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []models.Skin); ok {
+		r0 = rf(ctx, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Skin)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAnalyticsStorage_GetRecentlyUpdatedSkins_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRecentlyUpdatedSkins'
+type MockAnalyticsStorage_GetRecentlyUpdatedSkins_Call struct {
+	*mock.Call
+}
+
+// GetRecentlyUpdatedSkins is a helper method to define mock.On call
+//   - ctx context.Context
+//   - limit int
+func (_e *MockAnalyticsStorage_Expecter) GetRecentlyUpdatedSkins(ctx interface{}, limit interface{}) *MockAnalyticsStorage_GetRecentlyUpdatedSkins_Call {
+	return &MockAnalyticsStorage_GetRecentlyUpdatedSkins_Call{Call: _e.mock.On("GetRecentlyUpdatedSkins", ctx, limit)}
+}
+
+func (_c *MockAnalyticsStorage_GetRecentlyUpdatedSkins_Call) Run(run func(ctx context.Context, limit int)) *MockAnalyticsStorage_GetRecentlyUpdatedSkins_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MockAnalyticsStorage_GetRecentlyUpdatedSkins_Call) Return(_a0 []models.Skin, _a1 error) *MockAnalyticsStorage_GetRecentlyUpdatedSkins_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAnalyticsStorage_GetRecentlyUpdatedSkins_Call) RunAndReturn(run func(context.Context, int) ([]models.Skin, error)) *MockAnalyticsStorage_GetRecentlyUpdatedSkins_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSkinBySlug provides a mock function with given fields: ctx, slug
+func (_m *MockAnalyticsStorage) GetSkinBySlug(ctx context.Context, slug string) (*models.Skin, error) {
+	ret := _m.Called(ctx, slug)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSkinBySlug")
+	}
+
+	var r0 *models.Skin
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*models.Skin, error)); ok {
+		return rf(ctx, slug)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *models.Skin); ok {
+		r0 = rf(ctx, slug)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Skin)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, slug)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAnalyticsStorage_GetSkinBySlug_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSkinBySlug'
+type MockAnalyticsStorage_GetSkinBySlug_Call struct {
+	*mock.Call
+}
+
+// GetSkinBySlug is a helper method to define mock.On call
+//   - ctx context.Context
+//   - slug string
+func (_e *MockAnalyticsStorage_Expecter) GetSkinBySlug(ctx interface{}, slug interface{}) *MockAnalyticsStorage_GetSkinBySlug_Call {
+	return &MockAnalyticsStorage_GetSkinBySlug_Call{Call: _e.mock.On("GetSkinBySlug", ctx, slug)}
+}
+
+func (_c *MockAnalyticsStorage_GetSkinBySlug_Call) Run(run func(ctx context.Context, slug string)) *MockAnalyticsStorage_GetSkinBySlug_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockAnalyticsStorage_GetSkinBySlug_Call) Return(_a0 *models.Skin, _a1 error) *MockAnalyticsStorage_GetSkinBySlug_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAnalyticsStorage_GetSkinBySlug_Call) RunAndReturn(run func(context.Context, string) (*models.Skin, error)) *MockAnalyticsStorage_GetSkinBySlug_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSkinPriceAlerts provides a mock function with given fields: ctx, skinID, source
+func (_m *MockAnalyticsStorage) GetSkinPriceAlerts(ctx context.Context, skinID uuid.UUID, source string) ([]models.PriceAlert, error) {
+	ret := _m.Called(ctx, skinID, source)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSkinPriceAlerts")
+	}
+
+	var r0 []models.PriceAlert
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) ([]models.PriceAlert, error)); ok {
+		return rf(ctx, skinID, source)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) []models.PriceAlert); ok {
+		r0 = rf(ctx, skinID, source)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.PriceAlert)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
+		r1 = rf(ctx, skinID, source)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAnalyticsStorage_GetSkinPriceAlerts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSkinPriceAlerts'
+type MockAnalyticsStorage_GetSkinPriceAlerts_Call struct {
+	*mock.Call
+}
+
+// GetSkinPriceAlerts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - skinID uuid.UUID
+//   - source string
+func (_e *MockAnalyticsStorage_Expecter) GetSkinPriceAlerts(ctx interface{}, skinID interface{}, source interface{}) *MockAnalyticsStorage_GetSkinPriceAlerts_Call {
+	return &MockAnalyticsStorage_GetSkinPriceAlerts_Call{Call: _e.mock.On("GetSkinPriceAlerts", ctx, skinID, source)}
+}
+
+func (_c *MockAnalyticsStorage_GetSkinPriceAlerts_Call) Run(run func(ctx context.Context, skinID uuid.UUID, source string)) *MockAnalyticsStorage_GetSkinPriceAlerts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockAnalyticsStorage_GetSkinPriceAlerts_Call) Return(_a0 []models.PriceAlert, _a1 error) *MockAnalyticsStorage_GetSkinPriceAlerts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAnalyticsStorage_GetSkinPriceAlerts_Call) RunAndReturn(run func(context.Context, uuid.UUID, string) ([]models.PriceAlert, error)) *MockAnalyticsStorage_GetSkinPriceAlerts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTopGainers provides a mock function with given fields: ctx, limit
+func (_m *MockAnalyticsStorage) GetTopGainers(ctx context.Context, limit int) ([]models.Skin, error) {
+	ret := _m.Called(ctx, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTopGainers")
+	}
+
+	var r0 []models.Skin
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]models.Skin, error)); ok {
+		return rf(ctx, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []models.Skin); ok {
+		r0 = rf(ctx, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Skin)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAnalyticsStorage_GetTopGainers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTopGainers'
+type MockAnalyticsStorage_GetTopGainers_Call struct {
+	*mock.Call
+}
+
+// GetTopGainers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - limit int
+func (_e *MockAnalyticsStorage_Expecter) GetTopGainers(ctx interface{}, limit interface{}) *MockAnalyticsStorage_GetTopGainers_Call {
+	return &MockAnalyticsStorage_GetTopGainers_Call{Call: _e.mock.On("GetTopGainers", ctx, limit)}
+}
+
+func (_c *MockAnalyticsStorage_GetTopGainers_Call) Run(run func(ctx context.Context, limit int)) *MockAnalyticsStorage_GetTopGainers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MockAnalyticsStorage_GetTopGainers_Call) Return(_a0 []models.Skin, _a1 error) *MockAnalyticsStorage_GetTopGainers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAnalyticsStorage_GetTopGainers_Call) RunAndReturn(run func(context.Context, int) ([]models.Skin, error)) *MockAnalyticsStorage_GetTopGainers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTopLosers provides a mock function with given fields: ctx, limit
+func (_m *MockAnalyticsStorage) GetTopLosers(ctx context.Context, limit int) ([]models.Skin, error) {
+	ret := _m.Called(ctx, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTopLosers")
+	}
+
+	var r0 []models.Skin
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]models.Skin, error)); ok {
+		return rf(ctx, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []models.Skin); ok {
+		r0 = rf(ctx, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Skin)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAnalyticsStorage_GetTopLosers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTopLosers'
+type MockAnalyticsStorage_GetTopLosers_Call struct {
+	*mock.Call
+}
+
+// GetTopLosers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - limit int
+func (_e *MockAnalyticsStorage_Expecter) GetTopLosers(ctx interface{}, limit interface{}) *MockAnalyticsStorage_GetTopLosers_Call {
+	return &MockAnalyticsStorage_GetTopLosers_Call{Call: _e.mock.On("GetTopLosers", ctx, limit)}
+}
+
+func (_c *MockAnalyticsStorage_GetTopLosers_Call) Run(run func(ctx context.Context, limit int)) *MockAnalyticsStorage_GetTopLosers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MockAnalyticsStorage_GetTopLosers_Call) Return(_a0 []models.Skin, _a1 error) *MockAnalyticsStorage_GetTopLosers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAnalyticsStorage_GetTopLosers_Call) RunAndReturn(run func(context.Context, int) ([]models.Skin, error)) *MockAnalyticsStorage_GetTopLosers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTrendingSkins provides a mock function with given fields: ctx, period, limit
+func (_m *MockAnalyticsStorage) GetTrendingSkins(ctx context.Context, period string, limit int) ([]models.Skin, error) {
+	ret := _m.Called(ctx, period, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTrendingSkins")
+	}
+
+	var r0 []models.Skin
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) ([]models.Skin, error)); ok {
+		return rf(ctx, period, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) []models.Skin); ok {
+		r0 = rf(ctx, period, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Skin)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
+		r1 = rf(ctx, period, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAnalyticsStorage_GetTrendingSkins_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTrendingSkins'
+type MockAnalyticsStorage_GetTrendingSkins_Call struct {
+	*mock.Call
+}
+
+// GetTrendingSkins is a helper method to define mock.On call
+//   - ctx context.Context
+//   - period string
+//   - limit int
+func (_e *MockAnalyticsStorage_Expecter) GetTrendingSkins(ctx interface{}, period interface{}, limit interface{}) *MockAnalyticsStorage_GetTrendingSkins_Call {
+	return &MockAnalyticsStorage_GetTrendingSkins_Call{Call: _e.mock.On("GetTrendingSkins", ctx, period, limit)}
+}
+
+func (_c *MockAnalyticsStorage_GetTrendingSkins_Call) Run(run func(ctx context.Context, period string, limit int)) *MockAnalyticsStorage_GetTrendingSkins_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockAnalyticsStorage_GetTrendingSkins_Call) Return(_a0 []models.Skin, _a1 error) *MockAnalyticsStorage_GetTrendingSkins_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAnalyticsStorage_GetTrendingSkins_Call) RunAndReturn(run func(context.Context, string, int) ([]models.Skin, error)) *MockAnalyticsStorage_GetTrendingSkins_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListPriceAlerts provides a mock function with given fields: ctx, slug
+func (_m *MockAnalyticsStorage) ListPriceAlerts(ctx context.Context, slug string) ([]models.PriceAlert, error) {
+	ret := _m.Called(ctx, slug)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListPriceAlerts")
+	}
+
+	var r0 []models.PriceAlert
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]models.PriceAlert, error)); ok {
+		return rf(ctx, slug)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []models.PriceAlert); ok {
+		r0 = rf(ctx, slug)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.PriceAlert)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, slug)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAnalyticsStorage_ListPriceAlerts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListPriceAlerts'
+type MockAnalyticsStorage_ListPriceAlerts_Call struct {
+	*mock.Call
+}
+
+// ListPriceAlerts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - slug string
+func (_e *MockAnalyticsStorage_Expecter) ListPriceAlerts(ctx interface{}, slug interface{}) *MockAnalyticsStorage_ListPriceAlerts_Call {
+	return &MockAnalyticsStorage_ListPriceAlerts_Call{Call: _e.mock.On("ListPriceAlerts", ctx, slug)}
+}
+
+func (_c *MockAnalyticsStorage_ListPriceAlerts_Call) Run(run func(ctx context.Context, slug string)) *MockAnalyticsStorage_ListPriceAlerts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockAnalyticsStorage_ListPriceAlerts_Call) Return(_a0 []models.PriceAlert, _a1 error) *MockAnalyticsStorage_ListPriceAlerts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAnalyticsStorage_ListPriceAlerts_Call) RunAndReturn(run func(context.Context, string) ([]models.PriceAlert, error)) *MockAnalyticsStorage_ListPriceAlerts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecomputePriceStats provides a mock function with given fields: ctx
+func (_m *MockAnalyticsStorage) RecomputePriceStats(ctx context.Context) (int, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecomputePriceStats")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (int, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) int); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAnalyticsStorage_RecomputePriceStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecomputePriceStats'
+type MockAnalyticsStorage_RecomputePriceStats_Call struct {
+	*mock.Call
+}
+
+// RecomputePriceStats is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockAnalyticsStorage_Expecter) RecomputePriceStats(ctx interface{}) *MockAnalyticsStorage_RecomputePriceStats_Call {
+	return &MockAnalyticsStorage_RecomputePriceStats_Call{Call: _e.mock.On("RecomputePriceStats", ctx)}
+}
+
+func (_c *MockAnalyticsStorage_RecomputePriceStats_Call) Run(run func(ctx context.Context)) *MockAnalyticsStorage_RecomputePriceStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockAnalyticsStorage_RecomputePriceStats_Call) Return(_a0 int, _a1 error) *MockAnalyticsStorage_RecomputePriceStats_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAnalyticsStorage_RecomputePriceStats_Call) RunAndReturn(run func(context.Context) (int, error)) *MockAnalyticsStorage_RecomputePriceStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordPriceUpdate provides a mock function with given fields: ctx, event
+func (_m *MockAnalyticsStorage) RecordPriceUpdate(ctx context.Context, event *models.PriceUpdateEvent) (bool, error) {
+	ret := _m.Called(ctx, event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordPriceUpdate")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.PriceUpdateEvent) (bool, error)); ok {
+		return rf(ctx, event)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *models.PriceUpdateEvent) bool); ok {
+		r0 = rf(ctx, event)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *models.PriceUpdateEvent) error); ok {
+		r1 = rf(ctx, event)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAnalyticsStorage_RecordPriceUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordPriceUpdate'
+type MockAnalyticsStorage_RecordPriceUpdate_Call struct {
+	*mock.Call
+}
+
+// RecordPriceUpdate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - event *models.PriceUpdateEvent
+func (_e *MockAnalyticsStorage_Expecter) RecordPriceUpdate(ctx interface{}, event interface{}) *MockAnalyticsStorage_RecordPriceUpdate_Call {
+	return &MockAnalyticsStorage_RecordPriceUpdate_Call{Call: _e.mock.On("RecordPriceUpdate", ctx, event)}
+}
+
+func (_c *MockAnalyticsStorage_RecordPriceUpdate_Call) Run(run func(ctx context.Context, event *models.PriceUpdateEvent)) *MockAnalyticsStorage_RecordPriceUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.PriceUpdateEvent))
+	})
+	return _c
+}
+
+func (_c *MockAnalyticsStorage_RecordPriceUpdate_Call) Return(_a0 bool, _a1 error) *MockAnalyticsStorage_RecordPriceUpdate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAnalyticsStorage_RecordPriceUpdate_Call) RunAndReturn(run func(context.Context, *models.PriceUpdateEvent) (bool, error)) *MockAnalyticsStorage_RecordPriceUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetPriceAlertTriggered provides a mock function with given fields: ctx, alert, triggered
+func (_m *MockAnalyticsStorage) SetPriceAlertTriggered(ctx context.Context, alert *models.PriceAlert, triggered bool) (bool, error) {
+	ret := _m.Called(ctx, alert, triggered)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetPriceAlertTriggered")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.PriceAlert, bool) (bool, error)); ok {
+		return rf(ctx, alert, triggered)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *models.PriceAlert, bool) bool); ok {
+		r0 = rf(ctx, alert, triggered)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *models.PriceAlert, bool) error); ok {
+		r1 = rf(ctx, alert, triggered)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAnalyticsStorage_SetPriceAlertTriggered_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetPriceAlertTriggered'
+type MockAnalyticsStorage_SetPriceAlertTriggered_Call struct {
+	*mock.Call
+}
+
+// SetPriceAlertTriggered is a helper method to define mock.On call
+//   - ctx context.Context
+//   - alert *models.PriceAlert
+//   - triggered bool
+func (_e *MockAnalyticsStorage_Expecter) SetPriceAlertTriggered(ctx interface{}, alert interface{}, triggered interface{}) *MockAnalyticsStorage_SetPriceAlertTriggered_Call {
+	return &MockAnalyticsStorage_SetPriceAlertTriggered_Call{Call: _e.mock.On("SetPriceAlertTriggered", ctx, alert, triggered)}
+}
+
+func (_c *MockAnalyticsStorage_SetPriceAlertTriggered_Call) Run(run func(ctx context.Context, alert *models.PriceAlert, triggered bool)) *MockAnalyticsStorage_SetPriceAlertTriggered_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.PriceAlert), args[2].(bool))
+	})
+	return _c
+}
+
+func (_c *MockAnalyticsStorage_SetPriceAlertTriggered_Call) Return(_a0 bool, _a1 error) *MockAnalyticsStorage_SetPriceAlertTriggered_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAnalyticsStorage_SetPriceAlertTriggered_Call) RunAndReturn(run func(context.Context, *models.PriceAlert, bool) (bool, error)) *MockAnalyticsStorage_SetPriceAlertTriggered_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockAnalyticsStorage creates a new instance of MockAnalyticsStorage. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAnalyticsStorage(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAnalyticsStorage {
+	mock := &MockAnalyticsStorage{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
 // MockPriceAnalytics is an autogenerated mock type for the PriceAnalytics type
 type MockPriceAnalytics struct {
 	mock.Mock
 }
 
-type MockPriceAnalytics_Expecter struct {
-	mock *mock.Mock
+type MockPriceAnalytics_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPriceAnalytics) EXPECT() *MockPriceAnalytics_Expecter {
+	return &MockPriceAnalytics_Expecter{mock: &_m.Mock}
+}
+
+// GetMarketOverview provides a mock function with given fields: ctx
+func (_m *MockPriceAnalytics) GetMarketOverview(ctx context.Context) (*models.MarketOverview, bool) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMarketOverview")
+	}
+
+	var r0 *models.MarketOverview
+	var r1 bool
+	if rf, ok := ret.Get(0).(func(context.Context) (*models.MarketOverview, bool)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *models.MarketOverview); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.MarketOverview)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) bool); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// MockPriceAnalytics_GetMarketOverview_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMarketOverview'
+type MockPriceAnalytics_GetMarketOverview_Call struct {
+	*mock.Call
+}
+
+// GetMarketOverview is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockPriceAnalytics_Expecter) GetMarketOverview(ctx interface{}) *MockPriceAnalytics_GetMarketOverview_Call {
+	return &MockPriceAnalytics_GetMarketOverview_Call{Call: _e.mock.On("GetMarketOverview", ctx)}
+}
+
+func (_c *MockPriceAnalytics_GetMarketOverview_Call) Run(run func(ctx context.Context)) *MockPriceAnalytics_GetMarketOverview_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockPriceAnalytics_GetMarketOverview_Call) Return(_a0 *models.MarketOverview, _a1 bool) *MockPriceAnalytics_GetMarketOverview_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPriceAnalytics_GetMarketOverview_Call) RunAndReturn(run func(context.Context) (*models.MarketOverview, bool)) *MockPriceAnalytics_GetMarketOverview_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InvalidateMarketOverview provides a mock function with given fields: ctx
+func (_m *MockPriceAnalytics) InvalidateMarketOverview(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InvalidateMarketOverview")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockPriceAnalytics_InvalidateMarketOverview_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InvalidateMarketOverview'
+type MockPriceAnalytics_InvalidateMarketOverview_Call struct {
+	*mock.Call
+}
+
+// InvalidateMarketOverview is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockPriceAnalytics_Expecter) InvalidateMarketOverview(ctx interface{}) *MockPriceAnalytics_InvalidateMarketOverview_Call {
+	return &MockPriceAnalytics_InvalidateMarketOverview_Call{Call: _e.mock.On("InvalidateMarketOverview", ctx)}
+}
+
+func (_c *MockPriceAnalytics_InvalidateMarketOverview_Call) Run(run func(ctx context.Context)) *MockPriceAnalytics_InvalidateMarketOverview_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockPriceAnalytics_InvalidateMarketOverview_Call) Return(_a0 error) *MockPriceAnalytics_InvalidateMarketOverview_Call {
+	_c.Call.Return(_a0)
+	return _c
 }
 
-func (_m *MockPriceAnalytics) EXPECT() *MockPriceAnalytics_Expecter {
-	return &MockPriceAnalytics_Expecter{mock: &_m.Mock}
+func (_c *MockPriceAnalytics_InvalidateMarketOverview_Call) RunAndReturn(run func(context.Context) error) *MockPriceAnalytics_InvalidateMarketOverview_Call {
+	_c.Call.Return(run)
+	return _c
 }
 
-// InvalidateMarketOverview provides a mock function with given fields: ctx
-func (_m *MockPriceAnalytics) InvalidateMarketOverview(ctx context.Context) error {
-	ret := _m.Called(ctx)
+// SetMarketOverview provides a mock function with given fields: ctx, overview, ttl
+func (_m *MockPriceAnalytics) SetMarketOverview(ctx context.Context, overview *models.MarketOverview, ttl time.Duration) error {
+	ret := _m.Called(ctx, overview, ttl)
 
 	if len(ret) == 0 {
-		panic("no return value specified for InvalidateMarketOverview")
+		panic("no return value specified for SetMarketOverview")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
-		r0 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, *models.MarketOverview, time.Duration) error); ok {
+		r0 = rf(ctx, overview, ttl)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -40,30 +1205,32 @@ func (_m *MockPriceAnalytics) InvalidateMarketOverview(ctx context.Context) erro
 	return r0
 }
 
-// MockPriceAnalytics_InvalidateMarketOverview_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InvalidateMarketOverview'
-type MockPriceAnalytics_InvalidateMarketOverview_Call struct {
+// MockPriceAnalytics_SetMarketOverview_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetMarketOverview'
+type MockPriceAnalytics_SetMarketOverview_Call struct {
 	*mock.Call
 }
 
-// InvalidateMarketOverview is a helper method to define mock.On call
+// SetMarketOverview is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *MockPriceAnalytics_Expecter) InvalidateMarketOverview(ctx interface{}) *MockPriceAnalytics_InvalidateMarketOverview_Call {
-	return &MockPriceAnalytics_InvalidateMarketOverview_Call{Call: _e.mock.On("InvalidateMarketOverview", ctx)}
+//   - overview *models.MarketOverview
+//   - ttl time.Duration
+func (_e *MockPriceAnalytics_Expecter) SetMarketOverview(ctx interface{}, overview interface{}, ttl interface{}) *MockPriceAnalytics_SetMarketOverview_Call {
+	return &MockPriceAnalytics_SetMarketOverview_Call{Call: _e.mock.On("SetMarketOverview", ctx, overview, ttl)}
 }
 
-func (_c *MockPriceAnalytics_InvalidateMarketOverview_Call) Run(run func(ctx context.Context)) *MockPriceAnalytics_InvalidateMarketOverview_Call {
+func (_c *MockPriceAnalytics_SetMarketOverview_Call) Run(run func(ctx context.Context, overview *models.MarketOverview, ttl time.Duration)) *MockPriceAnalytics_SetMarketOverview_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context))
+		run(args[0].(context.Context), args[1].(*models.MarketOverview), args[2].(time.Duration))
 	})
 	return _c
 }
 
-func (_c *MockPriceAnalytics_InvalidateMarketOverview_Call) Return(_a0 error) *MockPriceAnalytics_InvalidateMarketOverview_Call {
+func (_c *MockPriceAnalytics_SetMarketOverview_Call) Return(_a0 error) *MockPriceAnalytics_SetMarketOverview_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockPriceAnalytics_InvalidateMarketOverview_Call) RunAndReturn(run func(context.Context) error) *MockPriceAnalytics_InvalidateMarketOverview_Call {
+func (_c *MockPriceAnalytics_SetMarketOverview_Call) RunAndReturn(run func(context.Context, *models.MarketOverview, time.Duration) error) *MockPriceAnalytics_SetMarketOverview_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -128,3 +1295,363 @@ func NewMockPriceAnalytics(t interface {
 
 	return mock
 }
+
+// MockSearchAnalytics is an autogenerated mock type for the SearchAnalytics type
+type MockSearchAnalytics struct {
+	mock.Mock
+}
+
+type MockSearchAnalytics_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSearchAnalytics) EXPECT() *MockSearchAnalytics_Expecter {
+	return &MockSearchAnalytics_Expecter{mock: &_m.Mock}
+}
+
+// GetPopularSearches provides a mock function with given fields: ctx, window, limit, now
+func (_m *MockSearchAnalytics) GetPopularSearches(ctx context.Context, window models.SearchWindow, limit int, now time.Time) (*models.PopularSearches, error) {
+	ret := _m.Called(ctx, window, limit, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPopularSearches")
+	}
+
+	var r0 *models.PopularSearches
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.SearchWindow, int, time.Time) (*models.PopularSearches, error)); ok {
+		return rf(ctx, window, limit, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.SearchWindow, int, time.Time) *models.PopularSearches); ok {
+		r0 = rf(ctx, window, limit, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.PopularSearches)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.SearchWindow, int, time.Time) error); ok {
+		r1 = rf(ctx, window, limit, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSearchAnalytics_GetPopularSearches_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPopularSearches'
+type MockSearchAnalytics_GetPopularSearches_Call struct {
+	*mock.Call
+}
+
+// GetPopularSearches is a helper method to define mock.On call
+//   - ctx context.Context
+//   - window models.SearchWindow
+//   - limit int
+//   - now time.Time
+func (_e *MockSearchAnalytics_Expecter) GetPopularSearches(ctx interface{}, window interface{}, limit interface{}, now interface{}) *MockSearchAnalytics_GetPopularSearches_Call {
+	return &MockSearchAnalytics_GetPopularSearches_Call{Call: _e.mock.On("GetPopularSearches", ctx, window, limit, now)}
+}
+
+func (_c *MockSearchAnalytics_GetPopularSearches_Call) Run(run func(ctx context.Context, window models.SearchWindow, limit int, now time.Time)) *MockSearchAnalytics_GetPopularSearches_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.SearchWindow), args[2].(int), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockSearchAnalytics_GetPopularSearches_Call) Return(_a0 *models.PopularSearches, _a1 error) *MockSearchAnalytics_GetPopularSearches_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSearchAnalytics_GetPopularSearches_Call) RunAndReturn(run func(context.Context, models.SearchWindow, int, time.Time) (*models.PopularSearches, error)) *MockSearchAnalytics_GetPopularSearches_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSearchAnalytics creates a new instance of MockSearchAnalytics. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSearchAnalytics(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSearchAnalytics {
+	mock := &MockSearchAnalytics{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockCacheStorage is an autogenerated mock type for the CacheStorage type
+type MockCacheStorage struct {
+	mock.Mock
+}
+
+type MockCacheStorage_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCacheStorage) EXPECT() *MockCacheStorage_Expecter {
+	return &MockCacheStorage_Expecter{mock: &_m.Mock}
+}
+
+// Delete provides a mock function with given fields: ctx, key
+func (_m *MockCacheStorage) Delete(ctx context.Context, key string) error {
+	ret := _m.Called(ctx, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, key)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockCacheStorage_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockCacheStorage_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+func (_e *MockCacheStorage_Expecter) Delete(ctx interface{}, key interface{}) *MockCacheStorage_Delete_Call {
+	return &MockCacheStorage_Delete_Call{Call: _e.mock.On("Delete", ctx, key)}
+}
+
+func (_c *MockCacheStorage_Delete_Call) Run(run func(ctx context.Context, key string)) *MockCacheStorage_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockCacheStorage_Delete_Call) Return(_a0 error) *MockCacheStorage_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockCacheStorage_Delete_Call) RunAndReturn(run func(context.Context, string) error) *MockCacheStorage_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Set provides a mock function with given fields: ctx, key, value, ttl
+func (_m *MockCacheStorage) Set(ctx context.Context, key string, value string, ttl time.Duration) error {
+	ret := _m.Called(ctx, key, value, ttl)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Set")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Duration) error); ok {
+		r0 = rf(ctx, key, value, ttl)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockCacheStorage_Set_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Set'
+type MockCacheStorage_Set_Call struct {
+	*mock.Call
+}
+
+// Set is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+//   - value string
+//   - ttl time.Duration
+func (_e *MockCacheStorage_Expecter) Set(ctx interface{}, key interface{}, value interface{}, ttl interface{}) *MockCacheStorage_Set_Call {
+	return &MockCacheStorage_Set_Call{Call: _e.mock.On("Set", ctx, key, value, ttl)}
+}
+
+func (_c *MockCacheStorage_Set_Call) Run(run func(ctx context.Context, key string, value string, ttl time.Duration)) *MockCacheStorage_Set_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *MockCacheStorage_Set_Call) Return(_a0 error) *MockCacheStorage_Set_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockCacheStorage_Set_Call) RunAndReturn(run func(context.Context, string, string, time.Duration) error) *MockCacheStorage_Set_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCacheStorage creates a new instance of MockCacheStorage. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCacheStorage(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCacheStorage {
+	mock := &MockCacheStorage{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockAlertPublisher is an autogenerated mock type for the AlertPublisher type
+type MockAlertPublisher struct {
+	mock.Mock
+}
+
+type MockAlertPublisher_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAlertPublisher) EXPECT() *MockAlertPublisher_Expecter {
+	return &MockAlertPublisher_Expecter{mock: &_m.Mock}
+}
+
+// PublishPriceAlert provides a mock function with given fields: ctx, event
+func (_m *MockAlertPublisher) PublishPriceAlert(ctx context.Context, event *models.PriceAlertEvent) error {
+	ret := _m.Called(ctx, event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PublishPriceAlert")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.PriceAlertEvent) error); ok {
+		r0 = rf(ctx, event)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAlertPublisher_PublishPriceAlert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PublishPriceAlert'
+type MockAlertPublisher_PublishPriceAlert_Call struct {
+	*mock.Call
+}
+
+// PublishPriceAlert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - event *models.PriceAlertEvent
+func (_e *MockAlertPublisher_Expecter) PublishPriceAlert(ctx interface{}, event interface{}) *MockAlertPublisher_PublishPriceAlert_Call {
+	return &MockAlertPublisher_PublishPriceAlert_Call{Call: _e.mock.On("PublishPriceAlert", ctx, event)}
+}
+
+func (_c *MockAlertPublisher_PublishPriceAlert_Call) Run(run func(ctx context.Context, event *models.PriceAlertEvent)) *MockAlertPublisher_PublishPriceAlert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.PriceAlertEvent))
+	})
+	return _c
+}
+
+func (_c *MockAlertPublisher_PublishPriceAlert_Call) Return(_a0 error) *MockAlertPublisher_PublishPriceAlert_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAlertPublisher_PublishPriceAlert_Call) RunAndReturn(run func(context.Context, *models.PriceAlertEvent) error) *MockAlertPublisher_PublishPriceAlert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockAlertPublisher creates a new instance of MockAlertPublisher. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAlertPublisher(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAlertPublisher {
+	mock := &MockAlertPublisher{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockAutocompleteWeights is an autogenerated mock type for the AutocompleteWeights type
+type MockAutocompleteWeights struct {
+	mock.Mock
+}
+
+type MockAutocompleteWeights_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAutocompleteWeights) EXPECT() *MockAutocompleteWeights_Expecter {
+	return &MockAutocompleteWeights_Expecter{mock: &_m.Mock}
+}
+
+// UpdateVolume provides a mock function with given fields: ctx, slug, volume24h
+func (_m *MockAutocompleteWeights) UpdateVolume(ctx context.Context, slug string, volume24h int) error {
+	ret := _m.Called(ctx, slug, volume24h)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateVolume")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) error); ok {
+		r0 = rf(ctx, slug, volume24h)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAutocompleteWeights_UpdateVolume_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateVolume'
+type MockAutocompleteWeights_UpdateVolume_Call struct {
+	*mock.Call
+}
+
+// UpdateVolume is a helper method to define mock.On call
+//   - ctx context.Context
+//   - slug string
+//   - volume24h int
+func (_e *MockAutocompleteWeights_Expecter) UpdateVolume(ctx interface{}, slug interface{}, volume24h interface{}) *MockAutocompleteWeights_UpdateVolume_Call {
+	return &MockAutocompleteWeights_UpdateVolume_Call{Call: _e.mock.On("UpdateVolume", ctx, slug, volume24h)}
+}
+
+func (_c *MockAutocompleteWeights_UpdateVolume_Call) Run(run func(ctx context.Context, slug string, volume24h int)) *MockAutocompleteWeights_UpdateVolume_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockAutocompleteWeights_UpdateVolume_Call) Return(_a0 error) *MockAutocompleteWeights_UpdateVolume_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAutocompleteWeights_UpdateVolume_Call) RunAndReturn(run func(context.Context, string, int) error) *MockAutocompleteWeights_UpdateVolume_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockAutocompleteWeights creates a new instance of MockAutocompleteWeights. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAutocompleteWeights(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAutocompleteWeights {
+	mock := &MockAutocompleteWeights{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
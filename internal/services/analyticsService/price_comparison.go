@@ -0,0 +1,42 @@
+package analyticsservice
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/kedr891/cs-parser/internal/models"
+)
+
+// GetPriceComparison сравнивает последние цены скина на площадках за maxAge.
+// Если свежих цен нет, сравнение возвращается с пустым Prices.
+func (s *Service) GetPriceComparison(ctx context.Context, slug string, maxAge time.Duration) (*models.PriceComparison, error) {
+	if maxAge <= 0 {
+		maxAge = models.DefaultComparisonMaxAge
+	}
+
+	skin, err := s.storage.GetSkinBySlug(ctx, slug)
+	if err != nil {
+		return nil, fmt.Errorf("get skin by slug: %w", err)
+	}
+
+	prices, updatedAt, err := s.storage.GetLatestSourcePrices(ctx, skin, maxAge)
+	if err != nil {
+		return nil, fmt.Errorf("get latest source prices: %w", err)
+	}
+
+	return models.NewPriceComparison(skin, prices, updatedAt), nil
+}
+
+// GetPriceSpreads возвращает скины каталога с наибольшим спредом между площадками.
+func (s *Service) GetPriceSpreads(ctx context.Context, maxAge time.Duration, minPrice float64, limit int) ([]models.PriceComparison, error) {
+	if maxAge <= 0 {
+		maxAge = models.DefaultComparisonMaxAge
+	}
+
+	comparisons, err := s.storage.GetPriceSpreads(ctx, maxAge, minPrice, limit)
+	if err != nil {
+		return nil, fmt.Errorf("get price spreads: %w", err)
+	}
+	return comparisons, nil
+}
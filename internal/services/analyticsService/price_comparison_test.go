@@ -0,0 +1,69 @@
+package analyticsservice
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kedr891/cs-parser/internal/models"
+)
+
+func (suite *AnalyticsServiceSuite) TestGetPriceComparison_PicksBestBuyAndSell() {
+	skin := &models.Skin{ID: uuid.New(), Slug: "awp_asiimov_ft", MarketHashName: "AWP | Asiimov (Field-Tested)", Weapon: "AWP"}
+	updatedAt := time.Now()
+
+	suite.mockStorage.On("GetSkinBySlug", suite.ctx, skin.Slug).
+		Return(skin, nil)
+
+	suite.mockStorage.On("GetLatestSourcePrices", suite.ctx, skin, models.DefaultComparisonMaxAge).
+		Return(map[string]float64{"steam_market": 120, "skinport": 100, "buff_market": 90, "csmoney": 120}, updatedAt, nil)
+
+	comparison, err := suite.service.GetPriceComparison(suite.ctx, skin.Slug, 0)
+
+	suite.NoError(err)
+	suite.Equal(90.0, comparison.BestPrice)
+	suite.Equal("buff_market", comparison.BestSource)
+	suite.Equal(120.0, comparison.SellPrice)
+	// при равной цене выбирается площадка с меньшим именем
+	suite.Equal("csmoney", comparison.SellSource)
+	suite.Equal(30.0, comparison.PriceDiff)
+	suite.InDelta(33.33, comparison.SpreadPercent, 0.01)
+	suite.Equal(updatedAt, comparison.UpdatedAt)
+}
+
+func (suite *AnalyticsServiceSuite) TestGetPriceComparison_NoFreshPrices() {
+	skin := &models.Skin{ID: uuid.New(), Slug: "awp_asiimov_ft", Weapon: "AWP"}
+
+	suite.mockStorage.On("GetSkinBySlug", suite.ctx, skin.Slug).
+		Return(skin, nil)
+
+	suite.mockStorage.On("GetLatestSourcePrices", suite.ctx, skin, time.Hour).
+		Return(map[string]float64{}, time.Time{}, nil)
+
+	comparison, err := suite.service.GetPriceComparison(suite.ctx, skin.Slug, time.Hour)
+
+	suite.NoError(err)
+	suite.Empty(comparison.Prices)
+	suite.Empty(comparison.BestSource)
+	suite.Zero(comparison.SpreadPercent)
+}
+
+func (suite *AnalyticsServiceSuite) TestGetPriceComparison_SkinNotFound() {
+	suite.mockStorage.On("GetSkinBySlug", suite.ctx, "missing").
+		Return(nil, models.ErrSkinNotFound)
+
+	_, err := suite.service.GetPriceComparison(suite.ctx, "missing", 0)
+
+	suite.ErrorIs(err, models.ErrSkinNotFound)
+}
+
+func (suite *AnalyticsServiceSuite) TestGetPriceSpreads_DefaultsMaxAge() {
+	expected := []models.PriceComparison{{Slug: "awp_asiimov_ft", SpreadPercent: 12}}
+
+	suite.mockStorage.On("GetPriceSpreads", suite.ctx, models.DefaultComparisonMaxAge, 5.0, 10).
+		Return(expected, nil)
+
+	comparisons, err := suite.service.GetPriceSpreads(suite.ctx, 0, 5, 10)
+
+	suite.NoError(err)
+	suite.Equal(expected, comparisons)
+}
@@ -2,6 +2,7 @@ package analyticsservice
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/kedr891/cs-parser/internal/models"
 )
@@ -14,6 +15,30 @@ func (s *Service) ProcessPriceUpdate(ctx context.Context, event *models.PriceUpd
 		"change", event.PriceChange,
 	)
 
+	recorded, err := s.storage.RecordPriceUpdate(ctx, event)
+	if err != nil {
+		return fmt.Errorf("record price update: %w", err)
+	}
+	if !recorded {
+		// Повторная доставка: цена уже учтена, правила по ней уже проверены.
+		s.log.Debug("Duplicate price update skipped", "skin_id", event.SkinID, "source", event.Source)
+		return nil
+	}
+
+	if err := s.evaluateAlerts(ctx, event); err != nil {
+		// Без точки истории повторная обработка события снова проверит правила.
+		if forgetErr := s.storage.ForgetPriceUpdate(ctx, event); forgetErr != nil {
+			s.log.Error("Failed to forget price update", "skin_id", event.SkinID, "error", forgetErr)
+		}
+		return fmt.Errorf("evaluate alerts: %w", err)
+	}
+
+	if s.autocomplete != nil && event.Slug != "" {
+		if err := s.autocomplete.UpdateVolume(ctx, event.Slug, event.Volume24h); err != nil {
+			s.log.Warn("Failed to update autocomplete weight", "slug", event.Slug, "error", err)
+		}
+	}
+
 	if err := s.priceAnalytics.UpdateTrending(ctx, event); err != nil {
 		s.log.Warn("Failed to update trending", "error", err)
 	}
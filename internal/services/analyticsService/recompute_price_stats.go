@@ -0,0 +1,62 @@
+package analyticsservice
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// RecomputePriceStats пересчитывает изменения цены и экстремумы по истории цен.
+// После пересчета сбрасывается кэш обзора рынка, так как в нем top gainers/losers.
+func (s *Service) RecomputePriceStats(ctx context.Context) (int, error) {
+	started := time.Now()
+
+	updated, err := s.storage.RecomputePriceStats(ctx)
+	if err != nil {
+		return updated, fmt.Errorf("recompute price stats: %w", err)
+	}
+
+	if err := s.priceAnalytics.InvalidateMarketOverview(ctx); err != nil {
+		s.log.Warn("Failed to invalidate market overview", "error", err)
+	}
+
+	s.log.Info("Price stats recomputed",
+		"updated_skins", updated,
+		"duration", time.Since(started),
+	)
+
+	return updated, nil
+}
+
+type PriceStatsJob struct {
+	service  *Service
+	interval time.Duration
+}
+
+func NewPriceStatsJob(service *Service, interval time.Duration) *PriceStatsJob {
+	return &PriceStatsJob{
+		service:  service,
+		interval: interval,
+	}
+}
+
+// Run пересчитывает статистику сразу при старте и далее с заданным интервалом.
+func (j *PriceStatsJob) Run(ctx context.Context) error {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := j.service.RecomputePriceStats(ctx); err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			j.service.log.Error("Price stats job failed", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,131 @@
+package exportservice
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/parquet-go/parquet-go"
+
+	"github.com/kedr891/cs-parser/internal/models"
+)
+
+// parquetRowGroupSize - после стольких строк группа строк Parquet сбрасывается в w,
+// поэтому в памяти держится не больше одной группы.
+const parquetRowGroupSize = 50000
+
+type encoder[R exportRow] interface {
+	Write(row R) error
+	// Close дописывает буферизованные данные (и футер Parquet), но не закрывает w.
+	Close() error
+}
+
+func newEncoder[R exportRow](format models.ExportFormat, w io.Writer) (encoder[R], error) {
+	switch format {
+	case models.ExportCSV:
+		return newCSVEncoder[R](w), nil
+	case models.ExportNDJSON:
+		return newNDJSONEncoder[R](w), nil
+	case models.ExportParquet:
+		return newParquetEncoder[R](w), nil
+	default:
+		return nil, fmt.Errorf("%w: unknown format %q", models.ErrInvalidExport, format)
+	}
+}
+
+type csvEncoder[R exportRow] struct {
+	w      *csv.Writer
+	header []string
+	wrote  bool
+}
+
+func newCSVEncoder[R exportRow](w io.Writer) *csvEncoder[R] {
+	return &csvEncoder[R]{
+		w:      csv.NewWriter(w),
+		header: columnNames[R](),
+	}
+}
+
+func (e *csvEncoder[R]) Write(row R) error {
+	if err := e.writeHeader(); err != nil {
+		return err
+	}
+	return e.w.Write(row.csvRecord())
+}
+
+func (e *csvEncoder[R]) Close() error {
+	// заголовок пишется и для пустой выгрузки
+	if err := e.writeHeader(); err != nil {
+		return err
+	}
+	e.w.Flush()
+	return e.w.Error()
+}
+
+func (e *csvEncoder[R]) writeHeader() error {
+	if e.wrote {
+		return nil
+	}
+	e.wrote = true
+	return e.w.Write(e.header)
+}
+
+type ndjsonEncoder[R exportRow] struct {
+	buf *bufio.Writer
+	enc *json.Encoder
+}
+
+func newNDJSONEncoder[R exportRow](w io.Writer) *ndjsonEncoder[R] {
+	buf := bufio.NewWriter(w)
+	return &ndjsonEncoder[R]{
+		buf: buf,
+		enc: json.NewEncoder(buf),
+	}
+}
+
+func (e *ndjsonEncoder[R]) Write(row R) error {
+	return e.enc.Encode(row)
+}
+
+func (e *ndjsonEncoder[R]) Close() error {
+	return e.buf.Flush()
+}
+
+type parquetEncoder[R exportRow] struct {
+	w       *parquet.GenericWriter[R]
+	pending int
+}
+
+func newParquetEncoder[R exportRow](w io.Writer) *parquetEncoder[R] {
+	return &parquetEncoder[R]{
+		w: parquet.NewGenericWriter[R](w, parquet.Compression(&parquet.Zstd)),
+	}
+}
+
+func (e *parquetEncoder[R]) Write(row R) error {
+	if _, err := e.w.Write([]R{row}); err != nil {
+		return err
+	}
+	e.pending++
+	if e.pending >= parquetRowGroupSize {
+		e.pending = 0
+		return e.w.Flush()
+	}
+	return nil
+}
+
+func (e *parquetEncoder[R]) Close() error {
+	return e.w.Close()
+}
+
+// columnNames - имена колонок Parquet строки R в порядке полей, они же заголовок CSV.
+func columnNames[R exportRow]() []string {
+	fields := parquet.SchemaOf(new(R)).Fields()
+	names := make([]string, len(fields))
+	for i, field := range fields {
+		names[i] = field.Name()
+	}
+	return names
+}
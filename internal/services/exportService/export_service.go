@@ -0,0 +1,88 @@
+package exportservice
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"time"
+
+	"github.com/kedr891/cs-parser/internal/models"
+)
+
+type ExportStorage interface {
+	StreamSkins(ctx context.Context, filter *models.ExportFilter, fn func(*models.Skin) error) error
+	StreamPriceHistory(ctx context.Context, filter *models.ExportFilter, fn func(*models.PriceHistoryExport) error) error
+}
+
+type Service struct {
+	storage ExportStorage
+	log     *slog.Logger
+}
+
+func New(storage ExportStorage, log *slog.Logger) *Service {
+	return &Service{
+		storage: storage,
+		log:     log,
+	}
+}
+
+// Export пишет набор filter.Dataset в w в формате filter.Format по мере чтения из шардов
+// и возвращает число выгруженных строк. Если запись в w не удалась, чтение прекращается.
+func (s *Service) Export(ctx context.Context, filter *models.ExportFilter, w io.Writer) (int, error) {
+	if err := filter.Validate(); err != nil {
+		return 0, err
+	}
+
+	started := time.Now()
+
+	var (
+		rows int
+		err  error
+	)
+	switch filter.Dataset {
+	case models.ExportSkins:
+		rows, err = export(w, filter.Format, func(write func(skinRow) error) error {
+			return s.storage.StreamSkins(ctx, filter, func(skin *models.Skin) error {
+				return write(newSkinRow(skin))
+			})
+		})
+	case models.ExportPriceHistory:
+		rows, err = export(w, filter.Format, func(write func(priceHistoryRow) error) error {
+			return s.storage.StreamPriceHistory(ctx, filter, func(h *models.PriceHistoryExport) error {
+				return write(newPriceHistoryRow(h))
+			})
+		})
+	}
+	if err != nil {
+		return rows, fmt.Errorf("export %s: %w", filter.Dataset, err)
+	}
+
+	s.log.Info("Export done",
+		"dataset", filter.Dataset,
+		"format", filter.Format,
+		"rows", rows,
+		"duration", time.Since(started),
+	)
+
+	return rows, nil
+}
+
+// export связывает поток строк с кодировщиком формата.
+func export[R exportRow](w io.Writer, format models.ExportFormat, stream func(write func(R) error) error) (int, error) {
+	enc, err := newEncoder[R](format, w)
+	if err != nil {
+		return 0, err
+	}
+
+	rows := 0
+	err = stream(func(row R) error {
+		rows++
+		return enc.Write(row)
+	})
+	if err != nil {
+		return rows, err
+	}
+
+	return rows, enc.Close()
+}
@@ -0,0 +1,147 @@
+package exportservice
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kedr891/cs-parser/internal/models"
+	"github.com/kedr891/cs-parser/internal/services/exportService/mocks"
+	"github.com/parquet-go/parquet-go"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type ExportServiceSuite struct {
+	suite.Suite
+	ctx         context.Context
+	service     *Service
+	mockStorage *mocks.MockExportStorage
+	history     []models.PriceHistoryExport
+}
+
+func (suite *ExportServiceSuite) SetupTest() {
+	suite.ctx = context.Background()
+	suite.mockStorage = mocks.NewMockExportStorage(suite.T())
+	log := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelError}))
+	suite.service = New(suite.mockStorage, log)
+
+	recorded := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	suite.history = []models.PriceHistoryExport{
+		{SkinID: uuid.New(), Slug: "awp_asiimov_ft", MarketHashName: "AWP | Asiimov (Field-Tested)", Weapon: "AWP", Price: 45.5, Currency: "USD", Source: "steam_market", Volume: 12, RecordedAt: recorded},
+		{SkinID: uuid.New(), Slug: "ak_47_redline_ft", MarketHashName: "AK-47 | Redline, \"FT\"", Weapon: "AK-47", Price: 12, Currency: "USD", Source: "skinport", Volume: 3, RecordedAt: recorded.Add(time.Hour)},
+	}
+}
+
+func TestExportServiceSuite(t *testing.T) {
+	suite.Run(t, new(ExportServiceSuite))
+}
+
+func (suite *ExportServiceSuite) expectHistory(filter *models.ExportFilter) {
+	suite.mockStorage.On("StreamPriceHistory", suite.ctx, filter, mock.Anything).
+		Return(func(_ context.Context, _ *models.ExportFilter, fn func(*models.PriceHistoryExport) error) error {
+			for i := range suite.history {
+				if err := fn(&suite.history[i]); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+}
+
+func (suite *ExportServiceSuite) TestExport_CSV() {
+	filter := &models.ExportFilter{Dataset: models.ExportPriceHistory, Format: models.ExportCSV}
+	suite.expectHistory(filter)
+
+	var out bytes.Buffer
+	rows, err := suite.service.Export(suite.ctx, filter, &out)
+
+	suite.NoError(err)
+	suite.Equal(2, rows)
+
+	records, err := csv.NewReader(&out).ReadAll()
+	suite.Require().NoError(err)
+	suite.Equal([]string{"skin_id", "slug", "market_hash_name", "weapon", "price", "currency", "source", "volume", "recorded_at"}, records[0])
+	suite.Equal([]string{
+		suite.history[1].SkinID.String(), "ak_47_redline_ft", "AK-47 | Redline, \"FT\"", "AK-47",
+		"12", "USD", "skinport", "3", "2026-03-01T13:00:00Z",
+	}, records[2])
+}
+
+func (suite *ExportServiceSuite) TestExport_CSVHeaderForEmptyResult() {
+	filter := &models.ExportFilter{Dataset: models.ExportSkins, Format: models.ExportCSV, Weapon: "AWP"}
+	suite.mockStorage.On("StreamSkins", suite.ctx, filter, mock.Anything).
+		Return(nil)
+
+	var out bytes.Buffer
+	rows, err := suite.service.Export(suite.ctx, filter, &out)
+
+	suite.NoError(err)
+	suite.Zero(rows)
+	suite.True(strings.HasPrefix(out.String(), "id,slug,market_hash_name,"))
+}
+
+func (suite *ExportServiceSuite) TestExport_NDJSON() {
+	filter := &models.ExportFilter{Dataset: models.ExportPriceHistory, Format: models.ExportNDJSON, Source: "steam_market"}
+	suite.expectHistory(filter)
+
+	var out bytes.Buffer
+	_, err := suite.service.Export(suite.ctx, filter, &out)
+	suite.NoError(err)
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	suite.Require().Len(lines, 2)
+
+	var first map[string]interface{}
+	suite.Require().NoError(json.Unmarshal([]byte(lines[0]), &first))
+	suite.Equal("awp_asiimov_ft", first["slug"])
+	suite.Equal(45.5, first["price"])
+	suite.Equal("2026-03-01T12:00:00Z", first["recorded_at"])
+}
+
+func (suite *ExportServiceSuite) TestExport_Parquet() {
+	filter := &models.ExportFilter{Dataset: models.ExportPriceHistory, Format: models.ExportParquet}
+	suite.expectHistory(filter)
+
+	var out bytes.Buffer
+	_, err := suite.service.Export(suite.ctx, filter, &out)
+	suite.NoError(err)
+
+	rows, err := parquet.Read[priceHistoryRow](bytes.NewReader(out.Bytes()), int64(out.Len()))
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, 2)
+	suite.Equal(newPriceHistoryRow(&suite.history[0]), rows[0])
+	suite.Equal("skinport", rows[1].Source)
+}
+
+func (suite *ExportServiceSuite) TestExport_StopsOnWriteError() {
+	filter := &models.ExportFilter{Dataset: models.ExportPriceHistory, Format: models.ExportCSV}
+	suite.expectHistory(filter)
+
+	_, err := suite.service.Export(suite.ctx, filter, failingWriter{})
+
+	suite.Error(err)
+}
+
+func (suite *ExportServiceSuite) TestExport_InvalidFilter() {
+	filter := &models.ExportFilter{Dataset: models.ExportSkins, Format: models.ExportCSV, Source: "steam_market"}
+
+	_, err := suite.service.Export(suite.ctx, filter, &bytes.Buffer{})
+
+	suite.ErrorIs(err, models.ErrInvalidExport)
+	suite.mockStorage.AssertNotCalled(suite.T(), "StreamSkins", mock.Anything, mock.Anything, mock.Anything)
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) {
+	return 0, errors.New("disk full")
+}
@@ -0,0 +1,110 @@
+package exportservice
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/kedr891/cs-parser/internal/models"
+)
+
+// exportRow - строка выгрузки. Теги json задают поля NDJSON, теги parquet - колонки Parquet
+// и заголовок CSV; csvRecord возвращает значения в порядке полей структуры.
+type exportRow interface {
+	csvRecord() []string
+}
+
+type skinRow struct {
+	ID             string    `json:"id" parquet:"id"`
+	Slug           string    `json:"slug" parquet:"slug"`
+	MarketHashName string    `json:"market_hash_name" parquet:"market_hash_name"`
+	Name           string    `json:"name" parquet:"name"`
+	Weapon         string    `json:"weapon" parquet:"weapon"`
+	Quality        string    `json:"quality" parquet:"quality"`
+	Rarity         string    `json:"rarity" parquet:"rarity"`
+	CurrentPrice   float64   `json:"current_price" parquet:"current_price"`
+	Currency       string    `json:"currency" parquet:"currency"`
+	ImageURL       string    `json:"image_url" parquet:"image_url"`
+	Volume24h      int64     `json:"volume_24h" parquet:"volume_24h"`
+	PriceChange24h float64   `json:"price_change_24h" parquet:"price_change_24h"`
+	PriceChange7d  float64   `json:"price_change_7d" parquet:"price_change_7d"`
+	LowestPrice    float64   `json:"lowest_price" parquet:"lowest_price"`
+	HighestPrice   float64   `json:"highest_price" parquet:"highest_price"`
+	LastUpdated    time.Time `json:"last_updated" parquet:"last_updated,timestamp(millisecond)"`
+	CreatedAt      time.Time `json:"created_at" parquet:"created_at,timestamp(millisecond)"`
+	UpdatedAt      time.Time `json:"updated_at" parquet:"updated_at,timestamp(millisecond)"`
+}
+
+func newSkinRow(skin *models.Skin) skinRow {
+	return skinRow{
+		ID:             skin.ID.String(),
+		Slug:           skin.Slug,
+		MarketHashName: skin.MarketHashName,
+		Name:           skin.Name,
+		Weapon:         skin.Weapon,
+		Quality:        skin.Quality,
+		Rarity:         skin.Rarity,
+		CurrentPrice:   skin.CurrentPrice,
+		Currency:       skin.Currency,
+		ImageURL:       skin.ImageURL,
+		Volume24h:      int64(skin.Volume24h),
+		PriceChange24h: skin.PriceChange24h,
+		PriceChange7d:  skin.PriceChange7d,
+		LowestPrice:    skin.LowestPrice,
+		HighestPrice:   skin.HighestPrice,
+		LastUpdated:    skin.LastUpdated.UTC(),
+		CreatedAt:      skin.CreatedAt.UTC(),
+		UpdatedAt:      skin.UpdatedAt.UTC(),
+	}
+}
+
+func (r skinRow) csvRecord() []string {
+	return []string{
+		r.ID, r.Slug, r.MarketHashName, r.Name, r.Weapon, r.Quality, r.Rarity,
+		formatFloat(r.CurrentPrice), r.Currency, r.ImageURL, strconv.FormatInt(r.Volume24h, 10),
+		formatFloat(r.PriceChange24h), formatFloat(r.PriceChange7d),
+		formatFloat(r.LowestPrice), formatFloat(r.HighestPrice),
+		formatTime(r.LastUpdated), formatTime(r.CreatedAt), formatTime(r.UpdatedAt),
+	}
+}
+
+type priceHistoryRow struct {
+	SkinID         string    `json:"skin_id" parquet:"skin_id"`
+	Slug           string    `json:"slug" parquet:"slug"`
+	MarketHashName string    `json:"market_hash_name" parquet:"market_hash_name"`
+	Weapon         string    `json:"weapon" parquet:"weapon"`
+	Price          float64   `json:"price" parquet:"price"`
+	Currency       string    `json:"currency" parquet:"currency"`
+	Source         string    `json:"source" parquet:"source"`
+	Volume         int64     `json:"volume" parquet:"volume"`
+	RecordedAt     time.Time `json:"recorded_at" parquet:"recorded_at,timestamp(millisecond)"`
+}
+
+func newPriceHistoryRow(h *models.PriceHistoryExport) priceHistoryRow {
+	return priceHistoryRow{
+		SkinID:         h.SkinID.String(),
+		Slug:           h.Slug,
+		MarketHashName: h.MarketHashName,
+		Weapon:         h.Weapon,
+		Price:          h.Price,
+		Currency:       h.Currency,
+		Source:         h.Source,
+		Volume:         int64(h.Volume),
+		RecordedAt:     h.RecordedAt.UTC(),
+	}
+}
+
+func (r priceHistoryRow) csvRecord() []string {
+	return []string{
+		r.SkinID, r.Slug, r.MarketHashName, r.Weapon,
+		formatFloat(r.Price), r.Currency, r.Source, strconv.FormatInt(r.Volume, 10),
+		formatTime(r.RecordedAt),
+	}
+}
+
+func formatFloat(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+func formatTime(t time.Time) string {
+	return t.Format(time.RFC3339Nano)
+}
@@ -0,0 +1,313 @@
+package notifierservice
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kedr891/cs-parser/internal/models"
+	"github.com/kedr891/cs-parser/internal/retry"
+)
+
+const _eventPriceAlert = "price_alert"
+
+var (
+	// errQueueFull - очередь webhook-а переполнена: адрес не успевает принимать уведомления.
+	errQueueFull = errors.New("delivery queue is full")
+	// errStopped - сервис остановлен и не принимает доставки в очереди.
+	errStopped = errors.New("notifier stopped")
+	// errWebhookInactive - webhook отключен, пока доставка ждала отправки.
+	errWebhookInactive = errors.New("webhook is inactive")
+)
+
+// DeliverPriceAlert сохраняет доставку уведомления на каждый активный webhook в статусе pending
+// и ставит их в очереди воркеров, не дожидаясь отправки. После успешного возврата уведомление
+// не теряется: то, что не отправлено до остановки API, отправится после перезапуска.
+// Ошибка возвращается, только если список webhook-ов не удалось получить или доставки не сохранились;
+// неудачная отправка записывается в журнал и не считается ошибкой обработки события.
+func (s *Service) DeliverPriceAlert(ctx context.Context, event *models.PriceAlertEvent) error {
+	webhooks, err := s.storage.ListActiveWebhooks(ctx)
+	if err != nil {
+		return fmt.Errorf("list active webhooks: %w", err)
+	}
+	if len(webhooks) == 0 {
+		return nil
+	}
+
+	body, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("marshal price alert event: %w", err)
+	}
+
+	jobs := make([]models.PendingDelivery, len(webhooks))
+	deliveries := make([]models.WebhookDelivery, len(webhooks))
+	for i, webhook := range webhooks {
+		deliveries[i] = newDelivery(&webhook, event.AlertID, body)
+		jobs[i] = models.PendingDelivery{Webhook: webhook, Delivery: deliveries[i]}
+	}
+
+	if err := s.storage.CreateWebhookDeliveries(ctx, deliveries, s.lease); err != nil {
+		return fmt.Errorf("create webhook deliveries: %w", err)
+	}
+
+	for _, job := range jobs {
+		s.dispatch(ctx, job)
+	}
+
+	return nil
+}
+
+// resumePending забирает из журнала доставки в статусе pending, срок закрепления которых истек.
+func (s *Service) resumePending(ctx context.Context) {
+	pending, err := s.storage.ClaimWebhookDeliveries(ctx, _claimBatch, s.lease)
+	if err != nil {
+		if ctx.Err() == nil {
+			s.log.Error("Failed to claim pending webhook deliveries", "error", err)
+		}
+		return
+	}
+
+	for _, job := range pending {
+		if !job.Webhook.Active {
+			s.finish(ctx, &job.Delivery, errWebhookInactive)
+			continue
+		}
+		s.dispatch(ctx, job)
+	}
+}
+
+// dispatch ставит доставку в очередь webhook-а. Если очередь переполнена или сервис остановлен,
+// доставка сразу возвращается в pending и ее заберет следующий опрос.
+func (s *Service) dispatch(ctx context.Context, job models.PendingDelivery) {
+	err := s.enqueue(job)
+	if err == nil {
+		return
+	}
+
+	if errors.Is(err, errQueueFull) {
+		s.log.Warn("Webhook delivery queue is full, delivery postponed",
+			"webhook_id", job.Webhook.ID,
+			"delivery_id", job.Delivery.ID,
+		)
+	}
+	s.release(ctx, &job.Delivery)
+}
+
+// enqueue кладет доставку в очередь webhook-а, при необходимости запуская его воркер.
+// Доставка, которая уже стоит в очереди этого процесса, повторно не ставится.
+func (s *Service) enqueue(job models.PendingDelivery) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return errStopped
+	}
+	if _, ok := s.inflight[job.Delivery.ID]; ok {
+		return nil
+	}
+
+	queue, ok := s.queues[job.Webhook.ID]
+	if !ok {
+		queue = make(chan models.PendingDelivery, s.queue.Depth)
+		s.queues[job.Webhook.ID] = queue
+		s.workers.Add(1)
+		go s.runWorker(job.Webhook.ID, queue)
+	}
+
+	select {
+	case queue <- job:
+		s.inflight[job.Delivery.ID] = struct{}{}
+		return nil
+	default:
+		return errQueueFull
+	}
+}
+
+// runWorker отправляет доставки одного webhook-а по очереди и останавливается, если
+// за queue.IdleTimeout не пришло ни одной.
+func (s *Service) runWorker(webhookID uuid.UUID, queue chan models.PendingDelivery) {
+	defer s.workers.Done()
+
+	idle := time.NewTimer(s.queue.IdleTimeout)
+	defer idle.Stop()
+
+	for {
+		select {
+		case job, ok := <-queue:
+			if !ok {
+				return
+			}
+			s.process(&job)
+			idle.Reset(s.queue.IdleTimeout)
+		case <-idle.C:
+			if s.retire(webhookID, queue) {
+				return
+			}
+			idle.Reset(s.queue.IdleTimeout)
+		}
+	}
+}
+
+func (s *Service) process(job *models.PendingDelivery) {
+	if s.ctx.Err() != nil {
+		// Сервис останавливается: доставка остается в pending до перезапуска.
+		s.release(s.ctx, &job.Delivery)
+	} else {
+		s.deliver(s.ctx, job)
+	}
+
+	s.mu.Lock()
+	delete(s.inflight, job.Delivery.ID)
+	s.mu.Unlock()
+}
+
+// retire убирает очередь простаивающего воркера. Доставки ставятся в очередь под тем же mu,
+// поэтому после удаления в нее ничего не попадет, а следующая доставка запустит новый воркер.
+func (s *Service) retire(webhookID uuid.UUID, queue chan models.PendingDelivery) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(queue) > 0 {
+		return false
+	}
+	if s.queues[webhookID] == queue {
+		delete(s.queues, webhookID)
+	}
+	return true
+}
+
+// shutdown закрывает очереди и ждет, пока воркеры обработают уже поставленные доставки.
+func (s *Service) shutdown() {
+	s.mu.Lock()
+	s.closed = true
+	for id, queue := range s.queues {
+		close(queue)
+		delete(s.queues, id)
+	}
+	s.mu.Unlock()
+
+	s.workers.Wait()
+}
+
+func newDelivery(webhook *models.Webhook, alertID uuid.UUID, body []byte) models.WebhookDelivery {
+	return models.WebhookDelivery{
+		ID:        uuid.New(),
+		WebhookID: webhook.ID,
+		AlertID:   alertID,
+		URL:       webhook.URL,
+		Status:    models.DeliveryStatusPending,
+		CreatedAt: time.Now().UTC(),
+		Payload:   body,
+	}
+}
+
+// deliver отправляет уведомление на webhook с повторами и записывает результат в журнал.
+// Попытки, сделанные до перезапуска, учитываются; прерванная остановкой доставка остается в pending.
+func (s *Service) deliver(ctx context.Context, job *models.PendingDelivery) {
+	webhook := &job.Webhook
+	delivery := &job.Delivery
+
+	// Последняя попытка могла прерваться на середине, поэтому хотя бы одна попытка остается всегда.
+	done := delivery.Attempts
+	policy := s.retry
+	policy.MaxAttempts = max(s.retry.MaxAttempts-done, 1)
+
+	// Ответы 4xx, кроме 408 и 429, не повторяются: send помечает их retry.ErrPermanent.
+	_, err := policy.Do(ctx, func(attempt int) error {
+		code, err := s.send(ctx, webhook, delivery.ID, delivery.Payload)
+		delivery.Attempts = done + attempt
+		delivery.ResponseCode = code
+		return err
+	}, func(attempt int, delay time.Duration, err error) {
+		s.log.Warn("Retrying webhook delivery",
+			"webhook_id", webhook.ID,
+			"attempt", done+attempt,
+			"backoff", delay,
+			"error", err,
+		)
+	})
+
+	if err != nil && ctx.Err() != nil {
+		s.release(ctx, delivery)
+		return
+	}
+
+	s.finish(ctx, delivery, err)
+}
+
+// finish записывает итог доставки.
+func (s *Service) finish(ctx context.Context, delivery *models.WebhookDelivery, err error) {
+	if err == nil {
+		deliveredAt := time.Now().UTC()
+		delivery.Status = models.DeliveryStatusDelivered
+		delivery.DeliveredAt = &deliveredAt
+	} else {
+		delivery.Status = models.DeliveryStatusFailed
+		delivery.Error = err.Error()
+		s.log.Error("Webhook delivery failed",
+			"webhook_id", delivery.WebhookID,
+			"alert_id", delivery.AlertID,
+			"attempts", delivery.Attempts,
+			"error", err,
+		)
+	}
+
+	s.updateDelivery(ctx, delivery)
+}
+
+// release возвращает доставку в pending, чтобы ее забрал следующий опрос.
+func (s *Service) release(ctx context.Context, delivery *models.WebhookDelivery) {
+	delivery.Status = models.DeliveryStatusPending
+	s.updateDelivery(ctx, delivery)
+}
+
+func (s *Service) updateDelivery(ctx context.Context, delivery *models.WebhookDelivery) {
+	// Журнал пишем и при остановке сервиса, чтобы не терять результат доставки.
+	if err := s.storage.UpdateWebhookDelivery(context.WithoutCancel(ctx), delivery); err != nil {
+		s.log.Error("Failed to update webhook delivery",
+			"webhook_id", delivery.WebhookID,
+			"delivery_id", delivery.ID,
+			"status", delivery.Status,
+			"error", err,
+		)
+	}
+}
+
+func (s *Service) send(ctx context.Context, webhook *models.Webhook, deliveryID uuid.UUID, body []byte) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, fmt.Errorf("%w: build request: %v", retry.ErrPermanent, err)
+	}
+
+	timestamp := time.Now().Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderEvent, _eventPriceAlert)
+	req.Header.Set(HeaderDelivery, deliveryID.String())
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(HeaderSignature, Sign(webhook.Secret, timestamp, body))
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("post webhook: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	switch {
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		return resp.StatusCode, nil
+	case resp.StatusCode == http.StatusTooManyRequests,
+		resp.StatusCode == http.StatusRequestTimeout,
+		resp.StatusCode >= 500:
+		return resp.StatusCode, fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+	default:
+		return resp.StatusCode, fmt.Errorf("%w: webhook responded with status %d", retry.ErrPermanent, resp.StatusCode)
+	}
+}
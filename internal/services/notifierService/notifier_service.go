@@ -0,0 +1,126 @@
+package notifierservice
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kedr891/cs-parser/internal/models"
+	"github.com/kedr891/cs-parser/internal/retry"
+)
+
+const (
+	_defaultTimeout        = 10 * time.Second
+	_defaultInitialBackoff = 500 * time.Millisecond
+	_defaultQueueDepth     = 100
+	_defaultPollInterval   = 5 * time.Second
+	_defaultIdleTimeout    = 5 * time.Minute
+	_claimBatch            = 100
+)
+
+type NotifierStorage interface {
+	CreateWebhook(ctx context.Context, webhook *models.Webhook) error
+	ListActiveWebhooks(ctx context.Context) ([]models.Webhook, error)
+	CreateWebhookDeliveries(ctx context.Context, deliveries []models.WebhookDelivery, lease time.Duration) error
+	ClaimWebhookDeliveries(ctx context.Context, limit int, lease time.Duration) ([]models.PendingDelivery, error)
+	UpdateWebhookDelivery(ctx context.Context, delivery *models.WebhookDelivery) error
+	ListWebhookDeliveries(ctx context.Context, webhookID uuid.UUID, limit int) ([]models.WebhookDelivery, error)
+}
+
+// QueueConfig задает очереди доставки: Depth - число уведомлений, ожидающих отправки на один webhook,
+// PollInterval - как часто забирать из журнала доставки в статусе pending, IdleTimeout - через сколько
+// без уведомлений воркер webhook-а останавливается (например, после отключения webhook-а).
+type QueueConfig struct {
+	Depth        int
+	PollInterval time.Duration
+	IdleTimeout  time.Duration
+}
+
+// Service доставляет уведомления на webhook-и. Каждая доставка сначала сохраняется в журнал
+// в статусе pending и только потом отправляется воркером своего webhook-а: повторы к недоступному
+// адресу не задерживают остальных подписчиков, а неотправленные уведомления переживают перезапуск.
+type Service struct {
+	storage    NotifierStorage
+	httpClient *http.Client
+	retry      retry.Policy
+	queue      QueueConfig
+	lease      time.Duration
+	log        *slog.Logger
+
+	ctx      context.Context
+	cancel   context.CancelFunc
+	mu       sync.Mutex
+	queues   map[uuid.UUID]chan models.PendingDelivery
+	inflight map[uuid.UUID]struct{}
+	closed   bool
+	workers  sync.WaitGroup
+}
+
+func New(storage NotifierStorage, httpClient *http.Client, policy retry.Policy, queue QueueConfig, log *slog.Logger) *Service {
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: _defaultTimeout}
+	}
+	policy = policy.WithDefaults(_defaultInitialBackoff)
+	if queue.Depth <= 0 {
+		queue.Depth = _defaultQueueDepth
+	}
+	if queue.PollInterval <= 0 {
+		queue.PollInterval = _defaultPollInterval
+	}
+	if queue.IdleTimeout <= 0 {
+		queue.IdleTimeout = _defaultIdleTimeout
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Service{
+		storage:    storage,
+		httpClient: httpClient,
+		retry:      policy,
+		queue:      queue,
+		lease:      deliveryLease(httpClient.Timeout, policy),
+		log:        log,
+		ctx:        ctx,
+		cancel:     cancel,
+		queues:     make(map[uuid.UUID]chan models.PendingDelivery),
+		inflight:   make(map[uuid.UUID]struct{}),
+	}
+}
+
+// Run сразу и затем каждые queue.PollInterval забирает доставки в статусе pending, которые
+// не отправлены за срок закрепления: оставшиеся от прошлого запуска, с упавшей реплики или
+// не поместившиеся в очередь. При отмене ctx текущие отправки прерываются, а они и вся очередь
+// остаются в статусе pending и будут отправлены после перезапуска.
+func (s *Service) Run(ctx context.Context) error {
+	ticker := time.NewTicker(s.queue.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		s.resumePending(ctx)
+
+		select {
+		case <-ctx.Done():
+			s.cancel()
+			s.shutdown()
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// deliveryLease - на сколько доставка закрепляется за воркером: время всех попыток с задержками.
+// Доставку, которая ждала в очереди дольше, может повторно забрать другая реплика; получатель
+// отличит повтор по заголовку HeaderDelivery.
+func deliveryLease(timeout time.Duration, policy retry.Policy) time.Duration {
+	if timeout <= 0 {
+		timeout = _defaultTimeout
+	}
+
+	var lease time.Duration
+	for attempt := 1; attempt <= policy.MaxAttempts; attempt++ {
+		lease += timeout + policy.Backoff(attempt)
+	}
+	return lease
+}
@@ -0,0 +1,467 @@
+package notifierservice
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kedr891/cs-parser/internal/models"
+	"github.com/kedr891/cs-parser/internal/retry"
+	"github.com/kedr891/cs-parser/internal/services/notifierService/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type receivedRequest struct {
+	header http.Header
+	body   []byte
+}
+
+type NotifierServiceSuite struct {
+	suite.Suite
+	ctx         context.Context
+	service     *Service
+	mockStorage *mocks.MockNotifierStorage
+	server      *httptest.Server
+
+	mu         sync.Mutex
+	statuses   []int
+	requests   []receivedRequest
+	webhook    models.Webhook
+	alertEvent *models.PriceAlertEvent
+}
+
+func (suite *NotifierServiceSuite) SetupTest() {
+	suite.ctx = context.Background()
+	suite.mockStorage = mocks.NewMockNotifierStorage(suite.T())
+	suite.statuses = nil
+	suite.requests = nil
+
+	suite.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+
+		suite.mu.Lock()
+		suite.requests = append(suite.requests, receivedRequest{header: r.Header.Clone(), body: body})
+		status := http.StatusOK
+		if len(suite.statuses) > 0 {
+			status = suite.statuses[0]
+			suite.statuses = suite.statuses[1:]
+		}
+		suite.mu.Unlock()
+
+		w.WriteHeader(status)
+	}))
+
+	suite.webhook = models.Webhook{ID: uuid.New(), URL: suite.server.URL + "/hooks/alerts", Secret: "s3cr3t", Active: true}
+	suite.alertEvent = &models.PriceAlertEvent{
+		AlertID:   uuid.New(),
+		SkinID:    uuid.New(),
+		Slug:      "awp_asiimov_ft",
+		Condition: models.AlertConditionBelow,
+		Price:     95,
+	}
+
+	log := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelError}))
+	policy := retry.Policy{MaxAttempts: 3, InitialBackoff: time.Millisecond, MaxBackoff: 2 * time.Millisecond}
+	suite.service = New(suite.mockStorage, suite.server.Client(), policy, QueueConfig{Depth: 10}, log)
+}
+
+func (suite *NotifierServiceSuite) TearDownTest() {
+	suite.service.shutdown()
+	suite.server.Close()
+}
+
+// expectCreate ожидает сохранение n доставок в статусе pending до постановки в очередь.
+func (suite *NotifierServiceSuite) expectCreate(n int) {
+	suite.mockStorage.On("CreateWebhookDeliveries", suite.ctx, mock.MatchedBy(func(ds []models.WebhookDelivery) bool {
+		for _, d := range ds {
+			if d.Status != models.DeliveryStatusPending || len(d.Payload) == 0 {
+				return false
+			}
+		}
+		return len(ds) == n
+	}), mock.Anything).Return(nil)
+}
+
+func TestNotifierServiceSuite(t *testing.T) {
+	suite.Run(t, new(NotifierServiceSuite))
+}
+
+func (suite *NotifierServiceSuite) TestDeliverPriceAlert_SignsPayload() {
+	suite.mockStorage.On("ListActiveWebhooks", suite.ctx).
+		Return([]models.Webhook{suite.webhook}, nil)
+
+	suite.expectCreate(1)
+
+	suite.mockStorage.On("UpdateWebhookDelivery", mock.Anything, mock.MatchedBy(func(d *models.WebhookDelivery) bool {
+		return d.Status == models.DeliveryStatusDelivered && d.Attempts == 1 && d.ResponseCode == 200 && d.DeliveredAt != nil
+	})).Return(nil)
+
+	err := suite.service.DeliverPriceAlert(suite.ctx, suite.alertEvent)
+	suite.service.shutdown()
+
+	suite.NoError(err)
+	suite.Require().Len(suite.requests, 1)
+	req := suite.requests[0]
+
+	timestamp, err := strconv.ParseInt(req.header.Get(HeaderTimestamp), 10, 64)
+	suite.Require().NoError(err)
+	suite.True(VerifySignature("s3cr3t", timestamp, req.body, req.header.Get(HeaderSignature)))
+	suite.False(VerifySignature("other", timestamp, req.body, req.header.Get(HeaderSignature)))
+	suite.Equal("price_alert", req.header.Get(HeaderEvent))
+	suite.NotEmpty(req.header.Get(HeaderDelivery))
+
+	var payload models.PriceAlertEvent
+	suite.Require().NoError(json.Unmarshal(req.body, &payload))
+	suite.Equal(suite.alertEvent.AlertID, payload.AlertID)
+	suite.Equal(95.0, payload.Price)
+}
+
+func (suite *NotifierServiceSuite) TestDeliverPriceAlert_RetriesServerErrors() {
+	suite.statuses = []int{http.StatusBadGateway, http.StatusTooManyRequests, http.StatusNoContent}
+
+	suite.mockStorage.On("ListActiveWebhooks", suite.ctx).
+		Return([]models.Webhook{suite.webhook}, nil)
+
+	suite.expectCreate(1)
+
+	suite.mockStorage.On("UpdateWebhookDelivery", mock.Anything, mock.MatchedBy(func(d *models.WebhookDelivery) bool {
+		return d.Status == models.DeliveryStatusDelivered && d.Attempts == 3 && d.ResponseCode == http.StatusNoContent
+	})).Return(nil)
+
+	err := suite.service.DeliverPriceAlert(suite.ctx, suite.alertEvent)
+	suite.service.shutdown()
+
+	suite.NoError(err)
+	suite.Len(suite.requests, 3)
+	suite.Equal(suite.requests[0].header.Get(HeaderDelivery), suite.requests[2].header.Get(HeaderDelivery))
+}
+
+func (suite *NotifierServiceSuite) TestDeliverPriceAlert_RecordsFailureAfterMaxAttempts() {
+	suite.statuses = []int{http.StatusInternalServerError, http.StatusInternalServerError, http.StatusInternalServerError}
+
+	suite.mockStorage.On("ListActiveWebhooks", suite.ctx).
+		Return([]models.Webhook{suite.webhook}, nil)
+
+	suite.expectCreate(1)
+
+	suite.mockStorage.On("UpdateWebhookDelivery", mock.Anything, mock.MatchedBy(func(d *models.WebhookDelivery) bool {
+		return d.Status == models.DeliveryStatusFailed && d.Attempts == 3 && d.ResponseCode == 500 && d.Error != ""
+	})).Return(nil)
+
+	err := suite.service.DeliverPriceAlert(suite.ctx, suite.alertEvent)
+	suite.service.shutdown()
+
+	suite.NoError(err)
+	suite.Len(suite.requests, 3)
+}
+
+func (suite *NotifierServiceSuite) TestDeliverPriceAlert_DoesNotRetryClientErrors() {
+	suite.statuses = []int{http.StatusGone}
+
+	suite.mockStorage.On("ListActiveWebhooks", suite.ctx).
+		Return([]models.Webhook{suite.webhook}, nil)
+
+	suite.expectCreate(1)
+
+	suite.mockStorage.On("UpdateWebhookDelivery", mock.Anything, mock.MatchedBy(func(d *models.WebhookDelivery) bool {
+		return d.Status == models.DeliveryStatusFailed && d.Attempts == 1 && d.ResponseCode == http.StatusGone
+	})).Return(nil)
+
+	err := suite.service.DeliverPriceAlert(suite.ctx, suite.alertEvent)
+	suite.service.shutdown()
+
+	suite.NoError(err)
+	suite.Len(suite.requests, 1)
+}
+
+func (suite *NotifierServiceSuite) TestDeliverPriceAlert_RetriesPerEndpoint() {
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer failing.Close()
+	broken := models.Webhook{ID: uuid.New(), URL: failing.URL, Secret: "x", Active: true}
+
+	suite.mockStorage.On("ListActiveWebhooks", suite.ctx).
+		Return([]models.Webhook{suite.webhook, broken}, nil)
+
+	suite.expectCreate(2)
+
+	suite.mockStorage.On("UpdateWebhookDelivery", mock.Anything, mock.MatchedBy(func(d *models.WebhookDelivery) bool {
+		return d.WebhookID == suite.webhook.ID && d.Status == models.DeliveryStatusDelivered && d.Attempts == 1
+	})).Return(nil).Once()
+
+	suite.mockStorage.On("UpdateWebhookDelivery", mock.Anything, mock.MatchedBy(func(d *models.WebhookDelivery) bool {
+		return d.WebhookID == broken.ID && d.Status == models.DeliveryStatusFailed && d.Attempts == 3
+	})).Return(nil).Once()
+
+	err := suite.service.DeliverPriceAlert(suite.ctx, suite.alertEvent)
+	suite.service.shutdown()
+
+	suite.NoError(err)
+	suite.Len(suite.requests, 1)
+}
+
+func (suite *NotifierServiceSuite) TestDeliverPriceAlert_DoesNotWaitForSlowEndpoint() {
+	release := make(chan struct{})
+	slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	defer slow.Close()
+	stalled := models.Webhook{ID: uuid.New(), URL: slow.URL, Secret: "x", Active: true}
+
+	suite.mockStorage.On("ListActiveWebhooks", suite.ctx).
+		Return([]models.Webhook{stalled, suite.webhook}, nil)
+
+	suite.expectCreate(2)
+
+	delivered := make(chan struct{})
+	suite.mockStorage.On("UpdateWebhookDelivery", mock.Anything, mock.MatchedBy(func(d *models.WebhookDelivery) bool {
+		return d.WebhookID == suite.webhook.ID && d.Status == models.DeliveryStatusDelivered
+	})).Return(nil).Once().Run(func(mock.Arguments) { close(delivered) })
+
+	suite.mockStorage.On("UpdateWebhookDelivery", mock.Anything, mock.MatchedBy(func(d *models.WebhookDelivery) bool {
+		return d.WebhookID == stalled.ID
+	})).Return(nil).Once()
+
+	err := suite.service.DeliverPriceAlert(suite.ctx, suite.alertEvent)
+	suite.NoError(err)
+
+	select {
+	case <-delivered:
+	case <-time.After(time.Second):
+		suite.Fail("delivery to a healthy webhook waited for the stalled one")
+	}
+
+	close(release)
+	suite.service.shutdown()
+}
+
+func (suite *NotifierServiceSuite) TestDeliverPriceAlert_PostponesWhenQueueFull() {
+	started := make(chan struct{}, 1)
+	release := make(chan struct{})
+	slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case started <- struct{}{}:
+		default:
+		}
+		<-release
+	}))
+	defer slow.Close()
+	stalled := models.Webhook{ID: uuid.New(), URL: slow.URL, Secret: "x", Active: true}
+
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+	suite.service = New(suite.mockStorage, slow.Client(), retry.Policy{MaxAttempts: 1}, QueueConfig{Depth: 1}, log)
+
+	suite.mockStorage.On("ListActiveWebhooks", suite.ctx).
+		Return([]models.Webhook{stalled}, nil)
+
+	suite.expectCreate(1)
+
+	suite.mockStorage.On("UpdateWebhookDelivery", mock.Anything, mock.MatchedBy(func(d *models.WebhookDelivery) bool {
+		return d.Status == models.DeliveryStatusPending && d.Attempts == 0
+	})).Return(nil).Once()
+
+	suite.mockStorage.On("UpdateWebhookDelivery", mock.Anything, mock.MatchedBy(func(d *models.WebhookDelivery) bool {
+		return d.Status == models.DeliveryStatusDelivered
+	})).Return(nil).Twice()
+
+	// первое уведомление занимает воркер, второе ждет в очереди, третье остается в журнале до опроса
+	suite.NoError(suite.service.DeliverPriceAlert(suite.ctx, suite.alertEvent))
+	<-started
+	suite.NoError(suite.service.DeliverPriceAlert(suite.ctx, suite.alertEvent))
+	suite.NoError(suite.service.DeliverPriceAlert(suite.ctx, suite.alertEvent))
+
+	close(release)
+	suite.service.shutdown()
+}
+
+func (suite *NotifierServiceSuite) TestDeliverPriceAlert_StopsIdleWorkers() {
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+	suite.service = New(suite.mockStorage, suite.server.Client(), retry.Policy{MaxAttempts: 1}, QueueConfig{Depth: 1, IdleTimeout: 10 * time.Millisecond}, log)
+
+	suite.mockStorage.On("ListActiveWebhooks", suite.ctx).
+		Return([]models.Webhook{suite.webhook}, nil)
+	suite.expectCreate(1)
+
+	suite.mockStorage.On("UpdateWebhookDelivery", mock.Anything, mock.MatchedBy(func(d *models.WebhookDelivery) bool {
+		return d.Status == models.DeliveryStatusDelivered
+	})).Return(nil).Twice()
+
+	idle := func() bool {
+		suite.service.mu.Lock()
+		defer suite.service.mu.Unlock()
+		return len(suite.service.queues) == 0
+	}
+
+	suite.NoError(suite.service.DeliverPriceAlert(suite.ctx, suite.alertEvent))
+	suite.Eventually(idle, time.Second, time.Millisecond)
+
+	// следующее уведомление запускает новый воркер
+	suite.NoError(suite.service.DeliverPriceAlert(suite.ctx, suite.alertEvent))
+	suite.Eventually(idle, time.Second, time.Millisecond)
+
+	suite.service.shutdown()
+	suite.Len(suite.requests, 2)
+}
+
+func (suite *NotifierServiceSuite) TestDeliverPriceAlert_FailsWhenDeliveriesNotSaved() {
+	suite.mockStorage.On("ListActiveWebhooks", suite.ctx).
+		Return([]models.Webhook{suite.webhook}, nil)
+
+	suite.mockStorage.On("CreateWebhookDeliveries", suite.ctx, mock.Anything, mock.Anything).
+		Return(errors.New("connection refused"))
+
+	err := suite.service.DeliverPriceAlert(suite.ctx, suite.alertEvent)
+	suite.service.shutdown()
+
+	suite.Error(err)
+	suite.Empty(suite.requests)
+}
+
+func (suite *NotifierServiceSuite) TestRun_ResumesPendingDeliveries() {
+	body, err := json.Marshal(suite.alertEvent)
+	suite.Require().NoError(err)
+	delivery := models.WebhookDelivery{
+		ID:        uuid.New(),
+		WebhookID: suite.webhook.ID,
+		AlertID:   suite.alertEvent.AlertID,
+		Status:    models.DeliveryStatusPending,
+		Attempts:  2,
+		Payload:   body,
+	}
+
+	suite.mockStorage.On("ClaimWebhookDeliveries", mock.Anything, _claimBatch, suite.service.lease).
+		Return([]models.PendingDelivery{{Webhook: suite.webhook, Delivery: delivery}}, nil).Once()
+
+	// до перезапуска сделано две попытки из трех - остается одна
+	delivered := make(chan struct{})
+	suite.mockStorage.On("UpdateWebhookDelivery", mock.Anything, mock.MatchedBy(func(d *models.WebhookDelivery) bool {
+		return d.ID == delivery.ID && d.Status == models.DeliveryStatusDelivered && d.Attempts == 3
+	})).Return(nil).Once().Run(func(mock.Arguments) { close(delivered) })
+
+	ctx, cancel := context.WithCancel(suite.ctx)
+	done := make(chan error, 1)
+	go func() { done <- suite.service.Run(ctx) }()
+
+	select {
+	case <-delivered:
+	case <-time.After(time.Second):
+		suite.Fail("pending delivery was not resumed")
+	}
+	cancel()
+
+	suite.ErrorIs(<-done, context.Canceled)
+	suite.Require().Len(suite.requests, 1)
+	suite.Equal(delivery.ID.String(), suite.requests[0].header.Get(HeaderDelivery))
+}
+
+func (suite *NotifierServiceSuite) TestRun_FailsDeliveriesOfInactiveWebhooks() {
+	inactive := suite.webhook
+	inactive.Active = false
+	delivery := models.WebhookDelivery{ID: uuid.New(), WebhookID: inactive.ID, Status: models.DeliveryStatusPending}
+
+	suite.mockStorage.On("ClaimWebhookDeliveries", mock.Anything, _claimBatch, suite.service.lease).
+		Return([]models.PendingDelivery{{Webhook: inactive, Delivery: delivery}}, nil).Once()
+
+	suite.mockStorage.On("UpdateWebhookDelivery", mock.Anything, mock.MatchedBy(func(d *models.WebhookDelivery) bool {
+		return d.ID == delivery.ID && d.Status == models.DeliveryStatusFailed && d.Error == errWebhookInactive.Error()
+	})).Return(nil).Once()
+
+	ctx, cancel := context.WithCancel(suite.ctx)
+	cancel()
+
+	suite.ErrorIs(suite.service.Run(ctx), context.Canceled)
+	suite.Empty(suite.requests)
+}
+
+func (suite *NotifierServiceSuite) TestRun_KeepsUnsentDeliveriesPendingOnStop() {
+	started := make(chan struct{}, 1)
+	slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case started <- struct{}{}:
+		default:
+		}
+		// сервер замечает обрыв соединения только после чтения тела
+		_, _ = io.ReadAll(r.Body)
+		<-r.Context().Done()
+	}))
+	defer slow.Close()
+	stalled := models.Webhook{ID: uuid.New(), URL: slow.URL, Secret: "x", Active: true}
+
+	suite.mockStorage.On("ClaimWebhookDeliveries", mock.Anything, _claimBatch, suite.service.lease).
+		Return(nil, nil).Once()
+
+	suite.mockStorage.On("ListActiveWebhooks", suite.ctx).
+		Return([]models.Webhook{stalled}, nil)
+	suite.expectCreate(1)
+
+	// прерванная отправка и ожидающая в очереди доставка не считаются неудачными
+	suite.mockStorage.On("UpdateWebhookDelivery", mock.Anything, mock.MatchedBy(func(d *models.WebhookDelivery) bool {
+		return d.Status == models.DeliveryStatusPending && d.Attempts == 1
+	})).Return(nil).Once()
+
+	suite.mockStorage.On("UpdateWebhookDelivery", mock.Anything, mock.MatchedBy(func(d *models.WebhookDelivery) bool {
+		return d.Status == models.DeliveryStatusPending && d.Attempts == 0
+	})).Return(nil).Once()
+
+	ctx, cancel := context.WithCancel(suite.ctx)
+	done := make(chan error, 1)
+	go func() { done <- suite.service.Run(ctx) }()
+
+	suite.NoError(suite.service.DeliverPriceAlert(suite.ctx, suite.alertEvent))
+	<-started
+	suite.NoError(suite.service.DeliverPriceAlert(suite.ctx, suite.alertEvent))
+
+	cancel()
+	suite.ErrorIs(<-done, context.Canceled)
+}
+
+func (suite *NotifierServiceSuite) TestDeliverPriceAlert_NoWebhooks() {
+	suite.mockStorage.On("ListActiveWebhooks", suite.ctx).
+		Return(nil, nil)
+
+	err := suite.service.DeliverPriceAlert(suite.ctx, suite.alertEvent)
+
+	suite.NoError(err)
+	suite.Empty(suite.requests)
+}
+
+func (suite *NotifierServiceSuite) TestRegisterWebhook_GeneratesSecret() {
+	suite.mockStorage.On("CreateWebhook", suite.ctx, mock.MatchedBy(func(w *models.Webhook) bool {
+		return w.URL == "https://bot.example.com/alerts" && len(w.Secret) == 64 && w.Active
+	})).Return(nil)
+
+	webhook, err := suite.service.RegisterWebhook(suite.ctx, "https://bot.example.com/alerts", "")
+
+	suite.NoError(err)
+	suite.Len(webhook.Secret, 64)
+}
+
+func (suite *NotifierServiceSuite) TestRegisterWebhook_InvalidURL() {
+	_, err := suite.service.RegisterWebhook(suite.ctx, "ftp://bot.example.com", "")
+
+	suite.ErrorIs(err, models.ErrInvalidWebhook)
+}
+
+func (suite *NotifierServiceSuite) TestListDeliveries_ClampsLimit() {
+	webhookID := uuid.New()
+
+	suite.mockStorage.On("ListWebhookDeliveries", suite.ctx, webhookID, 500).
+		Return([]models.WebhookDelivery{{ID: uuid.New()}}, nil)
+
+	deliveries, err := suite.service.ListDeliveries(suite.ctx, webhookID, 10000)
+
+	suite.NoError(err)
+	suite.Len(deliveries, 1)
+}
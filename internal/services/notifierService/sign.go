@@ -0,0 +1,31 @@
+package notifierservice
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+)
+
+// Заголовки запроса webhook-а.
+const (
+	HeaderEvent     = "X-CS-Parser-Event"
+	HeaderDelivery  = "X-CS-Parser-Delivery"
+	HeaderTimestamp = "X-CS-Parser-Timestamp"
+	HeaderSignature = "X-CS-Parser-Signature"
+)
+
+// Sign вычисляет подпись "sha256=<hex>" от "<timestamp>.<body>" на секрете webhook-а.
+// Временная метка входит в подпись, чтобы получатель мог отбрасывать повторы старых запросов.
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifySignature проверяет подпись за постоянное время.
+func VerifySignature(secret string, timestamp int64, body []byte, signature string) bool {
+	return hmac.Equal([]byte(Sign(secret, timestamp, body)), []byte(signature))
+}
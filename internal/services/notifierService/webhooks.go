@@ -0,0 +1,73 @@
+package notifierservice
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kedr891/cs-parser/internal/models"
+)
+
+const (
+	_defaultDeliveriesLimit = 50
+	_maxDeliveriesLimit     = 500
+)
+
+// RegisterWebhook сохраняет адрес для уведомлений. Если secret не задан, он генерируется;
+// секрет возвращается только в ответе на регистрацию.
+func (s *Service) RegisterWebhook(ctx context.Context, rawURL, secret string) (*models.Webhook, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, fmt.Errorf("%w: url must be an absolute http(s) url", models.ErrInvalidWebhook)
+	}
+
+	if secret == "" {
+		secret, err = generateSecret()
+		if err != nil {
+			return nil, fmt.Errorf("generate secret: %w", err)
+		}
+	}
+
+	webhook := &models.Webhook{
+		ID:        uuid.New(),
+		URL:       u.String(),
+		Secret:    secret,
+		Active:    true,
+		CreatedAt: time.Now().UTC(),
+	}
+
+	if err := s.storage.CreateWebhook(ctx, webhook); err != nil {
+		return nil, fmt.Errorf("create webhook: %w", err)
+	}
+
+	s.log.Info("Webhook registered", "webhook_id", webhook.ID, "url", webhook.URL)
+
+	return webhook, nil
+}
+
+func (s *Service) ListDeliveries(ctx context.Context, webhookID uuid.UUID, limit int) ([]models.WebhookDelivery, error) {
+	if limit <= 0 {
+		limit = _defaultDeliveriesLimit
+	}
+	if limit > _maxDeliveriesLimit {
+		limit = _maxDeliveriesLimit
+	}
+
+	deliveries, err := s.storage.ListWebhookDeliveries(ctx, webhookID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("list webhook deliveries: %w", err)
+	}
+	return deliveries, nil
+}
+
+func generateSecret() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
@@ -0,0 +1,93 @@
+package parserservice
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kedr891/cs-parser/internal/models"
+)
+
+const _discoveryPageSize = 100
+
+type DiscoveryResult struct {
+	Scanned    int
+	Discovered int
+}
+
+func (s *Service) discoveryEnabled() bool {
+	return s.listings != nil && s.discoveryPublisher != nil && s.discoveryPages > 0
+}
+
+// DiscoverOnce публикует skin.discovered для предметов из выдачи маркетплейса, которых нет в каталоге.
+// Сами скины создает consumer, поэтому парсер не пишет в базу напрямую.
+func (s *Service) DiscoverOnce(ctx context.Context) (*DiscoveryResult, error) {
+	if !s.discoveryEnabled() {
+		return nil, fmt.Errorf("discovery is not configured")
+	}
+
+	skins, err := s.storage.GetAllSkins(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get skins catalog: %w", err)
+	}
+
+	known := make(map[string]struct{}, len(skins))
+	for i := range skins {
+		known[skins[i].MarketHashName] = struct{}{}
+	}
+
+	result := &DiscoveryResult{}
+
+	for page := 0; page < s.discoveryPages; page++ {
+		listings, total, err := s.listings.SearchListings(ctx, page*_discoveryPageSize, _discoveryPageSize)
+		if err != nil {
+			return result, fmt.Errorf("search listings: %w", err)
+		}
+
+		for _, listing := range listings {
+			result.Scanned++
+
+			if _, ok := known[listing.MarketHashName]; ok {
+				continue
+			}
+
+			if err := s.publishDiscovered(ctx, &listing); err != nil {
+				return result, err
+			}
+			known[listing.MarketHashName] = struct{}{}
+			result.Discovered++
+		}
+
+		if len(listings) < _discoveryPageSize || (page+1)*_discoveryPageSize >= total {
+			break
+		}
+	}
+
+	return result, nil
+}
+
+func (s *Service) publishDiscovered(ctx context.Context, listing *models.MarketListing) error {
+	weapon, name, quality := models.ParseMarketHashName(listing.MarketHashName)
+
+	event := models.NewSkinDiscoveredEvent(
+		listing.MarketHashName,
+		name,
+		weapon,
+		quality,
+		listing.Rarity,
+		listing.Price,
+		string(s.listings.Source()),
+		listing.ImageURL,
+	)
+	event.Currency = listing.Currency
+
+	if err := s.discoveryPublisher.PublishSkinDiscovered(ctx, event); err != nil {
+		return fmt.Errorf("publish skin discovered: %w", err)
+	}
+
+	s.log.Info("New market listing discovered",
+		"market_hash_name", listing.MarketHashName,
+		"price", listing.Price,
+	)
+
+	return nil
+}
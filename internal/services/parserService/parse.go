@@ -0,0 +1,151 @@
+package parserservice
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/kedr891/cs-parser/internal/models"
+)
+
+type ParseResult struct {
+	Total     int
+	Published int
+	Failed    int
+}
+
+// Run выполняет проход по каталогу сразу при старте и далее по расписанию.
+func (s *Service) Run(ctx context.Context) error {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		result, err := s.ParseOnce(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			s.log.Error("Parser run failed", "error", err)
+		} else {
+			s.log.Info("Parser run finished",
+				"total", result.Total,
+				"published", result.Published,
+				"failed", result.Failed,
+			)
+		}
+
+		if s.discoveryEnabled() {
+			discovery, err := s.DiscoverOnce(ctx)
+			if err != nil {
+				if ctx.Err() != nil {
+					return ctx.Err()
+				}
+				s.log.Error("Discovery run failed", "error", err)
+			} else {
+				s.log.Info("Discovery run finished",
+					"scanned", discovery.Scanned,
+					"discovered", discovery.Discovered,
+				)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// ParseOnce обходит каталог всеми провайдерами параллельно: у каждого маркетплейса
+// свой лимит запросов, поэтому медленный источник не задерживает остальные.
+func (s *Service) ParseOnce(ctx context.Context) (*ParseResult, error) {
+	skins, err := s.storage.GetAllSkins(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get skins catalog: %w", err)
+	}
+
+	results := make([]ParseResult, len(s.providers))
+
+	var wg sync.WaitGroup
+	for i, provider := range s.providers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i] = s.parseProvider(ctx, provider, skins)
+		}()
+	}
+	wg.Wait()
+
+	result := &ParseResult{}
+	for _, r := range results {
+		result.Total += r.Total
+		result.Published += r.Published
+		result.Failed += r.Failed
+	}
+
+	if err := ctx.Err(); err != nil {
+		return result, err
+	}
+
+	return result, nil
+}
+
+func (s *Service) parseProvider(ctx context.Context, provider PriceProvider, skins []models.Skin) ParseResult {
+	result := ParseResult{}
+
+	for i := range skins {
+		if ctx.Err() != nil {
+			return result
+		}
+		result.Total++
+
+		if err := s.parseSkin(ctx, provider, &skins[i]); err != nil {
+			if ctx.Err() != nil {
+				return result
+			}
+			s.log.Warn("Failed to parse skin price",
+				"source", provider.Source(),
+				"market_hash_name", skins[i].MarketHashName,
+				"error", err,
+			)
+			result.Failed++
+			continue
+		}
+		result.Published++
+	}
+
+	s.log.Info("Provider pass finished",
+		"source", provider.Source(),
+		"published", result.Published,
+		"failed", result.Failed,
+	)
+
+	return result
+}
+
+func (s *Service) parseSkin(ctx context.Context, provider PriceProvider, skin *models.Skin) error {
+	price, err := provider.FetchPrice(ctx, skin.MarketHashName)
+	if err != nil {
+		return fmt.Errorf("fetch price: %w", err)
+	}
+
+	event := models.NewPriceUpdateEvent(
+		skin.ID,
+		skin.Slug,
+		skin.MarketHashName,
+		string(price.Source),
+		skin.CurrentPrice,
+		price.Price,
+		price.Volume,
+	)
+	event.Currency = price.Currency
+	event.Weapon = skin.Weapon
+
+	if err := s.publisher.PublishPriceUpdate(ctx, event); err != nil {
+		return fmt.Errorf("publish price update: %w", err)
+	}
+
+	return nil
+}
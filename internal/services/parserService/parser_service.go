@@ -0,0 +1,68 @@
+package parserservice
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/kedr891/cs-parser/internal/models"
+)
+
+type SkinStorage interface {
+	GetAllSkins(ctx context.Context) ([]models.Skin, error)
+}
+
+type PriceProvider interface {
+	Source() models.PriceSource
+	FetchPrice(ctx context.Context, marketHashName string) (*models.MarketPrice, error)
+}
+
+type PriceUpdatePublisher interface {
+	PublishPriceUpdate(ctx context.Context, event *models.PriceUpdateEvent) error
+}
+
+type ListingSource interface {
+	Source() models.PriceSource
+	SearchListings(ctx context.Context, start, count int) ([]models.MarketListing, int, error)
+}
+
+type SkinDiscoveredPublisher interface {
+	PublishSkinDiscovered(ctx context.Context, event *models.SkinDiscoveredEvent) error
+}
+
+type Service struct {
+	storage   SkinStorage
+	providers []PriceProvider
+	publisher PriceUpdatePublisher
+	interval  time.Duration
+	log       *slog.Logger
+
+	listings           ListingSource
+	discoveryPublisher SkinDiscoveredPublisher
+	discoveryPages     int
+}
+
+func New(
+	storage SkinStorage,
+	providers []PriceProvider,
+	publisher PriceUpdatePublisher,
+	interval time.Duration,
+	log *slog.Logger,
+) *Service {
+	return &Service{
+		storage:   storage,
+		providers: providers,
+		publisher: publisher,
+		interval:  interval,
+		log:       log,
+	}
+}
+
+// WithDiscovery включает поиск новых предметов: после каждого прохода по каталогу
+// парсер просматривает pages страниц выдачи маркетплейса и публикует неизвестные предметы.
+func (s *Service) WithDiscovery(listings ListingSource, publisher SkinDiscoveredPublisher, pages int) *Service {
+	s.listings = listings
+	s.discoveryPublisher = publisher
+	s.discoveryPages = pages
+	return s
+}
@@ -0,0 +1,184 @@
+package parserservice
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kedr891/cs-parser/internal/models"
+	"github.com/kedr891/cs-parser/internal/services/parserService/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type ParserServiceSuite struct {
+	suite.Suite
+	ctx           context.Context
+	service       *Service
+	mockStorage   *mocks.MockSkinStorage
+	mockSteam     *mocks.MockPriceProvider
+	mockSkinport  *mocks.MockPriceProvider
+	mockPublisher *mocks.MockPriceUpdatePublisher
+	mockListings  *mocks.MockListingSource
+	mockDiscovery *mocks.MockSkinDiscoveredPublisher
+}
+
+func (suite *ParserServiceSuite) SetupTest() {
+	suite.ctx = context.Background()
+	suite.mockStorage = mocks.NewMockSkinStorage(suite.T())
+	suite.mockSteam = mocks.NewMockPriceProvider(suite.T())
+	suite.mockSteam.On("Source").Return(models.SourceSteamMarket).Maybe()
+	suite.mockSkinport = mocks.NewMockPriceProvider(suite.T())
+	suite.mockSkinport.On("Source").Return(models.SourceSkinport).Maybe()
+	suite.mockPublisher = mocks.NewMockPriceUpdatePublisher(suite.T())
+	suite.mockListings = mocks.NewMockListingSource(suite.T())
+	suite.mockListings.On("Source").Return(models.SourceSteamMarket).Maybe()
+	suite.mockDiscovery = mocks.NewMockSkinDiscoveredPublisher(suite.T())
+	log := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelError}))
+	suite.service = New(
+		suite.mockStorage,
+		[]PriceProvider{suite.mockSteam, suite.mockSkinport},
+		suite.mockPublisher,
+		time.Minute,
+		log,
+	)
+}
+
+func TestParserServiceSuite(t *testing.T) {
+	suite.Run(t, new(ParserServiceSuite))
+}
+
+func (suite *ParserServiceSuite) TestParseOnce_PublishesPriceUpdatesPerSource() {
+	skin := models.Skin{
+		ID:             uuid.New(),
+		Slug:           "ak_47_redline_ft",
+		MarketHashName: "AK-47 | Redline (Field-Tested)",
+		Weapon:         "AK-47",
+		CurrentPrice:   10,
+	}
+
+	suite.mockStorage.On("GetAllSkins", suite.ctx).
+		Return([]models.Skin{skin}, nil)
+
+	suite.mockSteam.On("FetchPrice", suite.ctx, skin.MarketHashName).
+		Return(&models.MarketPrice{
+			MarketHashName: skin.MarketHashName,
+			Source:         models.SourceSteamMarket,
+			Price:          12,
+			Currency:       "USD",
+			Volume:         150,
+		}, nil)
+
+	suite.mockSkinport.On("FetchPrice", suite.ctx, skin.MarketHashName).
+		Return(&models.MarketPrice{
+			MarketHashName: skin.MarketHashName,
+			Source:         models.SourceSkinport,
+			Price:          11,
+			Currency:       "USD",
+			Volume:         40,
+		}, nil)
+
+	suite.mockPublisher.On("PublishPriceUpdate", suite.ctx, mock.MatchedBy(func(event *models.PriceUpdateEvent) bool {
+		return event.SkinID == skin.ID &&
+			event.Slug == skin.Slug &&
+			event.Source == string(models.SourceSteamMarket) &&
+			event.OldPrice == 10 &&
+			event.NewPrice == 12 &&
+			event.Volume24h == 150 &&
+			event.PriceChange == 20
+	})).
+		Return(nil).
+		Once()
+
+	suite.mockPublisher.On("PublishPriceUpdate", suite.ctx, mock.MatchedBy(func(event *models.PriceUpdateEvent) bool {
+		return event.SkinID == skin.ID &&
+			event.Source == string(models.SourceSkinport) &&
+			event.NewPrice == 11 &&
+			event.Volume24h == 40
+	})).
+		Return(nil).
+		Once()
+
+	result, err := suite.service.ParseOnce(suite.ctx)
+
+	suite.NoError(err)
+	suite.Equal(&ParseResult{Total: 2, Published: 2}, result)
+}
+
+func (suite *ParserServiceSuite) TestParseOnce_SkipsFailedFetch() {
+	skins := []models.Skin{
+		{ID: uuid.New(), MarketHashName: "Unknown Item"},
+		{ID: uuid.New(), MarketHashName: "AWP | Asiimov (Field-Tested)"},
+	}
+
+	suite.mockStorage.On("GetAllSkins", suite.ctx).
+		Return(skins, nil)
+
+	suite.mockSteam.On("FetchPrice", suite.ctx, "Unknown Item").
+		Return(nil, errors.New("price not found"))
+
+	suite.mockSteam.On("FetchPrice", suite.ctx, "AWP | Asiimov (Field-Tested)").
+		Return(&models.MarketPrice{Source: models.SourceSteamMarket, Price: 100, Currency: "USD"}, nil)
+
+	suite.mockSkinport.On("FetchPrice", suite.ctx, mock.Anything).
+		Return(nil, errors.New("rate limited by marketplace"))
+
+	suite.mockPublisher.On("PublishPriceUpdate", suite.ctx, mock.AnythingOfType("*models.PriceUpdateEvent")).
+		Return(nil).
+		Once()
+
+	result, err := suite.service.ParseOnce(suite.ctx)
+
+	suite.NoError(err)
+	suite.Equal(&ParseResult{Total: 4, Published: 1, Failed: 3}, result)
+}
+
+func (suite *ParserServiceSuite) TestParseOnce_StorageError() {
+	suite.mockStorage.On("GetAllSkins", suite.ctx).
+		Return(nil, errors.New("database connection failed"))
+
+	result, err := suite.service.ParseOnce(suite.ctx)
+
+	suite.Error(err)
+	suite.Nil(result)
+	suite.Contains(err.Error(), "get skins catalog")
+}
+
+func (suite *ParserServiceSuite) TestDiscoverOnce_PublishesUnknownListings() {
+	suite.service.WithDiscovery(suite.mockListings, suite.mockDiscovery, 5)
+
+	suite.mockStorage.On("GetAllSkins", suite.ctx).
+		Return([]models.Skin{{ID: uuid.New(), MarketHashName: "AK-47 | Redline (Field-Tested)"}}, nil)
+
+	suite.mockListings.On("SearchListings", suite.ctx, 0, 100).
+		Return([]models.MarketListing{
+			{MarketHashName: "AK-47 | Redline (Field-Tested)", Price: 12.9, Currency: "USD"},
+			{MarketHashName: "Kilowatt Case", Rarity: "Base Grade", Price: 1.12, Currency: "USD"},
+		}, 2, nil)
+
+	suite.mockDiscovery.On("PublishSkinDiscovered", suite.ctx, mock.MatchedBy(func(event *models.SkinDiscoveredEvent) bool {
+		return event.MarketHashName == "Kilowatt Case" &&
+			event.Weapon == "Case" &&
+			event.Rarity == "Base Grade" &&
+			event.InitialPrice == 1.12 &&
+			event.Source == string(models.SourceSteamMarket)
+	})).
+		Return(nil).
+		Once()
+
+	result, err := suite.service.DiscoverOnce(suite.ctx)
+
+	suite.NoError(err)
+	suite.Equal(&DiscoveryResult{Scanned: 2, Discovered: 1}, result)
+}
+
+func (suite *ParserServiceSuite) TestDiscoverOnce_NotConfigured() {
+	result, err := suite.service.DiscoverOnce(suite.ctx)
+
+	suite.Error(err)
+	suite.Nil(result)
+}
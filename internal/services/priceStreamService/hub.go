@@ -0,0 +1,151 @@
+package pricestreamservice
+
+import (
+	"context"
+	"sync"
+
+	"github.com/kedr891/cs-parser/internal/models"
+)
+
+type HubConfig struct {
+	// HistorySize - сколько последних событий хранится для продолжения потока после номера события
+	HistorySize int
+	// SubscriberBuffer - сколько событий может ждать отправки одному подписчику
+	SubscriberBuffer int
+}
+
+// Hub раздает события ленты рынка подписчикам WatchPrices, SSE и WebSocket внутри процесса.
+// Подписчик, у которого переполнился буфер, отключается с models.ErrSlowSubscriber,
+// чтобы медленный клиент не задерживал остальных.
+type Hub struct {
+	cfg HubConfig
+
+	mu          sync.Mutex
+	subscribers map[*subscriber]struct{}
+	history     []*models.FeedEvent
+	next        int
+	// trending - последний список трендов, отдается новым подписчикам сразу после подключения
+	trending *models.FeedEvent
+}
+
+type subscriber struct {
+	filter  *models.FeedFilter
+	events  chan *models.FeedEvent
+	evicted chan struct{}
+}
+
+func NewHub(cfg HubConfig) *Hub {
+	if cfg.HistorySize <= 0 {
+		cfg.HistorySize = 10000
+	}
+	if cfg.SubscriberBuffer <= 0 {
+		cfg.SubscriberBuffer = 256
+	}
+
+	return &Hub{
+		cfg:         cfg,
+		subscribers: make(map[*subscriber]struct{}),
+		history:     make([]*models.FeedEvent, 0, cfg.HistorySize),
+	}
+}
+
+// Broadcast сохраняет событие и рассылает его подписчикам с подходящим фильтром.
+// Цены и правила попадают в историю, от трендов хранится только последний список.
+func (h *Hub) Broadcast(event *models.FeedEvent) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if event.Type == models.FeedEventTrending {
+		h.trending = event
+	} else {
+		if len(h.history) < h.cfg.HistorySize {
+			h.history = append(h.history, event)
+		} else {
+			h.history[h.next] = event
+		}
+		h.next = (h.next + 1) % h.cfg.HistorySize
+	}
+
+	for sub := range h.subscribers {
+		if !sub.filter.Match(event) {
+			continue
+		}
+		select {
+		case sub.events <- event:
+		default:
+			delete(h.subscribers, sub)
+			close(sub.evicted)
+		}
+	}
+}
+
+// Watch отправляет через send последний список трендов (если подписчик их ждет), события
+// из истории с номером больше after (если after не нулевой), затем новые события, пока не отменен ctx,
+// send не вернул ошибку или подписчик не отключен.
+func (h *Hub) Watch(ctx context.Context, filter *models.FeedFilter, after uint64, send func(*models.FeedEvent) error) error {
+	h.mu.Lock()
+	var replay []*models.FeedEvent
+	if h.trending != nil && filter.Match(h.trending) {
+		replay = append(replay, h.trending)
+	}
+	if after > 0 {
+		replay = append(replay, h.historyAfter(filter, after)...)
+	}
+
+	// Повтор кладется в буфер до регистрации: так он не теряет события, пришедшие во время отправки,
+	// и не вытесняет подписчика, даже если длиннее SubscriberBuffer.
+	sub := &subscriber{
+		filter:  filter,
+		events:  make(chan *models.FeedEvent, len(replay)+h.cfg.SubscriberBuffer),
+		evicted: make(chan struct{}),
+	}
+	for _, event := range replay {
+		sub.events <- event
+	}
+	h.subscribers[sub] = struct{}{}
+	h.mu.Unlock()
+
+	defer h.unsubscribe(sub)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-sub.evicted:
+			return models.ErrSlowSubscriber
+		case event := <-sub.events:
+			if err := send(event); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// Subscribers - число активных подписчиков.
+func (h *Hub) Subscribers() int {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return len(h.subscribers)
+}
+
+func (h *Hub) unsubscribe(sub *subscriber) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	delete(h.subscribers, sub)
+}
+
+// historyAfter возвращает события в порядке поступления, он же порядок номеров. Вызывается под h.mu.
+func (h *Hub) historyAfter(filter *models.FeedFilter, after uint64) []*models.FeedEvent {
+	var result []*models.FeedEvent
+	start := 0
+	if len(h.history) == h.cfg.HistorySize {
+		start = h.next
+	}
+	for i := 0; i < len(h.history); i++ {
+		event := h.history[(start+i)%len(h.history)]
+		if event.Seq > after && filter.Match(event) {
+			result = append(result, event)
+		}
+	}
+	return result
+}
@@ -0,0 +1,195 @@
+package pricestreamservice
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kedr891/cs-parser/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func tick(slug, weapon string, ts time.Time) *models.FeedEvent {
+	return models.NewPriceFeedEvent(&models.PriceUpdateEvent{Slug: slug, Weapon: weapon, NewPrice: 1, Timestamp: ts})
+}
+
+func seqTick(seq uint64, ts time.Time) *models.FeedEvent {
+	event := tick("s", "AK-47", ts)
+	event.Seq = seq
+	return event
+}
+
+func priceFilter(t *testing.T, all bool, slugs, weapons []string) *models.FeedFilter {
+	t.Helper()
+	filter, err := models.NewFeedFilter([]string{models.FeedEventPrice}, all, slugs, weapons)
+	require.NoError(t, err)
+	return filter
+}
+
+func waitSubscribers(t *testing.T, hub *Hub, n int) {
+	t.Helper()
+	require.Eventually(t, func() bool { return hub.Subscribers() == n }, time.Second, time.Millisecond)
+}
+
+func TestHub_DeliversMatchingEvents(t *testing.T) {
+	hub := NewHub(HubConfig{HistorySize: 10, SubscriberBuffer: 10})
+	filter := priceFilter(t, false, []string{"ak_47_redline_ft"}, []string{"AWP"})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	received := make(chan string, 10)
+	done := make(chan error, 1)
+	go func() {
+		done <- hub.Watch(ctx, filter, 0, func(event *models.FeedEvent) error {
+			received <- event.Price.Slug
+			return nil
+		})
+	}()
+	waitSubscribers(t, hub, 1)
+
+	now := time.Now()
+	hub.Broadcast(tick("ak_47_redline_ft", "AK-47", now))
+	hub.Broadcast(tick("m4a4_howl_fn", "M4A4", now))
+	hub.Broadcast(tick("awp_asiimov_ft", "AWP", now))
+
+	assert.Equal(t, "ak_47_redline_ft", <-received)
+	assert.Equal(t, "awp_asiimov_ft", <-received)
+
+	cancel()
+	assert.ErrorIs(t, <-done, context.Canceled)
+	assert.Equal(t, 0, hub.Subscribers())
+}
+
+func TestHub_ReplaysHistoryAfterSeq(t *testing.T) {
+	hub := NewHub(HubConfig{HistorySize: 3, SubscriberBuffer: 10})
+	base := time.Now()
+	for seq := uint64(1); seq <= 5; seq++ {
+		hub.Broadcast(seqTick(seq, base))
+	}
+
+	filter := priceFilter(t, true, nil, nil)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var replayed []uint64
+	stop := errors.New("stop")
+	err := hub.Watch(ctx, filter, 3, func(event *models.FeedEvent) error {
+		replayed = append(replayed, event.Seq)
+		if len(replayed) == 2 {
+			return stop
+		}
+		return nil
+	})
+
+	assert.ErrorIs(t, err, stop)
+	assert.Equal(t, []uint64{4, 5}, replayed)
+}
+
+func TestHub_ReplaysLateEventsWithOlderTimestamps(t *testing.T) {
+	hub := NewHub(HubConfig{HistorySize: 10, SubscriberBuffer: 10})
+	base := time.Now()
+	hub.Broadcast(seqTick(1, base))
+	hub.Broadcast(seqTick(2, base.Add(time.Second)))
+	// событие из DLQ приходит позже, но со старой меткой времени
+	hub.Broadcast(seqTick(3, base.Add(-time.Minute)))
+
+	filter := priceFilter(t, true, nil, nil)
+	var replayed []uint64
+	stop := errors.New("stop")
+	err := hub.Watch(context.Background(), filter, 2, func(event *models.FeedEvent) error {
+		replayed = append(replayed, event.Seq)
+		return stop
+	})
+
+	assert.ErrorIs(t, err, stop)
+	assert.Equal(t, []uint64{3}, replayed)
+}
+
+func TestHub_ReplayLongerThanBufferDoesNotEvict(t *testing.T) {
+	hub := NewHub(HubConfig{HistorySize: 10, SubscriberBuffer: 1})
+	for seq := uint64(1); seq <= 6; seq++ {
+		hub.Broadcast(seqTick(seq, time.Now()))
+	}
+
+	filter := priceFilter(t, true, nil, nil)
+	var replayed int
+	stop := errors.New("stop")
+	err := hub.Watch(context.Background(), filter, 1, func(event *models.FeedEvent) error {
+		replayed++
+		if replayed == 5 {
+			return stop
+		}
+		return nil
+	})
+
+	assert.ErrorIs(t, err, stop)
+	assert.Equal(t, 5, replayed)
+}
+
+func TestHub_EvictsSlowSubscriber(t *testing.T) {
+	hub := NewHub(HubConfig{HistorySize: 10, SubscriberBuffer: 1})
+	filter := priceFilter(t, true, nil, nil)
+
+	block := make(chan struct{})
+	done := make(chan error, 1)
+	go func() {
+		done <- hub.Watch(context.Background(), filter, 0, func(event *models.FeedEvent) error {
+			<-block
+			return nil
+		})
+	}()
+	waitSubscribers(t, hub, 1)
+
+	// первое событие забирает отправка, второе ложится в буфер, третье не помещается
+	hub.Broadcast(tick("a", "AK-47", time.Now()))
+	require.Eventually(t, func() bool {
+		hub.Broadcast(tick("b", "AK-47", time.Now()))
+		return hub.Subscribers() == 0
+	}, time.Second, time.Millisecond)
+
+	close(block)
+	assert.ErrorIs(t, <-done, models.ErrSlowSubscriber)
+}
+
+func TestHub_SendsLatestTrendingOnConnect(t *testing.T) {
+	hub := NewHub(HubConfig{HistorySize: 10, SubscriberBuffer: 10})
+	hub.Broadcast(models.NewTrendingFeedEvent([]models.Skin{{Slug: "old"}}))
+	hub.Broadcast(models.NewTrendingFeedEvent([]models.Skin{{Slug: "new"}}))
+	hub.Broadcast(tick("s", "AK-47", time.Now()))
+
+	filter, err := models.NewFeedFilter([]string{models.FeedEventTrending}, true, nil, nil)
+	require.NoError(t, err)
+
+	var got *models.FeedEvent
+	stop := errors.New("stop")
+	err = hub.Watch(context.Background(), filter, 0, func(event *models.FeedEvent) error {
+		got = event
+		return stop
+	})
+
+	assert.ErrorIs(t, err, stop)
+	require.NotNil(t, got)
+	assert.Equal(t, models.FeedEventTrending, got.Type)
+	assert.Equal(t, "new", got.Trending[0].Slug)
+}
+
+func TestFeedFilter_MatchesAlertsByWeapon(t *testing.T) {
+	filter, err := models.NewFeedFilter([]string{models.FeedEventAlert}, false, nil, []string{"AWP"})
+	require.NoError(t, err)
+
+	awp := models.NewAlertFeedEvent(&models.PriceAlertEvent{Slug: "awp_asiimov_ft", MarketHashName: "AWP | Asiimov (Field-Tested)"})
+	ak := models.NewAlertFeedEvent(&models.PriceAlertEvent{Slug: "ak_47_redline_ft", MarketHashName: "AK-47 | Redline (Field-Tested)"})
+
+	assert.True(t, filter.Match(awp))
+	assert.False(t, filter.Match(ak))
+	assert.False(t, filter.Match(tick("awp_asiimov_ft", "AWP", time.Now())))
+}
+
+func TestNewFeedFilter_RequiresSelection(t *testing.T) {
+	_, err := models.NewFeedFilter([]string{models.FeedEventPrice}, false, nil, nil)
+	assert.ErrorIs(t, err, models.ErrInvalidFeedFilter)
+
+	_, err = models.NewFeedFilter([]string{"volume"}, true, nil, nil)
+	assert.ErrorIs(t, err, models.ErrInvalidFeedFilter)
+}
@@ -0,0 +1,131 @@
+package pricestreamservice
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/kedr891/cs-parser/internal/models"
+)
+
+const (
+	_resubscribeInitialBackoff = time.Second
+	_resubscribeMaxBackoff     = 30 * time.Second
+)
+
+// RedisBroker связывает хабы всех реплик API: обработанное обновление цены или сработавшее
+// правило публикуется в канал Redis, а каждая реплика читает канал и раздает событие своим подписчикам.
+// Kafka отдает событие только одной реплике, поэтому локальной рассылки недостаточно.
+type RedisBroker struct {
+	client  *redis.Client
+	channel string
+	hub     *Hub
+	log     *slog.Logger
+
+	initialBackoff time.Duration
+	maxBackoff     time.Duration
+}
+
+func NewRedisBroker(client *redis.Client, channel string, hub *Hub, log *slog.Logger) *RedisBroker {
+	return &RedisBroker{
+		client:         client,
+		channel:        channel,
+		hub:            hub,
+		log:            log,
+		initialBackoff: _resubscribeInitialBackoff,
+		maxBackoff:     _resubscribeMaxBackoff,
+	}
+}
+
+func (b *RedisBroker) PublishPriceTick(ctx context.Context, event *models.PriceUpdateEvent) error {
+	if err := b.publish(ctx, models.NewPriceFeedEvent(event)); err != nil {
+		return fmt.Errorf("publish price tick: %w", err)
+	}
+	return nil
+}
+
+func (b *RedisBroker) PublishPriceAlert(ctx context.Context, event *models.PriceAlertEvent) error {
+	if err := b.publish(ctx, models.NewAlertFeedEvent(event)); err != nil {
+		return fmt.Errorf("publish price alert: %w", err)
+	}
+	return nil
+}
+
+// publishScript назначает событию следующий номер и публикует его одной операцией Redis,
+// поэтому все реплики получают события в порядке номеров. Номер дописывается первым полем JSON.
+var publishScript = redis.NewScript(`
+local seq = redis.call('INCR', KEYS[1])
+redis.call('PUBLISH', ARGV[1], '{"seq":' .. seq .. ',' .. string.sub(ARGV[2], 2))
+return seq
+`)
+
+func (b *RedisBroker) publish(ctx context.Context, event *models.FeedEvent) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("marshal feed event: %w", err)
+	}
+	return publishScript.Run(ctx, b.client, []string{b.channel + ":seq"}, b.channel, data).Err()
+}
+
+// Run читает канал и передает события в хаб, пока не отменен ctx. Если подписка не удалась
+// или канал закрылся, Run подписывается заново с экспоненциальной задержкой.
+func (b *RedisBroker) Run(ctx context.Context) error {
+	backoff := b.initialBackoff
+	for {
+		subscribed, err := b.receive(ctx)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if subscribed {
+			backoff = b.initialBackoff
+		}
+
+		b.log.Error("Price stream subscription lost", "channel", b.channel, "error", err, "backoff", backoff)
+
+		timer := time.NewTimer(backoff)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+
+		backoff = min(backoff*2, b.maxBackoff)
+	}
+}
+
+// receive подписывается на канал и раздает события, пока подписка жива.
+// subscribed - удалось ли подписаться: после этого задержка переподключения сбрасывается.
+func (b *RedisBroker) receive(ctx context.Context) (subscribed bool, err error) {
+	pubsub := b.client.Subscribe(ctx, b.channel)
+	defer pubsub.Close()
+
+	if _, err := pubsub.Receive(ctx); err != nil {
+		return false, fmt.Errorf("subscribe to %s: %w", b.channel, err)
+	}
+
+	b.log.Info("Price stream subscribed", "channel", b.channel)
+
+	messages := pubsub.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			return true, ctx.Err()
+		case msg, ok := <-messages:
+			if !ok {
+				return true, fmt.Errorf("price stream channel closed")
+			}
+
+			var event models.FeedEvent
+			if err := json.Unmarshal([]byte(msg.Payload), &event); err != nil || !event.Valid() {
+				b.log.Warn("Invalid feed event", "error", err)
+				continue
+			}
+			b.hub.Broadcast(&event)
+		}
+	}
+}
@@ -0,0 +1,97 @@
+package pricestreamservice
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kedr891/cs-parser/internal/models"
+)
+
+func TestRedisBroker_ResubscribesAfterConnectionLoss(t *testing.T) {
+	server := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: server.Addr(), MaxRetries: -1})
+	defer client.Close()
+
+	hub := NewHub(HubConfig{HistorySize: 10, SubscriberBuffer: 10})
+	broker := NewRedisBroker(client, "prices:ticks", hub, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	broker.initialBackoff = 10 * time.Millisecond
+	broker.maxBackoff = 20 * time.Millisecond
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- broker.Run(ctx) }()
+
+	received := make(chan string, 10)
+	go func() {
+		_ = hub.Watch(ctx, priceFilter(t, true, nil, nil), 0, func(event *models.FeedEvent) error {
+			received <- event.Price.Slug
+			return nil
+		})
+	}()
+
+	publish := func(slug string) func() bool {
+		return func() bool {
+			_ = broker.PublishPriceTick(ctx, &models.PriceUpdateEvent{Slug: slug, Timestamp: time.Now()})
+			select {
+			case got := <-received:
+				return got == slug
+			case <-time.After(10 * time.Millisecond):
+				return false
+			}
+		}
+	}
+
+	require.Eventually(t, publish("before"), time.Second, time.Millisecond)
+
+	server.Close()
+	require.NoError(t, server.Restart())
+
+	require.Eventually(t, publish("after"), 2*time.Second, time.Millisecond)
+
+	cancel()
+	assert.ErrorIs(t, <-done, context.Canceled)
+}
+
+func TestRedisBroker_NumbersEventsInPublishOrder(t *testing.T) {
+	server := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: server.Addr()})
+	defer client.Close()
+
+	hub := NewHub(HubConfig{HistorySize: 10, SubscriberBuffer: 10})
+	broker := NewRedisBroker(client, "prices:ticks", hub, slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() { _ = broker.Run(ctx) }()
+
+	received := make(chan *models.FeedEvent, 10)
+	go func() {
+		_ = hub.Watch(ctx, priceFilter(t, true, nil, nil), 0, func(event *models.FeedEvent) error {
+			received <- event
+			return nil
+		})
+	}()
+	waitSubscribers(t, hub, 1)
+	require.Eventually(t, func() bool { return len(server.PubSubChannels("")) == 1 }, time.Second, time.Millisecond)
+
+	// метки времени идут в обратном порядке, номера - в порядке публикации
+	now := time.Now()
+	require.NoError(t, broker.PublishPriceTick(ctx, &models.PriceUpdateEvent{Slug: "a", Timestamp: now}))
+	require.NoError(t, broker.PublishPriceTick(ctx, &models.PriceUpdateEvent{Slug: "b", Timestamp: now}))
+	require.NoError(t, broker.PublishPriceAlert(ctx, &models.PriceAlertEvent{Slug: "c", TriggeredAt: now.Add(-time.Hour)}))
+
+	first, second := <-received, <-received
+	assert.Equal(t, uint64(1), first.Seq)
+	assert.Equal(t, "a", first.Price.Slug)
+	assert.Equal(t, uint64(2), second.Seq)
+	assert.Equal(t, "b", second.Price.Slug)
+	assert.Equal(t, "3", client.Get(ctx, "prices:ticks:seq").Val())
+}
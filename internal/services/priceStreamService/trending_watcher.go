@@ -0,0 +1,79 @@
+package pricestreamservice
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/kedr891/cs-parser/internal/models"
+)
+
+type trendingSource interface {
+	GetTrending(ctx context.Context, period string, limit int) ([]models.Skin, error)
+}
+
+// TrendingWatcher периодически запрашивает тренды и рассылает подписчикам ленты новый список,
+// когда меняется состав или порядок скинов. Тренды считаются на каждой реплике по общему
+// кэшу аналитики, поэтому через Redis они не публикуются.
+type TrendingWatcher struct {
+	source   trendingSource
+	hub      *Hub
+	period   string
+	limit    int
+	interval time.Duration
+	log      *slog.Logger
+
+	last []string
+}
+
+func NewTrendingWatcher(source trendingSource, hub *Hub, period string, limit int, interval time.Duration, log *slog.Logger) *TrendingWatcher {
+	return &TrendingWatcher{
+		source:   source,
+		hub:      hub,
+		period:   period,
+		limit:    limit,
+		interval: interval,
+		log:      log,
+	}
+}
+
+// Run проверяет тренды сразу при старте и далее с заданным интервалом.
+func (w *TrendingWatcher) Run(ctx context.Context) error {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := w.check(ctx); err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			w.log.Warn("Trending watcher failed", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func (w *TrendingWatcher) check(ctx context.Context) error {
+	skins, err := w.source.GetTrending(ctx, w.period, w.limit)
+	if err != nil {
+		return err
+	}
+
+	slugs := make([]string, len(skins))
+	for i := range skins {
+		slugs[i] = skins[i].Slug
+	}
+	if slices.Equal(slugs, w.last) {
+		return nil
+	}
+
+	w.last = slugs
+	w.hub.Broadcast(models.NewTrendingFeedEvent(skins))
+	return nil
+}
@@ -0,0 +1,18 @@
+package pricealertprocessor
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/kedr891/cs-parser/internal/models"
+)
+
+// Handle отдает сработавшее правило в живую ленту и доставляет его по вебхукам.
+// Лента получает событие до доставки, чтобы медленные вебхуки и их повторы не задерживали ее.
+func (p *PriceAlertProcessor) Handle(ctx context.Context, event *models.PriceAlertEvent) error {
+	if err := p.priceAlertPublisher.PublishPriceAlert(ctx, event); err != nil {
+		slog.Warn("Failed to publish price alert", "alert_id", event.AlertID, "error", err)
+	}
+
+	return p.notifierService.DeliverPriceAlert(ctx, event)
+}
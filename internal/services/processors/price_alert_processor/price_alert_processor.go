@@ -0,0 +1,27 @@
+package pricealertprocessor
+
+import (
+	"context"
+
+	"github.com/kedr891/cs-parser/internal/models"
+)
+
+type notifierService interface {
+	DeliverPriceAlert(ctx context.Context, event *models.PriceAlertEvent) error
+}
+
+type priceAlertPublisher interface {
+	PublishPriceAlert(ctx context.Context, event *models.PriceAlertEvent) error
+}
+
+type PriceAlertProcessor struct {
+	notifierService     notifierService
+	priceAlertPublisher priceAlertPublisher
+}
+
+func NewPriceAlertProcessor(notifierService notifierService, priceAlertPublisher priceAlertPublisher) *PriceAlertProcessor {
+	return &PriceAlertProcessor{
+		notifierService:     notifierService,
+		priceAlertPublisher: priceAlertPublisher,
+	}
+}
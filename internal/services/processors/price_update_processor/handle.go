@@ -2,10 +2,26 @@ package priceupdateprocessor
 
 import (
 	"context"
+	"log/slog"
 
 	"github.com/kedr891/cs-parser/internal/models"
 )
 
+// Handle записывает обновление цены и после успешной записи отдает его подписчикам WatchPrices.
+// Ошибка рассылки не возвращается: цена уже сохранена, а повтор обработки ради тика не нужен.
 func (p *PriceUpdateProcessor) Handle(ctx context.Context, event *models.PriceUpdateEvent) error {
-	return p.analyticsService.ProcessPriceUpdate(ctx, event)
+	if err := p.analyticsService.ProcessPriceUpdate(ctx, event); err != nil {
+		return err
+	}
+
+	if event.Weapon == "" {
+		// события старых версий парсера не содержат оружия
+		event.Weapon, _, _ = models.ParseMarketHashName(event.MarketHashName)
+	}
+
+	if err := p.priceTickPublisher.PublishPriceTick(ctx, event); err != nil {
+		slog.Warn("Failed to publish price tick", "skin_id", event.SkinID, "error", err)
+	}
+
+	return nil
 }
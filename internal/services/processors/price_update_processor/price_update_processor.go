@@ -10,12 +10,18 @@ type analyticsService interface {
 	ProcessPriceUpdate(ctx context.Context, event *models.PriceUpdateEvent) error
 }
 
+type priceTickPublisher interface {
+	PublishPriceTick(ctx context.Context, event *models.PriceUpdateEvent) error
+}
+
 type PriceUpdateProcessor struct {
-	analyticsService analyticsService
+	analyticsService   analyticsService
+	priceTickPublisher priceTickPublisher
 }
 
-func NewPriceUpdateProcessor(analyticsService analyticsService) *PriceUpdateProcessor {
+func NewPriceUpdateProcessor(analyticsService analyticsService, priceTickPublisher priceTickPublisher) *PriceUpdateProcessor {
 	return &PriceUpdateProcessor{
-		analyticsService: analyticsService,
+		analyticsService:   analyticsService,
+		priceTickPublisher: priceTickPublisher,
 	}
 }
@@ -0,0 +1,11 @@
+package skindiscoveredprocessor
+
+import (
+	"context"
+
+	"github.com/kedr891/cs-parser/internal/models"
+)
+
+func (p *SkinDiscoveredProcessor) Handle(ctx context.Context, event *models.SkinDiscoveredEvent) error {
+	return p.skinService.ProcessSkinDiscovered(ctx, event)
+}
@@ -0,0 +1,21 @@
+package skindiscoveredprocessor
+
+import (
+	"context"
+
+	"github.com/kedr891/cs-parser/internal/models"
+)
+
+type skinService interface {
+	ProcessSkinDiscovered(ctx context.Context, event *models.SkinDiscoveredEvent) error
+}
+
+type SkinDiscoveredProcessor struct {
+	skinService skinService
+}
+
+func NewSkinDiscoveredProcessor(skinService skinService) *SkinDiscoveredProcessor {
+	return &SkinDiscoveredProcessor{
+		skinService: skinService,
+	}
+}
@@ -0,0 +1,239 @@
+package skinservice
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/kedr891/cs-parser/internal/models"
+	redisstorage "github.com/kedr891/cs-parser/internal/storage/redis"
+)
+
+const (
+	_autocompletePrefixKey = "autocomplete:prefix:"
+	_autocompleteQueryKey  = "autocomplete:query:"
+	_autocompleteSkinsKey  = "autocomplete:skins"
+	_autocompleteViewsKey  = "autocomplete:views"
+
+	// пересечение для запроса из нескольких слов живет недолго: новые скины попадут в него не позже чем через минуту
+	_autocompleteQueryTTL  = time.Minute
+	_autocompleteBatchSize = 500
+)
+
+// AutocompleteIndex - префиксный индекс подсказок в Redis. Под каждым префиксом слова slug
+// лежит sorted set slug -> вес, карточки подсказок хранятся в hash autocomplete:skins,
+// счетчики просмотров - в sorted set autocomplete:views, чтобы переживать перестроение.
+type AutocompleteIndex struct {
+	redis *redisstorage.Redis
+}
+
+func NewAutocompleteIndex(r *redisstorage.Redis) *AutocompleteIndex {
+	return &AutocompleteIndex{redis: r}
+}
+
+// Index перезаписывает подсказки и веса скинов.
+func (i *AutocompleteIndex) Index(ctx context.Context, skins ...models.Skin) error {
+	return i.index(ctx, skins, redis.ZAddArgs{})
+}
+
+// Add индексирует новые скины. GT не дает понизить вес уже проиндексированного скина:
+// повторно обнаруженный скин приходит с нулевым объемом.
+func (i *AutocompleteIndex) Add(ctx context.Context, skins ...models.Skin) error {
+	return i.index(ctx, skins, redis.ZAddArgs{GT: true})
+}
+
+func (i *AutocompleteIndex) index(ctx context.Context, skins []models.Skin, args redis.ZAddArgs) error {
+	for start := 0; start < len(skins); start += _autocompleteBatchSize {
+		end := min(start+_autocompleteBatchSize, len(skins))
+		if err := i.indexBatch(ctx, skins[start:end], args); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (i *AutocompleteIndex) indexBatch(ctx context.Context, skins []models.Skin, args redis.ZAddArgs) error {
+	slugs := make([]string, len(skins))
+	for k := range skins {
+		slugs[k] = skins[k].Slug
+	}
+
+	views, err := i.redis.Client.ZMScore(ctx, _autocompleteViewsKey, slugs...).Result()
+	if err != nil {
+		return fmt.Errorf("get views: %w", err)
+	}
+
+	_, err = i.redis.Client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for k := range skins {
+			skin := &skins[k]
+			data, err := json.Marshal(models.NewAutocompleteSuggestion(skin))
+			if err != nil {
+				return err
+			}
+			pipe.HSet(ctx, _autocompleteSkinsKey, skin.Slug, data)
+
+			args.Members = []redis.Z{{Score: models.AutocompleteScore(skin.Volume24h, int64(views[k])), Member: skin.Slug}}
+			for _, prefix := range models.AutocompletePrefixes(skin.Slug) {
+				pipe.ZAddArgs(ctx, _autocompletePrefixKey+prefix, args)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("index skins: %w", err)
+	}
+	return nil
+}
+
+func (i *AutocompleteIndex) Remove(ctx context.Context, slug string) error {
+	_, err := i.redis.Client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for _, prefix := range models.AutocompletePrefixes(slug) {
+			pipe.ZRem(ctx, _autocompletePrefixKey+prefix, slug)
+		}
+		pipe.HDel(ctx, _autocompleteSkinsKey, slug)
+		pipe.ZRem(ctx, _autocompleteViewsKey, slug)
+		return nil
+	})
+	return err
+}
+
+// RecordView поднимает вес скина во всех его префиксах. XX не дает добавить в индекс скин, которого в нем нет.
+func (i *AutocompleteIndex) RecordView(ctx context.Context, slug string) error {
+	_, err := i.redis.Client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.ZIncrBy(ctx, _autocompleteViewsKey, 1, slug)
+		incr := redis.ZAddArgs{XX: true, Members: []redis.Z{{Score: models.AutocompleteViewWeight, Member: slug}}}
+		for _, prefix := range models.AutocompletePrefixes(slug) {
+			pipe.ZAddArgsIncr(ctx, _autocompletePrefixKey+prefix, incr)
+		}
+		return nil
+	})
+	return err
+}
+
+// UpdateVolume пересчитывает вес скина по новому объему за сутки. Префиксы перезаписываются,
+// только если вес изменился; скин, которого нет в индексе, не добавляется.
+func (i *AutocompleteIndex) UpdateVolume(ctx context.Context, slug string, volume24h int) error {
+	prefixes := models.AutocompletePrefixes(slug)
+	if len(prefixes) == 0 {
+		return nil
+	}
+
+	var views, current *redis.FloatCmd
+	_, err := i.redis.Client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		views = pipe.ZScore(ctx, _autocompleteViewsKey, slug)
+		current = pipe.ZScore(ctx, _autocompletePrefixKey+prefixes[0], slug)
+		return nil
+	})
+	if err != nil && err != redis.Nil {
+		return fmt.Errorf("get autocomplete score: %w", err)
+	}
+	if current.Err() == redis.Nil {
+		return nil
+	}
+
+	score := models.AutocompleteScore(volume24h, int64(views.Val()))
+	if current.Val() == score {
+		return nil
+	}
+
+	_, err = i.redis.Client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		update := redis.ZAddArgs{XX: true, Members: []redis.Z{{Score: score, Member: slug}}}
+		for _, prefix := range prefixes {
+			pipe.ZAddArgs(ctx, _autocompletePrefixKey+prefix, update)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("update autocomplete score: %w", err)
+	}
+	return nil
+}
+
+// Suggest возвращает limit скинов с наибольшим весом, у которых каждое слово запроса - начало какого-то слова slug.
+// Для нескольких слов префиксные множества пересекаются в отдельный ключ с коротким TTL.
+func (i *AutocompleteIndex) Suggest(ctx context.Context, query string, limit int) ([]models.AutocompleteSuggestion, error) {
+	terms := models.AutocompleteTerms(query)
+	if len(terms) == 0 {
+		return []models.AutocompleteSuggestion{}, nil
+	}
+
+	key := _autocompletePrefixKey + terms[0]
+	if len(terms) > 1 {
+		keys := make([]string, len(terms))
+		for k, term := range terms {
+			keys[k] = _autocompletePrefixKey + term
+		}
+		key = _autocompleteQueryKey + strings.Join(terms, "_")
+
+		_, err := i.redis.Client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+			pipe.ZInterStore(ctx, key, &redis.ZStore{Keys: keys, Aggregate: "MAX"})
+			pipe.Expire(ctx, key, _autocompleteQueryTTL)
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("intersect prefixes: %w", err)
+		}
+	}
+
+	members, err := i.redis.ZRevRangeWithScores(ctx, key, 0, int64(limit-1))
+	if err != nil {
+		return nil, fmt.Errorf("get suggestions: %w", err)
+	}
+	if len(members) == 0 {
+		return []models.AutocompleteSuggestion{}, nil
+	}
+
+	slugs := make([]string, len(members))
+	for k, member := range members {
+		slugs[k], _ = member.Member.(string)
+	}
+
+	values, err := i.redis.Client.HMGet(ctx, _autocompleteSkinsKey, slugs...).Result()
+	if err != nil {
+		return nil, fmt.Errorf("get suggestion details: %w", err)
+	}
+
+	suggestions := make([]models.AutocompleteSuggestion, 0, len(values))
+	for k, value := range values {
+		data, ok := value.(string)
+		if !ok {
+			continue
+		}
+		var suggestion models.AutocompleteSuggestion
+		if err := json.Unmarshal([]byte(data), &suggestion); err != nil {
+			continue
+		}
+		suggestion.Score = members[k].Score
+		suggestions = append(suggestions, suggestion)
+	}
+	return suggestions, nil
+}
+
+// Rebuild перезаписывает веса всех скинов и удаляет из индекса скины, которых больше нет в каталоге.
+// Индекс не очищается целиком, поэтому подсказки работают и во время перестроения.
+func (i *AutocompleteIndex) Rebuild(ctx context.Context, skins []models.Skin) error {
+	indexed, err := i.redis.Client.HKeys(ctx, _autocompleteSkinsKey).Result()
+	if err != nil {
+		return fmt.Errorf("get indexed skins: %w", err)
+	}
+
+	current := make(map[string]struct{}, len(skins))
+	for k := range skins {
+		current[skins[k].Slug] = struct{}{}
+	}
+
+	for _, slug := range indexed {
+		if _, ok := current[slug]; ok {
+			continue
+		}
+		if err := i.Remove(ctx, slug); err != nil {
+			return fmt.Errorf("remove stale skin %s: %w", slug, err)
+		}
+	}
+
+	return i.Index(ctx, skins...)
+}
@@ -0,0 +1,68 @@
+package skinservice
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kedr891/cs-parser/internal/models"
+	redisstorage "github.com/kedr891/cs-parser/internal/storage/redis"
+)
+
+func newTestAutocompleteIndex(t *testing.T) *AutocompleteIndex {
+	t.Helper()
+	server := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: server.Addr()})
+	t.Cleanup(func() { _ = client.Close() })
+	return NewAutocompleteIndex(redisstorage.FromClient(client))
+}
+
+func suggestScore(t *testing.T, index *AutocompleteIndex, query string) float64 {
+	t.Helper()
+	suggestions, err := index.Suggest(context.Background(), query, 10)
+	require.NoError(t, err)
+	require.Len(t, suggestions, 1)
+	return suggestions[0].Score
+}
+
+func TestAutocompleteIndex_AddKeepsHigherWeight(t *testing.T) {
+	ctx := context.Background()
+	index := newTestAutocompleteIndex(t)
+
+	skin := models.NewSkin("AWP | Asiimov (Field-Tested)", "Asiimov", "AWP", "Field-Tested")
+	skin.Volume24h = 120
+	require.NoError(t, index.Index(ctx, *skin))
+	require.NoError(t, index.RecordView(ctx, skin.Slug))
+
+	// повторное обнаружение приходит с нулевым объемом
+	rediscovered := models.NewSkin(skin.MarketHashName, skin.Name, skin.Weapon, skin.Quality)
+	require.NoError(t, index.Add(ctx, *rediscovered))
+
+	assert.Equal(t, models.AutocompleteScore(120, 1), suggestScore(t, index, "awp asii"))
+}
+
+func TestAutocompleteIndex_UpdateVolume(t *testing.T) {
+	ctx := context.Background()
+	index := newTestAutocompleteIndex(t)
+
+	skin := models.NewSkin("AWP | Asiimov (Field-Tested)", "Asiimov", "AWP", "Field-Tested")
+	require.NoError(t, index.Add(ctx, *skin))
+	require.NoError(t, index.RecordView(ctx, skin.Slug))
+
+	require.NoError(t, index.UpdateVolume(ctx, skin.Slug, 40))
+	assert.Equal(t, models.AutocompleteScore(40, 1), suggestScore(t, index, "asiimov"))
+
+	// объем упал - вес тоже падает
+	require.NoError(t, index.UpdateVolume(ctx, skin.Slug, 10))
+	assert.Equal(t, models.AutocompleteScore(10, 1), suggestScore(t, index, "asiimov"))
+
+	// скина нет в индексе - UpdateVolume его не добавляет
+	require.NoError(t, index.UpdateVolume(ctx, "ak_47_redline_ft", 500))
+	suggestions, err := index.Suggest(ctx, "redline", 10)
+	require.NoError(t, err)
+	assert.Empty(t, suggestions)
+}
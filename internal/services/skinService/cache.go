@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/redis/go-redis/v9"
@@ -23,6 +24,10 @@ func NewSkinsCache(client *redis.Client, ttlSeconds int) *SkinsCache {
 	}
 }
 
+func (c *SkinsCache) Client() *redis.Client {
+	return c.client
+}
+
 func (c *SkinsCache) key(prefix, id string) string {
 	return fmt.Sprintf("skins:%s:%s", prefix, id)
 }
@@ -44,11 +49,22 @@ func (c *SkinsCache) Set(ctx context.Context, key string, value string, ttl time
 	return c.client.Set(ctx, key, value, ttl).Err()
 }
 
+// Delete удаляет ключ, а ключ с "*" считает шаблоном и удаляет все подходящие ключи.
 func (c *SkinsCache) Delete(ctx context.Context, key string) error {
 	if c == nil || c.client == nil {
 		return fmt.Errorf("cache not initialized")
 	}
-	return c.client.Del(ctx, key).Err()
+	if !strings.Contains(key, "*") {
+		return c.client.Del(ctx, key).Err()
+	}
+
+	iter := c.client.Scan(ctx, 0, key, 100).Iterator()
+	for iter.Next(ctx) {
+		if err := c.client.Del(ctx, iter.Val()).Err(); err != nil {
+			return err
+		}
+	}
+	return iter.Err()
 }
 
 func (c *SkinsCache) GetSkinList(ctx context.Context, cacheKey string) (*models.SkinListResponse, bool) {
@@ -95,6 +111,33 @@ func (c *SkinsCache) SetSkinDetail(ctx context.Context, slug string, response *m
 	return c.Set(ctx, cacheKey, string(data), ttl)
 }
 
+// RecordSearch увеличивает счетчик запроса в текущей корзине каждого окна статистики поиска.
+// Корзина живет, пока входит в окно, поэтому старые счетчики удаляет сам Redis.
+func (c *SkinsCache) RecordSearch(ctx context.Context, query string, zeroResults bool, at time.Time) error {
+	if c == nil || c.client == nil {
+		return fmt.Errorf("cache not initialized")
+	}
+
+	_, err := c.client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for _, window := range models.SearchWindows {
+			bucket := at.UTC().Truncate(window.BucketSize())
+			ttl := window.Duration() + window.BucketSize()
+
+			key := models.SearchStatsKey(window, bucket, false)
+			pipe.ZIncrBy(ctx, key, 1, query)
+			pipe.Expire(ctx, key, ttl)
+
+			if zeroResults {
+				zeroKey := models.SearchStatsKey(window, bucket, true)
+				pipe.ZIncrBy(ctx, zeroKey, 1, query)
+				pipe.Expire(ctx, zeroKey, ttl)
+			}
+		}
+		return nil
+	})
+	return err
+}
+
 func (c *SkinsCache) InvalidateSkin(ctx context.Context, skinID string) error {
 	pattern := fmt.Sprintf("skins:*:%s", skinID)
 	iter := c.client.Scan(ctx, 0, pattern, 0).Iterator()
@@ -6,10 +6,9 @@ import (
 	context "context"
 	time "time"
 
+	uuid "github.com/google/uuid"
 	models "github.com/kedr891/cs-parser/internal/models"
 	mock "github.com/stretchr/testify/mock"
-
-	uuid "github.com/google/uuid"
 )
 
 // MockSkinStorage is an autogenerated mock type for the SkinStorage type
@@ -25,6 +24,111 @@ func (_m *MockSkinStorage) EXPECT() *MockSkinStorage_Expecter {
 	return &MockSkinStorage_Expecter{mock: &_m.Mock}
 }
 
+// CreateDiscoveredSkin provides a mock function with given fields: ctx, skin
+func (_m *MockSkinStorage) CreateDiscoveredSkin(ctx context.Context, skin *models.Skin) (bool, error) {
+	ret := _m.Called(ctx, skin)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateDiscoveredSkin")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.Skin) (bool, error)); ok {
+		return rf(ctx, skin)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *models.Skin) bool); ok {
+		r0 = rf(ctx, skin)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *models.Skin) error); ok {
+		r1 = rf(ctx, skin)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSkinStorage_CreateDiscoveredSkin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateDiscoveredSkin'
+type MockSkinStorage_CreateDiscoveredSkin_Call struct {
+	*mock.Call
+}
+
+// CreateDiscoveredSkin is a helper method to define mock.On call
+//   - ctx context.Context
+//   - skin *models.Skin
+func (_e *MockSkinStorage_Expecter) CreateDiscoveredSkin(ctx interface{}, skin interface{}) *MockSkinStorage_CreateDiscoveredSkin_Call {
+	return &MockSkinStorage_CreateDiscoveredSkin_Call{Call: _e.mock.On("CreateDiscoveredSkin", ctx, skin)}
+}
+
+func (_c *MockSkinStorage_CreateDiscoveredSkin_Call) Run(run func(ctx context.Context, skin *models.Skin)) *MockSkinStorage_CreateDiscoveredSkin_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.Skin))
+	})
+	return _c
+}
+
+func (_c *MockSkinStorage_CreateDiscoveredSkin_Call) Return(_a0 bool, _a1 error) *MockSkinStorage_CreateDiscoveredSkin_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSkinStorage_CreateDiscoveredSkin_Call) RunAndReturn(run func(context.Context, *models.Skin) (bool, error)) *MockSkinStorage_CreateDiscoveredSkin_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateInitialPriceHistory provides a mock function with given fields: ctx, weapon, history
+func (_m *MockSkinStorage) CreateInitialPriceHistory(ctx context.Context, weapon string, history *models.PriceHistory) error {
+	ret := _m.Called(ctx, weapon, history)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateInitialPriceHistory")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *models.PriceHistory) error); ok {
+		r0 = rf(ctx, weapon, history)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockSkinStorage_CreateInitialPriceHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateInitialPriceHistory'
+type MockSkinStorage_CreateInitialPriceHistory_Call struct {
+	*mock.Call
+}
+
+// CreateInitialPriceHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - weapon string
+//   - history *models.PriceHistory
+func (_e *MockSkinStorage_Expecter) CreateInitialPriceHistory(ctx interface{}, weapon interface{}, history interface{}) *MockSkinStorage_CreateInitialPriceHistory_Call {
+	return &MockSkinStorage_CreateInitialPriceHistory_Call{Call: _e.mock.On("CreateInitialPriceHistory", ctx, weapon, history)}
+}
+
+func (_c *MockSkinStorage_CreateInitialPriceHistory_Call) Run(run func(ctx context.Context, weapon string, history *models.PriceHistory)) *MockSkinStorage_CreateInitialPriceHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*models.PriceHistory))
+	})
+	return _c
+}
+
+func (_c *MockSkinStorage_CreateInitialPriceHistory_Call) Return(_a0 error) *MockSkinStorage_CreateInitialPriceHistory_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockSkinStorage_CreateInitialPriceHistory_Call) RunAndReturn(run func(context.Context, string, *models.PriceHistory) error) *MockSkinStorage_CreateInitialPriceHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateSkin provides a mock function with given fields: ctx, skin
 func (_m *MockSkinStorage) CreateSkin(ctx context.Context, skin *models.Skin) error {
 	ret := _m.Called(ctx, skin)
@@ -72,6 +176,111 @@ func (_c *MockSkinStorage_CreateSkin_Call) RunAndReturn(run func(context.Context
 	return _c
 }
 
+// DeleteSkin provides a mock function with given fields: ctx, skin
+func (_m *MockSkinStorage) DeleteSkin(ctx context.Context, skin *models.Skin) error {
+	ret := _m.Called(ctx, skin)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSkin")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.Skin) error); ok {
+		r0 = rf(ctx, skin)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockSkinStorage_DeleteSkin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSkin'
+type MockSkinStorage_DeleteSkin_Call struct {
+	*mock.Call
+}
+
+// DeleteSkin is a helper method to define mock.On call
+//   - ctx context.Context
+//   - skin *models.Skin
+func (_e *MockSkinStorage_Expecter) DeleteSkin(ctx interface{}, skin interface{}) *MockSkinStorage_DeleteSkin_Call {
+	return &MockSkinStorage_DeleteSkin_Call{Call: _e.mock.On("DeleteSkin", ctx, skin)}
+}
+
+func (_c *MockSkinStorage_DeleteSkin_Call) Run(run func(ctx context.Context, skin *models.Skin)) *MockSkinStorage_DeleteSkin_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.Skin))
+	})
+	return _c
+}
+
+func (_c *MockSkinStorage_DeleteSkin_Call) Return(_a0 error) *MockSkinStorage_DeleteSkin_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockSkinStorage_DeleteSkin_Call) RunAndReturn(run func(context.Context, *models.Skin) error) *MockSkinStorage_DeleteSkin_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAllSkins provides a mock function with given fields: ctx
+func (_m *MockSkinStorage) GetAllSkins(ctx context.Context) ([]models.Skin, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllSkins")
+	}
+
+	var r0 []models.Skin
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]models.Skin, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []models.Skin); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Skin)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSkinStorage_GetAllSkins_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAllSkins'
+type MockSkinStorage_GetAllSkins_Call struct {
+	*mock.Call
+}
+
+// GetAllSkins is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockSkinStorage_Expecter) GetAllSkins(ctx interface{}) *MockSkinStorage_GetAllSkins_Call {
+	return &MockSkinStorage_GetAllSkins_Call{Call: _e.mock.On("GetAllSkins", ctx)}
+}
+
+func (_c *MockSkinStorage_GetAllSkins_Call) Run(run func(ctx context.Context)) *MockSkinStorage_GetAllSkins_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockSkinStorage_GetAllSkins_Call) Return(_a0 []models.Skin, _a1 error) *MockSkinStorage_GetAllSkins_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSkinStorage_GetAllSkins_Call) RunAndReturn(run func(context.Context) ([]models.Skin, error)) *MockSkinStorage_GetAllSkins_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetPopularSkins provides a mock function with given fields: ctx, limit
 func (_m *MockSkinStorage) GetPopularSkins(ctx context.Context, limit int) ([]models.Skin, error) {
 	ret := _m.Called(ctx, limit)
@@ -131,6 +340,68 @@ func (_c *MockSkinStorage_GetPopularSkins_Call) RunAndReturn(run func(context.Co
 	return _c
 }
 
+// GetPriceCandles provides a mock function with given fields: ctx, skin, source, period, interval
+func (_m *MockSkinStorage) GetPriceCandles(ctx context.Context, skin *models.Skin, source models.PriceSource, period models.PriceStatsPeriod, interval models.ChartInterval) ([]models.PriceCandle, error) {
+	ret := _m.Called(ctx, skin, source, period, interval)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPriceCandles")
+	}
+
+	var r0 []models.PriceCandle
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.Skin, models.PriceSource, models.PriceStatsPeriod, models.ChartInterval) ([]models.PriceCandle, error)); ok {
+		return rf(ctx, skin, source, period, interval)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *models.Skin, models.PriceSource, models.PriceStatsPeriod, models.ChartInterval) []models.PriceCandle); ok {
+		r0 = rf(ctx, skin, source, period, interval)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.PriceCandle)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *models.Skin, models.PriceSource, models.PriceStatsPeriod, models.ChartInterval) error); ok {
+		r1 = rf(ctx, skin, source, period, interval)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSkinStorage_GetPriceCandles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPriceCandles'
+type MockSkinStorage_GetPriceCandles_Call struct {
+	*mock.Call
+}
+
+// GetPriceCandles is a helper method to define mock.On call
+//   - ctx context.Context
+//   - skin *models.Skin
+//   - source models.PriceSource
+//   - period models.PriceStatsPeriod
+//   - interval models.ChartInterval
+func (_e *MockSkinStorage_Expecter) GetPriceCandles(ctx interface{}, skin interface{}, source interface{}, period interface{}, interval interface{}) *MockSkinStorage_GetPriceCandles_Call {
+	return &MockSkinStorage_GetPriceCandles_Call{Call: _e.mock.On("GetPriceCandles", ctx, skin, source, period, interval)}
+}
+
+func (_c *MockSkinStorage_GetPriceCandles_Call) Run(run func(ctx context.Context, skin *models.Skin, source models.PriceSource, period models.PriceStatsPeriod, interval models.ChartInterval)) *MockSkinStorage_GetPriceCandles_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.Skin), args[2].(models.PriceSource), args[3].(models.PriceStatsPeriod), args[4].(models.ChartInterval))
+	})
+	return _c
+}
+
+func (_c *MockSkinStorage_GetPriceCandles_Call) Return(_a0 []models.PriceCandle, _a1 error) *MockSkinStorage_GetPriceCandles_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSkinStorage_GetPriceCandles_Call) RunAndReturn(run func(context.Context, *models.Skin, models.PriceSource, models.PriceStatsPeriod, models.ChartInterval) ([]models.PriceCandle, error)) *MockSkinStorage_GetPriceCandles_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetPriceHistory provides a mock function with given fields: ctx, skinID, period
 func (_m *MockSkinStorage) GetPriceHistory(ctx context.Context, skinID uuid.UUID, period models.PriceStatsPeriod) ([]models.PriceHistory, error) {
 	ret := _m.Called(ctx, skinID, period)
@@ -250,6 +521,66 @@ func (_c *MockSkinStorage_GetSkinBySlug_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
+// GetSkinFacets provides a mock function with given fields: ctx, filter, bounds
+func (_m *MockSkinStorage) GetSkinFacets(ctx context.Context, filter *models.SkinFilter, bounds []float64) ([]models.SkinFacetRow, error) {
+	ret := _m.Called(ctx, filter, bounds)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSkinFacets")
+	}
+
+	var r0 []models.SkinFacetRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.SkinFilter, []float64) ([]models.SkinFacetRow, error)); ok {
+		return rf(ctx, filter, bounds)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *models.SkinFilter, []float64) []models.SkinFacetRow); ok {
+		r0 = rf(ctx, filter, bounds)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.SkinFacetRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *models.SkinFilter, []float64) error); ok {
+		r1 = rf(ctx, filter, bounds)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSkinStorage_GetSkinFacets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSkinFacets'
+type MockSkinStorage_GetSkinFacets_Call struct {
+	*mock.Call
+}
+
+// GetSkinFacets is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter *models.SkinFilter
+//   - bounds []float64
+func (_e *MockSkinStorage_Expecter) GetSkinFacets(ctx interface{}, filter interface{}, bounds interface{}) *MockSkinStorage_GetSkinFacets_Call {
+	return &MockSkinStorage_GetSkinFacets_Call{Call: _e.mock.On("GetSkinFacets", ctx, filter, bounds)}
+}
+
+func (_c *MockSkinStorage_GetSkinFacets_Call) Run(run func(ctx context.Context, filter *models.SkinFilter, bounds []float64)) *MockSkinStorage_GetSkinFacets_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.SkinFilter), args[2].([]float64))
+	})
+	return _c
+}
+
+func (_c *MockSkinStorage_GetSkinFacets_Call) Return(_a0 []models.SkinFacetRow, _a1 error) *MockSkinStorage_GetSkinFacets_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSkinStorage_GetSkinFacets_Call) RunAndReturn(run func(context.Context, *models.SkinFilter, []float64) ([]models.SkinFacetRow, error)) *MockSkinStorage_GetSkinFacets_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetSkinStatistics provides a mock function with given fields: ctx, skinID
 func (_m *MockSkinStorage) GetSkinStatistics(ctx context.Context, skinID uuid.UUID) (*models.SkinStatistics, error) {
 	ret := _m.Called(ctx, skinID)
@@ -375,29 +706,29 @@ func (_c *MockSkinStorage_GetSkins_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
-// SearchSkins provides a mock function with given fields: ctx, query, limit
-func (_m *MockSkinStorage) SearchSkins(ctx context.Context, query string, limit int) ([]models.Skin, error) {
-	ret := _m.Called(ctx, query, limit)
+// GetSkinsBySlugsOrIDs provides a mock function with given fields: ctx, slugs, ids
+func (_m *MockSkinStorage) GetSkinsBySlugsOrIDs(ctx context.Context, slugs []string, ids []string) ([]models.Skin, error) {
+	ret := _m.Called(ctx, slugs, ids)
 
 	if len(ret) == 0 {
-		panic("no return value specified for SearchSkins")
+		panic("no return value specified for GetSkinsBySlugsOrIDs")
 	}
 
 	var r0 []models.Skin
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, int) ([]models.Skin, error)); ok {
-		return rf(ctx, query, limit)
+	if rf, ok := ret.Get(0).(func(context.Context, []string, []string) ([]models.Skin, error)); ok {
+		return rf(ctx, slugs, ids)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, int) []models.Skin); ok {
-		r0 = rf(ctx, query, limit)
+	if rf, ok := ret.Get(0).(func(context.Context, []string, []string) []models.Skin); ok {
+		r0 = rf(ctx, slugs, ids)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]models.Skin)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
-		r1 = rf(ctx, query, limit)
+	if rf, ok := ret.Get(1).(func(context.Context, []string, []string) error); ok {
+		r1 = rf(ctx, slugs, ids)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -405,86 +736,59 @@ func (_m *MockSkinStorage) SearchSkins(ctx context.Context, query string, limit
 	return r0, r1
 }
 
-// MockSkinStorage_SearchSkins_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchSkins'
-type MockSkinStorage_SearchSkins_Call struct {
+// MockSkinStorage_GetSkinsBySlugsOrIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSkinsBySlugsOrIDs'
+type MockSkinStorage_GetSkinsBySlugsOrIDs_Call struct {
 	*mock.Call
 }
 
-// SearchSkins is a helper method to define mock.On call
+// GetSkinsBySlugsOrIDs is a helper method to define mock.On call
 //   - ctx context.Context
-//   - query string
-//   - limit int
-func (_e *MockSkinStorage_Expecter) SearchSkins(ctx interface{}, query interface{}, limit interface{}) *MockSkinStorage_SearchSkins_Call {
-	return &MockSkinStorage_SearchSkins_Call{Call: _e.mock.On("SearchSkins", ctx, query, limit)}
+//   - slugs []string
+//   - ids []string
+func (_e *MockSkinStorage_Expecter) GetSkinsBySlugsOrIDs(ctx interface{}, slugs interface{}, ids interface{}) *MockSkinStorage_GetSkinsBySlugsOrIDs_Call {
+	return &MockSkinStorage_GetSkinsBySlugsOrIDs_Call{Call: _e.mock.On("GetSkinsBySlugsOrIDs", ctx, slugs, ids)}
 }
 
-func (_c *MockSkinStorage_SearchSkins_Call) Run(run func(ctx context.Context, query string, limit int)) *MockSkinStorage_SearchSkins_Call {
+func (_c *MockSkinStorage_GetSkinsBySlugsOrIDs_Call) Run(run func(ctx context.Context, slugs []string, ids []string)) *MockSkinStorage_GetSkinsBySlugsOrIDs_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(int))
+		run(args[0].(context.Context), args[1].([]string), args[2].([]string))
 	})
 	return _c
 }
 
-func (_c *MockSkinStorage_SearchSkins_Call) Return(_a0 []models.Skin, _a1 error) *MockSkinStorage_SearchSkins_Call {
+func (_c *MockSkinStorage_GetSkinsBySlugsOrIDs_Call) Return(_a0 []models.Skin, _a1 error) *MockSkinStorage_GetSkinsBySlugsOrIDs_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockSkinStorage_SearchSkins_Call) RunAndReturn(run func(context.Context, string, int) ([]models.Skin, error)) *MockSkinStorage_SearchSkins_Call {
+func (_c *MockSkinStorage_GetSkinsBySlugsOrIDs_Call) RunAndReturn(run func(context.Context, []string, []string) ([]models.Skin, error)) *MockSkinStorage_GetSkinsBySlugsOrIDs_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockSkinStorage creates a new instance of MockSkinStorage. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-// The first argument is typically a *testing.T value.
-func NewMockSkinStorage(t interface {
-	mock.TestingT
-	Cleanup(func())
-}) *MockSkinStorage {
-	mock := &MockSkinStorage{}
-	mock.Mock.Test(t)
-
-	t.Cleanup(func() { mock.AssertExpectations(t) })
-
-	return mock
-}
-
-// MockSkinCache is an autogenerated mock type for the SkinCache type
-type MockSkinCache struct {
-	mock.Mock
-}
-
-type MockSkinCache_Expecter struct {
-	mock *mock.Mock
-}
-
-func (_m *MockSkinCache) EXPECT() *MockSkinCache_Expecter {
-	return &MockSkinCache_Expecter{mock: &_m.Mock}
-}
-
-// Get provides a mock function with given fields: ctx, key
-func (_m *MockSkinCache) Get(ctx context.Context, key string) (string, error) {
-	ret := _m.Called(ctx, key)
+// SearchSkins provides a mock function with given fields: ctx, query, limit
+func (_m *MockSkinStorage) SearchSkins(ctx context.Context, query string, limit int) ([]models.Skin, error) {
+	ret := _m.Called(ctx, query, limit)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Get")
+		panic("no return value specified for SearchSkins")
 	}
 
-	var r0 string
+	var r0 []models.Skin
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (string, error)); ok {
-		return rf(ctx, key)
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) ([]models.Skin, error)); ok {
+		return rf(ctx, query, limit)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) string); ok {
-		r0 = rf(ctx, key)
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) []models.Skin); ok {
+		r0 = rf(ctx, query, limit)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(string)
+			r0 = ret.Get(0).([]models.Skin)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, key)
+	if rf, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
+		r1 = rf(ctx, query, limit)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -492,17 +796,47 @@ func (_m *MockSkinCache) Get(ctx context.Context, key string) (string, error) {
 	return r0, r1
 }
 
-// Set provides a mock function with given fields: ctx, key, value, ttl
-func (_m *MockSkinCache) Set(ctx context.Context, key string, value string, ttl time.Duration) error {
-	ret := _m.Called(ctx, key, value, ttl)
+// MockSkinStorage_SearchSkins_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchSkins'
+type MockSkinStorage_SearchSkins_Call struct {
+	*mock.Call
+}
+
+// SearchSkins is a helper method to define mock.On call
+//   - ctx context.Context
+//   - query string
+//   - limit int
+func (_e *MockSkinStorage_Expecter) SearchSkins(ctx interface{}, query interface{}, limit interface{}) *MockSkinStorage_SearchSkins_Call {
+	return &MockSkinStorage_SearchSkins_Call{Call: _e.mock.On("SearchSkins", ctx, query, limit)}
+}
+
+func (_c *MockSkinStorage_SearchSkins_Call) Run(run func(ctx context.Context, query string, limit int)) *MockSkinStorage_SearchSkins_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockSkinStorage_SearchSkins_Call) Return(_a0 []models.Skin, _a1 error) *MockSkinStorage_SearchSkins_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSkinStorage_SearchSkins_Call) RunAndReturn(run func(context.Context, string, int) ([]models.Skin, error)) *MockSkinStorage_SearchSkins_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateSkinFields provides a mock function with given fields: ctx, skin, previousWeapon, fields
+func (_m *MockSkinStorage) UpdateSkinFields(ctx context.Context, skin *models.Skin, previousWeapon string, fields []string) error {
+	ret := _m.Called(ctx, skin, previousWeapon, fields)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Set")
+		panic("no return value specified for UpdateSkinFields")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Duration) error); ok {
-		r0 = rf(ctx, key, value, ttl)
+	if rf, ok := ret.Get(0).(func(context.Context, *models.Skin, string, []string) error); ok {
+		r0 = rf(ctx, skin, previousWeapon, fields)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -510,6 +844,64 @@ func (_m *MockSkinCache) Set(ctx context.Context, key string, value string, ttl
 	return r0
 }
 
+// MockSkinStorage_UpdateSkinFields_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateSkinFields'
+type MockSkinStorage_UpdateSkinFields_Call struct {
+	*mock.Call
+}
+
+// UpdateSkinFields is a helper method to define mock.On call
+//   - ctx context.Context
+//   - skin *models.Skin
+//   - previousWeapon string
+//   - fields []string
+func (_e *MockSkinStorage_Expecter) UpdateSkinFields(ctx interface{}, skin interface{}, previousWeapon interface{}, fields interface{}) *MockSkinStorage_UpdateSkinFields_Call {
+	return &MockSkinStorage_UpdateSkinFields_Call{Call: _e.mock.On("UpdateSkinFields", ctx, skin, previousWeapon, fields)}
+}
+
+func (_c *MockSkinStorage_UpdateSkinFields_Call) Run(run func(ctx context.Context, skin *models.Skin, previousWeapon string, fields []string)) *MockSkinStorage_UpdateSkinFields_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.Skin), args[2].(string), args[3].([]string))
+	})
+	return _c
+}
+
+func (_c *MockSkinStorage_UpdateSkinFields_Call) Return(_a0 error) *MockSkinStorage_UpdateSkinFields_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockSkinStorage_UpdateSkinFields_Call) RunAndReturn(run func(context.Context, *models.Skin, string, []string) error) *MockSkinStorage_UpdateSkinFields_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSkinStorage creates a new instance of MockSkinStorage. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSkinStorage(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSkinStorage {
+	mock := &MockSkinStorage{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockSkinCache is an autogenerated mock type for the SkinCache type
+type MockSkinCache struct {
+	mock.Mock
+}
+
+type MockSkinCache_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSkinCache) EXPECT() *MockSkinCache_Expecter {
+	return &MockSkinCache_Expecter{mock: &_m.Mock}
+}
+
 // Delete provides a mock function with given fields: ctx, key
 func (_m *MockSkinCache) Delete(ctx context.Context, key string) error {
 	ret := _m.Called(ctx, key)
@@ -528,52 +920,90 @@ func (_m *MockSkinCache) Delete(ctx context.Context, key string) error {
 	return r0
 }
 
-// GetSkinList provides a mock function with given fields: ctx, cacheKey
-func (_m *MockSkinCache) GetSkinList(ctx context.Context, cacheKey string) (*models.SkinListResponse, bool) {
-	ret := _m.Called(ctx, cacheKey)
+// MockSkinCache_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockSkinCache_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+func (_e *MockSkinCache_Expecter) Delete(ctx interface{}, key interface{}) *MockSkinCache_Delete_Call {
+	return &MockSkinCache_Delete_Call{Call: _e.mock.On("Delete", ctx, key)}
+}
+
+func (_c *MockSkinCache_Delete_Call) Run(run func(ctx context.Context, key string)) *MockSkinCache_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockSkinCache_Delete_Call) Return(_a0 error) *MockSkinCache_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockSkinCache_Delete_Call) RunAndReturn(run func(context.Context, string) error) *MockSkinCache_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function with given fields: ctx, key
+func (_m *MockSkinCache) Get(ctx context.Context, key string) (string, error) {
+	ret := _m.Called(ctx, key)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetSkinList")
+		panic("no return value specified for Get")
 	}
 
-	var r0 *models.SkinListResponse
-	var r1 bool
-	if rf, ok := ret.Get(0).(func(context.Context, string) (*models.SkinListResponse, bool)); ok {
-		return rf(ctx, cacheKey)
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (string, error)); ok {
+		return rf(ctx, key)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) *models.SkinListResponse); ok {
-		r0 = rf(ctx, cacheKey)
+	if rf, ok := ret.Get(0).(func(context.Context, string) string); ok {
+		r0 = rf(ctx, key)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*models.SkinListResponse)
-		}
+		r0 = ret.Get(0).(string)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) bool); ok {
-		r1 = rf(ctx, cacheKey)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, key)
 	} else {
-		r1 = ret.Get(1).(bool)
+		r1 = ret.Error(1)
 	}
 
 	return r0, r1
 }
 
-// SetSkinList provides a mock function with given fields: ctx, cacheKey, response, ttl
-func (_m *MockSkinCache) SetSkinList(ctx context.Context, cacheKey string, response *models.SkinListResponse, ttl time.Duration) error {
-	ret := _m.Called(ctx, cacheKey, response, ttl)
+// MockSkinCache_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockSkinCache_Get_Call struct {
+	*mock.Call
+}
 
-	if len(ret) == 0 {
-		panic("no return value specified for SetSkinList")
-	}
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+func (_e *MockSkinCache_Expecter) Get(ctx interface{}, key interface{}) *MockSkinCache_Get_Call {
+	return &MockSkinCache_Get_Call{Call: _e.mock.On("Get", ctx, key)}
+}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, *models.SkinListResponse, time.Duration) error); ok {
-		r0 = rf(ctx, cacheKey, response, ttl)
-	} else {
-		r0 = ret.Error(0)
-	}
+func (_c *MockSkinCache_Get_Call) Run(run func(ctx context.Context, key string)) *MockSkinCache_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
 
-	return r0
+func (_c *MockSkinCache_Get_Call) Return(_a0 string, _a1 error) *MockSkinCache_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSkinCache_Get_Call) RunAndReturn(run func(context.Context, string) (string, error)) *MockSkinCache_Get_Call {
+	_c.Call.Return(run)
+	return _c
 }
 
 // GetSkinDetail provides a mock function with given fields: ctx, slug
@@ -606,29 +1036,295 @@ func (_m *MockSkinCache) GetSkinDetail(ctx context.Context, slug string) (*model
 	return r0, r1
 }
 
-// SetSkinDetail provides a mock function with given fields: ctx, slug, response, ttl
-func (_m *MockSkinCache) SetSkinDetail(ctx context.Context, slug string, response *models.SkinDetailResponse, ttl time.Duration) error {
-	ret := _m.Called(ctx, slug, response, ttl)
+// MockSkinCache_GetSkinDetail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSkinDetail'
+type MockSkinCache_GetSkinDetail_Call struct {
+	*mock.Call
+}
+
+// GetSkinDetail is a helper method to define mock.On call
+//   - ctx context.Context
+//   - slug string
+func (_e *MockSkinCache_Expecter) GetSkinDetail(ctx interface{}, slug interface{}) *MockSkinCache_GetSkinDetail_Call {
+	return &MockSkinCache_GetSkinDetail_Call{Call: _e.mock.On("GetSkinDetail", ctx, slug)}
+}
+
+func (_c *MockSkinCache_GetSkinDetail_Call) Run(run func(ctx context.Context, slug string)) *MockSkinCache_GetSkinDetail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockSkinCache_GetSkinDetail_Call) Return(_a0 *models.SkinDetailResponse, _a1 bool) *MockSkinCache_GetSkinDetail_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSkinCache_GetSkinDetail_Call) RunAndReturn(run func(context.Context, string) (*models.SkinDetailResponse, bool)) *MockSkinCache_GetSkinDetail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSkinList provides a mock function with given fields: ctx, cacheKey
+func (_m *MockSkinCache) GetSkinList(ctx context.Context, cacheKey string) (*models.SkinListResponse, bool) {
+	ret := _m.Called(ctx, cacheKey)
 
 	if len(ret) == 0 {
-		panic("no return value specified for SetSkinDetail")
+		panic("no return value specified for GetSkinList")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, *models.SkinDetailResponse, time.Duration) error); ok {
-		r0 = rf(ctx, slug, response, ttl)
+	var r0 *models.SkinListResponse
+	var r1 bool
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*models.SkinListResponse, bool)); ok {
+		return rf(ctx, cacheKey)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *models.SkinListResponse); ok {
+		r0 = rf(ctx, cacheKey)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.SkinListResponse)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, string) bool); ok {
+		r1 = rf(ctx, cacheKey)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
 }
 
-// NewMockSkinCache creates a new instance of MockSkinCache. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-// The first argument is typically a *testing.T value.
-func NewMockSkinCache(t interface {
-	mock.TestingT
-	Cleanup(func())
+// MockSkinCache_GetSkinList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSkinList'
+type MockSkinCache_GetSkinList_Call struct {
+	*mock.Call
+}
+
+// GetSkinList is a helper method to define mock.On call
+//   - ctx context.Context
+//   - cacheKey string
+func (_e *MockSkinCache_Expecter) GetSkinList(ctx interface{}, cacheKey interface{}) *MockSkinCache_GetSkinList_Call {
+	return &MockSkinCache_GetSkinList_Call{Call: _e.mock.On("GetSkinList", ctx, cacheKey)}
+}
+
+func (_c *MockSkinCache_GetSkinList_Call) Run(run func(ctx context.Context, cacheKey string)) *MockSkinCache_GetSkinList_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockSkinCache_GetSkinList_Call) Return(_a0 *models.SkinListResponse, _a1 bool) *MockSkinCache_GetSkinList_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSkinCache_GetSkinList_Call) RunAndReturn(run func(context.Context, string) (*models.SkinListResponse, bool)) *MockSkinCache_GetSkinList_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordSearch provides a mock function with given fields: ctx, query, zeroResults, at
+func (_m *MockSkinCache) RecordSearch(ctx context.Context, query string, zeroResults bool, at time.Time) error {
+	ret := _m.Called(ctx, query, zeroResults, at)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordSearch")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool, time.Time) error); ok {
+		r0 = rf(ctx, query, zeroResults, at)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockSkinCache_RecordSearch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordSearch'
+type MockSkinCache_RecordSearch_Call struct {
+	*mock.Call
+}
+
+// RecordSearch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - query string
+//   - zeroResults bool
+//   - at time.Time
+func (_e *MockSkinCache_Expecter) RecordSearch(ctx interface{}, query interface{}, zeroResults interface{}, at interface{}) *MockSkinCache_RecordSearch_Call {
+	return &MockSkinCache_RecordSearch_Call{Call: _e.mock.On("RecordSearch", ctx, query, zeroResults, at)}
+}
+
+func (_c *MockSkinCache_RecordSearch_Call) Run(run func(ctx context.Context, query string, zeroResults bool, at time.Time)) *MockSkinCache_RecordSearch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(bool), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockSkinCache_RecordSearch_Call) Return(_a0 error) *MockSkinCache_RecordSearch_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockSkinCache_RecordSearch_Call) RunAndReturn(run func(context.Context, string, bool, time.Time) error) *MockSkinCache_RecordSearch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Set provides a mock function with given fields: ctx, key, value, ttl
+func (_m *MockSkinCache) Set(ctx context.Context, key string, value string, ttl time.Duration) error {
+	ret := _m.Called(ctx, key, value, ttl)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Set")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Duration) error); ok {
+		r0 = rf(ctx, key, value, ttl)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockSkinCache_Set_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Set'
+type MockSkinCache_Set_Call struct {
+	*mock.Call
+}
+
+// Set is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+//   - value string
+//   - ttl time.Duration
+func (_e *MockSkinCache_Expecter) Set(ctx interface{}, key interface{}, value interface{}, ttl interface{}) *MockSkinCache_Set_Call {
+	return &MockSkinCache_Set_Call{Call: _e.mock.On("Set", ctx, key, value, ttl)}
+}
+
+func (_c *MockSkinCache_Set_Call) Run(run func(ctx context.Context, key string, value string, ttl time.Duration)) *MockSkinCache_Set_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *MockSkinCache_Set_Call) Return(_a0 error) *MockSkinCache_Set_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockSkinCache_Set_Call) RunAndReturn(run func(context.Context, string, string, time.Duration) error) *MockSkinCache_Set_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetSkinDetail provides a mock function with given fields: ctx, slug, response, ttl
+func (_m *MockSkinCache) SetSkinDetail(ctx context.Context, slug string, response *models.SkinDetailResponse, ttl time.Duration) error {
+	ret := _m.Called(ctx, slug, response, ttl)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetSkinDetail")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *models.SkinDetailResponse, time.Duration) error); ok {
+		r0 = rf(ctx, slug, response, ttl)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockSkinCache_SetSkinDetail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetSkinDetail'
+type MockSkinCache_SetSkinDetail_Call struct {
+	*mock.Call
+}
+
+// SetSkinDetail is a helper method to define mock.On call
+//   - ctx context.Context
+//   - slug string
+//   - response *models.SkinDetailResponse
+//   - ttl time.Duration
+func (_e *MockSkinCache_Expecter) SetSkinDetail(ctx interface{}, slug interface{}, response interface{}, ttl interface{}) *MockSkinCache_SetSkinDetail_Call {
+	return &MockSkinCache_SetSkinDetail_Call{Call: _e.mock.On("SetSkinDetail", ctx, slug, response, ttl)}
+}
+
+func (_c *MockSkinCache_SetSkinDetail_Call) Run(run func(ctx context.Context, slug string, response *models.SkinDetailResponse, ttl time.Duration)) *MockSkinCache_SetSkinDetail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*models.SkinDetailResponse), args[3].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *MockSkinCache_SetSkinDetail_Call) Return(_a0 error) *MockSkinCache_SetSkinDetail_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockSkinCache_SetSkinDetail_Call) RunAndReturn(run func(context.Context, string, *models.SkinDetailResponse, time.Duration) error) *MockSkinCache_SetSkinDetail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetSkinList provides a mock function with given fields: ctx, cacheKey, response, ttl
+func (_m *MockSkinCache) SetSkinList(ctx context.Context, cacheKey string, response *models.SkinListResponse, ttl time.Duration) error {
+	ret := _m.Called(ctx, cacheKey, response, ttl)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetSkinList")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *models.SkinListResponse, time.Duration) error); ok {
+		r0 = rf(ctx, cacheKey, response, ttl)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockSkinCache_SetSkinList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetSkinList'
+type MockSkinCache_SetSkinList_Call struct {
+	*mock.Call
+}
+
+// SetSkinList is a helper method to define mock.On call
+//   - ctx context.Context
+//   - cacheKey string
+//   - response *models.SkinListResponse
+//   - ttl time.Duration
+func (_e *MockSkinCache_Expecter) SetSkinList(ctx interface{}, cacheKey interface{}, response interface{}, ttl interface{}) *MockSkinCache_SetSkinList_Call {
+	return &MockSkinCache_SetSkinList_Call{Call: _e.mock.On("SetSkinList", ctx, cacheKey, response, ttl)}
+}
+
+func (_c *MockSkinCache_SetSkinList_Call) Run(run func(ctx context.Context, cacheKey string, response *models.SkinListResponse, ttl time.Duration)) *MockSkinCache_SetSkinList_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*models.SkinListResponse), args[3].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *MockSkinCache_SetSkinList_Call) Return(_a0 error) *MockSkinCache_SetSkinList_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockSkinCache_SetSkinList_Call) RunAndReturn(run func(context.Context, string, *models.SkinListResponse, time.Duration) error) *MockSkinCache_SetSkinList_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSkinCache creates a new instance of MockSkinCache. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSkinCache(t interface {
+	mock.TestingT
+	Cleanup(func())
 }) *MockSkinCache {
 	mock := &MockSkinCache{}
 	mock.Mock.Test(t)
@@ -637,3 +1333,353 @@ func NewMockSkinCache(t interface {
 
 	return mock
 }
+
+// MockSkinAutocomplete is an autogenerated mock type for the SkinAutocomplete type
+type MockSkinAutocomplete struct {
+	mock.Mock
+}
+
+type MockSkinAutocomplete_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSkinAutocomplete) EXPECT() *MockSkinAutocomplete_Expecter {
+	return &MockSkinAutocomplete_Expecter{mock: &_m.Mock}
+}
+
+// Add provides a mock function with given fields: ctx, skins
+func (_m *MockSkinAutocomplete) Add(ctx context.Context, skins ...models.Skin) error {
+	_va := make([]interface{}, len(skins))
+	for _i := range skins {
+		_va[_i] = skins[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Add")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, ...models.Skin) error); ok {
+		r0 = rf(ctx, skins...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockSkinAutocomplete_Add_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Add'
+type MockSkinAutocomplete_Add_Call struct {
+	*mock.Call
+}
+
+// Add is a helper method to define mock.On call
+//   - ctx context.Context
+//   - skins ...models.Skin
+func (_e *MockSkinAutocomplete_Expecter) Add(ctx interface{}, skins ...interface{}) *MockSkinAutocomplete_Add_Call {
+	return &MockSkinAutocomplete_Add_Call{Call: _e.mock.On("Add",
+		append([]interface{}{ctx}, skins...)...)}
+}
+
+func (_c *MockSkinAutocomplete_Add_Call) Run(run func(ctx context.Context, skins ...models.Skin)) *MockSkinAutocomplete_Add_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]models.Skin, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(models.Skin)
+			}
+		}
+		run(args[0].(context.Context), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockSkinAutocomplete_Add_Call) Return(_a0 error) *MockSkinAutocomplete_Add_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockSkinAutocomplete_Add_Call) RunAndReturn(run func(context.Context, ...models.Skin) error) *MockSkinAutocomplete_Add_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Index provides a mock function with given fields: ctx, skins
+func (_m *MockSkinAutocomplete) Index(ctx context.Context, skins ...models.Skin) error {
+	_va := make([]interface{}, len(skins))
+	for _i := range skins {
+		_va[_i] = skins[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Index")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, ...models.Skin) error); ok {
+		r0 = rf(ctx, skins...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockSkinAutocomplete_Index_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Index'
+type MockSkinAutocomplete_Index_Call struct {
+	*mock.Call
+}
+
+// Index is a helper method to define mock.On call
+//   - ctx context.Context
+//   - skins ...models.Skin
+func (_e *MockSkinAutocomplete_Expecter) Index(ctx interface{}, skins ...interface{}) *MockSkinAutocomplete_Index_Call {
+	return &MockSkinAutocomplete_Index_Call{Call: _e.mock.On("Index",
+		append([]interface{}{ctx}, skins...)...)}
+}
+
+func (_c *MockSkinAutocomplete_Index_Call) Run(run func(ctx context.Context, skins ...models.Skin)) *MockSkinAutocomplete_Index_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]models.Skin, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(models.Skin)
+			}
+		}
+		run(args[0].(context.Context), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockSkinAutocomplete_Index_Call) Return(_a0 error) *MockSkinAutocomplete_Index_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockSkinAutocomplete_Index_Call) RunAndReturn(run func(context.Context, ...models.Skin) error) *MockSkinAutocomplete_Index_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Rebuild provides a mock function with given fields: ctx, skins
+func (_m *MockSkinAutocomplete) Rebuild(ctx context.Context, skins []models.Skin) error {
+	ret := _m.Called(ctx, skins)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Rebuild")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []models.Skin) error); ok {
+		r0 = rf(ctx, skins)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockSkinAutocomplete_Rebuild_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Rebuild'
+type MockSkinAutocomplete_Rebuild_Call struct {
+	*mock.Call
+}
+
+// Rebuild is a helper method to define mock.On call
+//   - ctx context.Context
+//   - skins []models.Skin
+func (_e *MockSkinAutocomplete_Expecter) Rebuild(ctx interface{}, skins interface{}) *MockSkinAutocomplete_Rebuild_Call {
+	return &MockSkinAutocomplete_Rebuild_Call{Call: _e.mock.On("Rebuild", ctx, skins)}
+}
+
+func (_c *MockSkinAutocomplete_Rebuild_Call) Run(run func(ctx context.Context, skins []models.Skin)) *MockSkinAutocomplete_Rebuild_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]models.Skin))
+	})
+	return _c
+}
+
+func (_c *MockSkinAutocomplete_Rebuild_Call) Return(_a0 error) *MockSkinAutocomplete_Rebuild_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockSkinAutocomplete_Rebuild_Call) RunAndReturn(run func(context.Context, []models.Skin) error) *MockSkinAutocomplete_Rebuild_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordView provides a mock function with given fields: ctx, slug
+func (_m *MockSkinAutocomplete) RecordView(ctx context.Context, slug string) error {
+	ret := _m.Called(ctx, slug)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordView")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, slug)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockSkinAutocomplete_RecordView_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordView'
+type MockSkinAutocomplete_RecordView_Call struct {
+	*mock.Call
+}
+
+// RecordView is a helper method to define mock.On call
+//   - ctx context.Context
+//   - slug string
+func (_e *MockSkinAutocomplete_Expecter) RecordView(ctx interface{}, slug interface{}) *MockSkinAutocomplete_RecordView_Call {
+	return &MockSkinAutocomplete_RecordView_Call{Call: _e.mock.On("RecordView", ctx, slug)}
+}
+
+func (_c *MockSkinAutocomplete_RecordView_Call) Run(run func(ctx context.Context, slug string)) *MockSkinAutocomplete_RecordView_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockSkinAutocomplete_RecordView_Call) Return(_a0 error) *MockSkinAutocomplete_RecordView_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockSkinAutocomplete_RecordView_Call) RunAndReturn(run func(context.Context, string) error) *MockSkinAutocomplete_RecordView_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Remove provides a mock function with given fields: ctx, slug
+func (_m *MockSkinAutocomplete) Remove(ctx context.Context, slug string) error {
+	ret := _m.Called(ctx, slug)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Remove")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, slug)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockSkinAutocomplete_Remove_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Remove'
+type MockSkinAutocomplete_Remove_Call struct {
+	*mock.Call
+}
+
+// Remove is a helper method to define mock.On call
+//   - ctx context.Context
+//   - slug string
+func (_e *MockSkinAutocomplete_Expecter) Remove(ctx interface{}, slug interface{}) *MockSkinAutocomplete_Remove_Call {
+	return &MockSkinAutocomplete_Remove_Call{Call: _e.mock.On("Remove", ctx, slug)}
+}
+
+func (_c *MockSkinAutocomplete_Remove_Call) Run(run func(ctx context.Context, slug string)) *MockSkinAutocomplete_Remove_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockSkinAutocomplete_Remove_Call) Return(_a0 error) *MockSkinAutocomplete_Remove_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockSkinAutocomplete_Remove_Call) RunAndReturn(run func(context.Context, string) error) *MockSkinAutocomplete_Remove_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Suggest provides a mock function with given fields: ctx, query, limit
+func (_m *MockSkinAutocomplete) Suggest(ctx context.Context, query string, limit int) ([]models.AutocompleteSuggestion, error) {
+	ret := _m.Called(ctx, query, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Suggest")
+	}
+
+	var r0 []models.AutocompleteSuggestion
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) ([]models.AutocompleteSuggestion, error)); ok {
+		return rf(ctx, query, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) []models.AutocompleteSuggestion); ok {
+		r0 = rf(ctx, query, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.AutocompleteSuggestion)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
+		r1 = rf(ctx, query, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSkinAutocomplete_Suggest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Suggest'
+type MockSkinAutocomplete_Suggest_Call struct {
+	*mock.Call
+}
+
+// Suggest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - query string
+//   - limit int
+func (_e *MockSkinAutocomplete_Expecter) Suggest(ctx interface{}, query interface{}, limit interface{}) *MockSkinAutocomplete_Suggest_Call {
+	return &MockSkinAutocomplete_Suggest_Call{Call: _e.mock.On("Suggest", ctx, query, limit)}
+}
+
+func (_c *MockSkinAutocomplete_Suggest_Call) Run(run func(ctx context.Context, query string, limit int)) *MockSkinAutocomplete_Suggest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockSkinAutocomplete_Suggest_Call) Return(_a0 []models.AutocompleteSuggestion, _a1 error) *MockSkinAutocomplete_Suggest_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSkinAutocomplete_Suggest_Call) RunAndReturn(run func(context.Context, string, int) ([]models.AutocompleteSuggestion, error)) *MockSkinAutocomplete_Suggest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSkinAutocomplete creates a new instance of MockSkinAutocomplete. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSkinAutocomplete(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSkinAutocomplete {
+	mock := &MockSkinAutocomplete{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,79 @@
+package skinservice
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kedr891/cs-parser/internal/models"
+)
+
+// ProcessSkinDiscovered добавляет найденный на маркетплейсе предмет в каталог, если его там еще нет.
+// Повторное событие не трогает цены и объем существующего скина: оно только заполняет пустые
+// rarity и image_url, а начальная точка истории цен пишется лишь для нового скина.
+func (s *Service) ProcessSkinDiscovered(ctx context.Context, event *models.SkinDiscoveredEvent) error {
+	if event.MarketHashName == "" {
+		return fmt.Errorf("market_hash_name is required")
+	}
+
+	weapon, name, quality := models.ParseMarketHashName(event.MarketHashName)
+	if event.Weapon != "" {
+		weapon = event.Weapon
+	}
+	if event.Name != "" {
+		name = event.Name
+	}
+	if event.Quality != "" {
+		quality = event.Quality
+	}
+
+	skin := models.NewSkin(event.MarketHashName, name, weapon, quality)
+	skin.Rarity = event.Rarity
+	skin.ImageURL = event.ImageURL
+	skin.CurrentPrice = event.InitialPrice
+	skin.LowestPrice = event.InitialPrice
+	skin.HighestPrice = event.InitialPrice
+	if !event.Timestamp.IsZero() {
+		skin.LastUpdated = event.Timestamp
+	}
+
+	if err := skin.Validate(); err != nil {
+		return err
+	}
+
+	created, err := s.storage.CreateDiscoveredSkin(ctx, skin)
+	if err != nil {
+		return fmt.Errorf("create discovered skin: %w", err)
+	}
+	if !created {
+		// списки живут пару минут, а обнаружение повторяется на каждом проходе парсера, поэтому сбрасываем только карточку
+		_ = s.cache.Delete(ctx, "skins:detail:"+skin.Slug)
+		s.log.Debug("Skin rediscovered", "market_hash_name", skin.MarketHashName, "source", event.Source)
+		return nil
+	}
+
+	_ = s.cache.Delete(ctx, "skins:list:*")
+	s.addAutocomplete(ctx, skin)
+
+	if event.InitialPrice > 0 {
+		history := models.NewPriceHistory(skin.ID, event.InitialPrice, event.Source, 0)
+		if event.Currency != "" {
+			history.Currency = event.Currency
+		}
+		if !event.Timestamp.IsZero() {
+			history.RecordedAt = event.Timestamp
+		}
+
+		if err := s.storage.CreateInitialPriceHistory(ctx, skin.Weapon, history); err != nil {
+			return fmt.Errorf("create initial price history: %w", err)
+		}
+	}
+
+	s.log.Info("Skin discovered",
+		"skin_id", skin.ID,
+		"market_hash_name", skin.MarketHashName,
+		"source", event.Source,
+		"initial_price", event.InitialPrice,
+	)
+
+	return nil
+}
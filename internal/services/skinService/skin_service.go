@@ -4,7 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"hash/fnv"
 	"log/slog"
+	"strconv"
 	"time"
 
 	"github.com/google/uuid"
@@ -15,10 +17,18 @@ type SkinStorage interface {
 	GetSkins(ctx context.Context, filter *models.SkinFilter) ([]models.Skin, int, error)
 	GetSkinBySlug(ctx context.Context, slug string) (*models.Skin, error)
 	GetPriceHistory(ctx context.Context, skinID uuid.UUID, period models.PriceStatsPeriod) ([]models.PriceHistory, error)
+	GetPriceCandles(ctx context.Context, skin *models.Skin, source models.PriceSource, period models.PriceStatsPeriod, interval models.ChartInterval) ([]models.PriceCandle, error)
 	GetSkinStatistics(ctx context.Context, skinID uuid.UUID) (*models.SkinStatistics, error)
 	SearchSkins(ctx context.Context, query string, limit int) ([]models.Skin, error)
+	GetSkinFacets(ctx context.Context, filter *models.SkinFilter, bounds []float64) ([]models.SkinFacetRow, error)
 	GetPopularSkins(ctx context.Context, limit int) ([]models.Skin, error)
+	GetSkinsBySlugsOrIDs(ctx context.Context, slugs, ids []string) ([]models.Skin, error)
+	GetAllSkins(ctx context.Context) ([]models.Skin, error)
 	CreateSkin(ctx context.Context, skin *models.Skin) error
+	CreateDiscoveredSkin(ctx context.Context, skin *models.Skin) (bool, error)
+	UpdateSkinFields(ctx context.Context, skin *models.Skin, previousWeapon string, fields []string) error
+	DeleteSkin(ctx context.Context, skin *models.Skin) error
+	CreateInitialPriceHistory(ctx context.Context, weapon string, history *models.PriceHistory) error
 }
 
 type SkinCache interface {
@@ -29,12 +39,23 @@ type SkinCache interface {
 	SetSkinList(ctx context.Context, cacheKey string, response *models.SkinListResponse, ttl time.Duration) error
 	GetSkinDetail(ctx context.Context, slug string) (*models.SkinDetailResponse, bool)
 	SetSkinDetail(ctx context.Context, slug string, response *models.SkinDetailResponse, ttl time.Duration) error
+	RecordSearch(ctx context.Context, query string, zeroResults bool, at time.Time) error
+}
+
+type SkinAutocomplete interface {
+	Index(ctx context.Context, skins ...models.Skin) error
+	Add(ctx context.Context, skins ...models.Skin) error
+	Remove(ctx context.Context, slug string) error
+	RecordView(ctx context.Context, slug string) error
+	Suggest(ctx context.Context, query string, limit int) ([]models.AutocompleteSuggestion, error)
+	Rebuild(ctx context.Context, skins []models.Skin) error
 }
 
 type Service struct {
-	storage SkinStorage
-	cache   SkinCache
-	log     *slog.Logger
+	storage      SkinStorage
+	cache        SkinCache
+	autocomplete SkinAutocomplete
+	log          *slog.Logger
 }
 
 func New(storage SkinStorage, cache SkinCache, log *slog.Logger) *Service {
@@ -45,7 +66,18 @@ func New(storage SkinStorage, cache SkinCache, log *slog.Logger) *Service {
 	}
 }
 
+// WithAutocomplete включает индекс подсказок: скины попадают в него при создании и изменении,
+// а просмотры карточек поднимают их вес.
+func (s *Service) WithAutocomplete(autocomplete SkinAutocomplete) *Service {
+	s.autocomplete = autocomplete
+	return s
+}
+
 func (s *Service) GetSkins(ctx context.Context, filter *models.SkinFilter) (*models.SkinListResponse, error) {
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+
 	cacheKey := s.generateCacheKey(filter)
 
 	if cached, ok := s.cache.GetSkinList(ctx, cacheKey); ok {
@@ -53,21 +85,32 @@ func (s *Service) GetSkins(ctx context.Context, filter *models.SkinFilter) (*mod
 		return cached, nil
 	}
 
-	skins, total, err := s.storage.GetSkins(ctx, filter)
+	// на один скин больше страницы, чтобы понять, есть ли следующая
+	query := *filter
+	query.Limit = filter.Limit + 1
+
+	skins, total, err := s.storage.GetSkins(ctx, &query)
 	if err != nil {
 		return nil, fmt.Errorf("get skins from storage: %w", err)
 	}
 
+	var nextPageToken string
+	if len(skins) > filter.Limit {
+		skins = skins[:filter.Limit]
+		nextPageToken = models.NewSkinCursor(filter, &skins[len(skins)-1]).Encode()
+	}
+
 	pageSize := filter.Limit
 	page := (filter.Offset / pageSize) + 1
 	totalPages := (total + pageSize - 1) / pageSize
 
 	response := &models.SkinListResponse{
-		Skins:      skins,
-		Total:      total,
-		Page:       page,
-		PageSize:   pageSize,
-		TotalPages: totalPages,
+		Skins:         skins,
+		Total:         total,
+		Page:          page,
+		PageSize:      pageSize,
+		TotalPages:    totalPages,
+		NextPageToken: nextPageToken,
 	}
 
 	_ = s.cache.SetSkinList(ctx, cacheKey, response, 2*time.Minute)
@@ -77,7 +120,7 @@ func (s *Service) GetSkins(ctx context.Context, filter *models.SkinFilter) (*mod
 
 func (s *Service) GetSkinBySlug(ctx context.Context, slug string, period models.PriceStatsPeriod) (*models.SkinDetailResponse, error) {
 	if cached, ok := s.cache.GetSkinDetail(ctx, slug); ok {
-		s.incrementViewCount(ctx, cached.Skin.ID)
+		s.incrementViewCount(ctx, &cached.Skin)
 		return cached, nil
 	}
 
@@ -109,17 +152,74 @@ func (s *Service) GetSkinBySlug(ctx context.Context, slug string, period models.
 
 	_ = s.cache.SetSkinDetail(ctx, slug, response, 5*time.Minute)
 
-	s.incrementViewCount(ctx, skin.ID)
+	s.incrementViewCount(ctx, skin)
 
 	return response, nil
 }
 
-func (s *Service) GetPriceChart(ctx context.Context, slug string, period models.PriceStatsPeriod) (*models.PriceChartResponse, error) {
+// BatchGetSkins находит скины по ключам: ключ, который разбирается как UUID, считается id, остальные - slug.
+// Результат идет в порядке ключей запроса, для ненайденных ключей Skin равен nil.
+func (s *Service) BatchGetSkins(ctx context.Context, keys []string) ([]models.SkinLookup, error) {
+	var slugs, ids []string
+	seen := make(map[string]bool, len(keys))
+	for _, key := range keys {
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		if id, err := uuid.Parse(key); err == nil {
+			ids = append(ids, id.String())
+		} else {
+			slugs = append(slugs, key)
+		}
+	}
+
+	skins, err := s.storage.GetSkinsBySlugsOrIDs(ctx, slugs, ids)
+	if err != nil {
+		return nil, fmt.Errorf("get skins by slugs or ids: %w", err)
+	}
+
+	byKey := make(map[string]*models.Skin, 2*len(skins))
+	for i := range skins {
+		byKey[skins[i].Slug] = &skins[i]
+		byKey[skins[i].ID.String()] = &skins[i]
+	}
+
+	result := make([]models.SkinLookup, len(keys))
+	for i, key := range keys {
+		lookupKey := key
+		if id, err := uuid.Parse(key); err == nil {
+			lookupKey = id.String()
+		}
+		result[i] = models.SkinLookup{Key: key, Skin: byKey[lookupKey]}
+	}
+
+	return result, nil
+}
+
+// GetPriceChart отдает график цены за period. Без interval это все точки истории,
+// с interval - OHLCV-свечи площадки source (по умолчанию steam_market),
+// а DataPoints содержат цену закрытия каждой свечи.
+func (s *Service) GetPriceChart(ctx context.Context, slug string, period models.PriceStatsPeriod, interval models.ChartInterval, source models.PriceSource) (*models.PriceChartResponse, error) {
+	if interval != "" {
+		if err := models.ValidateCandleRange(period, interval); err != nil {
+			return nil, err
+		}
+	}
+
 	skin, err := s.storage.GetSkinBySlug(ctx, slug)
 	if err != nil {
 		return nil, fmt.Errorf("get skin by slug: %w", err)
 	}
 
+	if interval != "" {
+		if source == "" {
+			source = models.SourceSteamMarket
+		}
+		return s.getPriceCandleChart(ctx, skin, source, period, interval)
+	}
+
 	history, err := s.storage.GetPriceHistory(ctx, skin.ID, period)
 	if err != nil {
 		return nil, fmt.Errorf("get price history: %w", err)
@@ -169,18 +269,114 @@ func (s *Service) GetPriceChart(ctx context.Context, slug string, period models.
 	}, nil
 }
 
+func (s *Service) getPriceCandleChart(ctx context.Context, skin *models.Skin, source models.PriceSource, period models.PriceStatsPeriod, interval models.ChartInterval) (*models.PriceChartResponse, error) {
+	candles, err := s.storage.GetPriceCandles(ctx, skin, source, period, interval)
+	if err != nil {
+		return nil, fmt.Errorf("get price candles: %w", err)
+	}
+
+	chart := &models.PriceChartResponse{
+		SkinID:     skin.ID,
+		Period:     string(period),
+		Interval:   string(interval),
+		Source:     string(source),
+		DataPoints: make([]models.PriceChartData, len(candles)),
+		Candles:    candles,
+	}
+	if len(candles) == 0 {
+		return chart, nil
+	}
+
+	chart.MinPrice = candles[0].Low
+	chart.MaxPrice = candles[0].High
+
+	var sumPrice float64
+	var points int
+
+	for i, c := range candles {
+		chart.DataPoints[i] = models.PriceChartData{
+			Timestamp: c.Timestamp,
+			Price:     c.Close,
+			Volume:    c.Volume,
+		}
+
+		if c.Low < chart.MinPrice {
+			chart.MinPrice = c.Low
+		}
+		if c.High > chart.MaxPrice {
+			chart.MaxPrice = c.High
+		}
+
+		// среднее по всем точкам истории, а не по свечам
+		sumPrice += c.AvgPrice * float64(c.Count)
+		points += c.Count
+	}
+
+	// объем свечи - снимок за 24 часа, сумма по свечам его бы завышала; берется последний
+	chart.TotalVolume = candles[len(candles)-1].Volume
+
+	if points > 0 {
+		chart.AvgPrice = sumPrice / float64(points)
+	}
+
+	return chart, nil
+}
+
+// GetSkinFacets считает скины фильтра по оружию, качеству, редкости, категории и ценовым диапазонам.
+// Пустой bounds означает models.DefaultPriceBuckets.
+func (s *Service) GetSkinFacets(ctx context.Context, filter *models.SkinFilter, bounds []float64) (*models.SkinFacets, error) {
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+
+	bounds, err := models.NormalizePriceBuckets(bounds)
+	if err != nil {
+		return nil, err
+	}
+
+	cacheKey := s.facetsCacheKey(filter, bounds)
+	if data, err := s.cache.Get(ctx, cacheKey); err == nil {
+		var facets models.SkinFacets
+		if json.Unmarshal([]byte(data), &facets) == nil {
+			return &facets, nil
+		}
+	}
+
+	rows, err := s.storage.GetSkinFacets(ctx, filter, bounds)
+	if err != nil {
+		return nil, fmt.Errorf("get skin facets: %w", err)
+	}
+
+	facets := models.NewSkinFacets(rows, bounds)
+	if data, err := json.Marshal(facets); err == nil {
+		_ = s.cache.Set(ctx, cacheKey, string(data), 2*time.Minute)
+	}
+
+	return facets, nil
+}
+
 func (s *Service) SearchSkins(ctx context.Context, query string, limit int) ([]models.Skin, error) {
 	skins, err := s.storage.SearchSkins(ctx, query, limit)
 	if err != nil {
 		return nil, fmt.Errorf("search skins: %w", err)
 	}
 
-	key := fmt.Sprintf("analytics:popular:search:%s", query)
-	_ = s.cache.Set(ctx, key, "1", 24*time.Hour)
+	s.recordSearch(ctx, query, len(skins) == 0)
 
 	return skins, nil
 }
 
+// recordSearch считает нормализованный запрос в статистике поиска; пустые после нормализации запросы не считаются.
+func (s *Service) recordSearch(ctx context.Context, query string, zeroResults bool) {
+	query = models.NormalizeSearchStatsQuery(query)
+	if query == "" {
+		return
+	}
+	if err := s.cache.RecordSearch(ctx, query, zeroResults, time.Now()); err != nil {
+		s.log.Warn("failed to record search", "query", query, "error", err)
+	}
+}
+
 func (s *Service) GetPopularSkins(ctx context.Context, limit int) ([]models.Skin, error) {
 	cacheKey := fmt.Sprintf("skins:popular:%d", limit)
 
@@ -205,21 +401,44 @@ func (s *Service) GetPopularSkins(ctx context.Context, limit int) ([]models.Skin
 
 func (s *Service) generateCacheKey(filter *models.SkinFilter) string {
 	return fmt.Sprintf(
-		"skins:list:%s:%s:%.2f-%.2f:%s:%s:%d:%d",
-		filter.Weapon,
-		filter.Quality,
-		filter.MinPrice,
-		filter.MaxPrice,
+		"skins:list:%s:%s:%s:%d:%d:%s",
+		filterConditionsKey(filter),
 		filter.SortBy,
 		filter.SortOrder,
 		filter.Limit,
 		filter.Offset,
+		filter.PageToken,
 	)
 }
 
-func (s *Service) incrementViewCount(ctx context.Context, skinID uuid.UUID) {
-	key := fmt.Sprintf("analytics:views:%s", skinID.String())
+// facetsCacheKey зависит только от условий фильтра: сортировка и страница на счетчики не влияют.
+// Префикс skins:list: нужен, чтобы фасеты сбрасывались вместе со списками.
+func (s *Service) facetsCacheKey(filter *models.SkinFilter, bounds []float64) string {
+	return fmt.Sprintf("skins:list:facets:%s:%v", filterConditionsKey(filter), bounds)
+}
+
+// filterConditionsKey - хеш условий фильтра без сортировки и пагинации.
+// Условий слишком много, чтобы перечислять их в ключе по отдельности.
+func filterConditionsKey(filter *models.SkinFilter) string {
+	conditions := *filter
+	conditions.SortBy, conditions.SortOrder = "", ""
+	conditions.Limit, conditions.Offset, conditions.PageToken = 0, 0, ""
+
+	data, _ := json.Marshal(conditions)
+	hash := fnv.New64a()
+	_, _ = hash.Write(data)
+	return strconv.FormatUint(hash.Sum64(), 16)
+}
+
+func (s *Service) incrementViewCount(ctx context.Context, skin *models.Skin) {
+	key := fmt.Sprintf("analytics:views:%s", skin.ID.String())
 	_ = s.cache.Set(ctx, key, "1", 24*time.Hour)
+
+	if s.autocomplete != nil {
+		if err := s.autocomplete.RecordView(ctx, skin.Slug); err != nil {
+			s.log.Warn("failed to record autocomplete view", "slug", skin.Slug, "error", err)
+		}
+	}
 }
 
 func (s *Service) getViewCount(ctx context.Context, skinID uuid.UUID) (int64, error) {
@@ -236,11 +455,128 @@ func (s *Service) InvalidateSkinCache(ctx context.Context, skinID uuid.UUID) err
 }
 
 func (s *Service) CreateSkin(ctx context.Context, skin *models.Skin) error {
+	if skin.Currency == "" {
+		skin.Currency = "USD"
+	}
+	if err := skin.Validate(); err != nil {
+		return err
+	}
+
 	if err := s.storage.CreateSkin(ctx, skin); err != nil {
 		return fmt.Errorf("create skin: %w", err)
 	}
 
 	_ = s.cache.Delete(ctx, "skins:list:*")
+	s.addAutocomplete(ctx, skin)
+
+	return nil
+}
+
+// UpdateSkin меняет у скина только поля из fields. Смена оружия может перенести скин на другой шард.
+func (s *Service) UpdateSkin(ctx context.Context, slug string, patch *models.Skin, fields []string) (*models.Skin, error) {
+	skin, err := s.storage.GetSkinBySlug(ctx, slug)
+	if err != nil {
+		return nil, fmt.Errorf("get skin by slug: %w", err)
+	}
+
+	previousWeapon := skin.Weapon
+	if err := skin.ApplyUpdate(patch, fields); err != nil {
+		return nil, err
+	}
+	if err := skin.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := s.storage.UpdateSkinFields(ctx, skin, previousWeapon, fields); err != nil {
+		return nil, fmt.Errorf("update skin: %w", err)
+	}
+
+	s.invalidateSkin(ctx, slug)
+	s.indexAutocomplete(ctx, skin)
+
+	if skin.Weapon != previousWeapon {
+		s.log.Info("Skin weapon changed", "slug", slug, "from", previousWeapon, "to", skin.Weapon)
+	}
+
+	return skin, nil
+}
+
+func (s *Service) DeleteSkin(ctx context.Context, slug string) error {
+	skin, err := s.storage.GetSkinBySlug(ctx, slug)
+	if err != nil {
+		return fmt.Errorf("get skin by slug: %w", err)
+	}
+
+	if err := s.storage.DeleteSkin(ctx, skin); err != nil {
+		return fmt.Errorf("delete skin: %w", err)
+	}
+
+	s.invalidateSkin(ctx, slug)
+
+	if s.autocomplete != nil {
+		if err := s.autocomplete.Remove(ctx, slug); err != nil {
+			s.log.Warn("failed to remove skin from autocomplete", "slug", slug, "error", err)
+		}
+	}
 
 	return nil
 }
+
+// invalidateSkin сбрасывает карточку скина и все закешированные списки, в которые он мог попасть.
+func (s *Service) invalidateSkin(ctx context.Context, slug string) {
+	for _, key := range []string{"skins:detail:" + slug, "skins:list:*", "skins:popular:*"} {
+		if err := s.cache.Delete(ctx, key); err != nil {
+			s.log.Warn("failed to invalidate skin cache", "key", key, "error", err)
+		}
+	}
+}
+
+// indexAutocomplete обновляет подсказку скина. Ошибка индекса не отменяет запись в каталог:
+// подсказка восстановится при следующем изменении скина или перестроении индекса.
+func (s *Service) indexAutocomplete(ctx context.Context, skin *models.Skin) {
+	if s.autocomplete == nil {
+		return
+	}
+	if err := s.autocomplete.Index(ctx, *skin); err != nil {
+		s.log.Warn("failed to index skin for autocomplete", "slug", skin.Slug, "error", err)
+	}
+}
+
+// addAutocomplete - indexAutocomplete для нового скина: вес уже проиндексированного скина не понижается.
+func (s *Service) addAutocomplete(ctx context.Context, skin *models.Skin) {
+	if s.autocomplete == nil {
+		return
+	}
+	if err := s.autocomplete.Add(ctx, *skin); err != nil {
+		s.log.Warn("failed to index skin for autocomplete", "slug", skin.Slug, "error", err)
+	}
+}
+
+func (s *Service) Autocomplete(ctx context.Context, query string, limit int) ([]models.AutocompleteSuggestion, error) {
+	if s.autocomplete == nil {
+		return nil, models.ErrAutocompleteUnavailable
+	}
+
+	suggestions, err := s.autocomplete.Suggest(ctx, query, limit)
+	if err != nil {
+		return nil, fmt.Errorf("autocomplete: %w", err)
+	}
+	return suggestions, nil
+}
+
+// RebuildAutocomplete заново строит индекс подсказок по скинам со всех шардов и возвращает их число.
+func (s *Service) RebuildAutocomplete(ctx context.Context) (int, error) {
+	if s.autocomplete == nil {
+		return 0, models.ErrAutocompleteUnavailable
+	}
+
+	skins, err := s.storage.GetAllSkins(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("get all skins: %w", err)
+	}
+
+	if err := s.autocomplete.Rebuild(ctx, skins); err != nil {
+		return 0, fmt.Errorf("rebuild autocomplete: %w", err)
+	}
+	return len(skins), nil
+}
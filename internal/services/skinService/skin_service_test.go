@@ -5,6 +5,7 @@ import (
 	"errors"
 	"log/slog"
 	"os"
+	"strings"
 	"testing"
 	"time"
 
@@ -71,7 +72,7 @@ func (suite *SkinServiceSuite) TestGetSkins_Success_CacheMiss() {
 	suite.mockCache.On("GetSkinList", suite.ctx, suite.service.generateCacheKey(filter)).
 		Return(nil, false)
 
-	suite.mockStorage.On("GetSkins", suite.ctx, filter).
+	suite.mockStorage.On("GetSkins", suite.ctx, pageQuery(filter)).
 		Return(expectedSkins, 1, nil)
 
 	suite.mockCache.On("SetSkinList", suite.ctx, suite.service.generateCacheKey(filter),
@@ -93,6 +94,67 @@ func (suite *SkinServiceSuite) TestGetSkins_Success_CacheMiss() {
 	suite.Equal("ak47-redline", result.Skins[0].Slug)
 }
 
+// pageQuery - фильтр, с которым сервис идет в хранилище: на один скин больше страницы.
+func pageQuery(filter *models.SkinFilter) *models.SkinFilter {
+	query := *filter
+	query.Limit = filter.Limit + 1
+	return &query
+}
+
+func (suite *SkinServiceSuite) TestGetSkins_ReturnsNextPageToken() {
+	filter := &models.SkinFilter{Limit: 2, SortBy: "price", SortOrder: "asc"}
+
+	skins := []models.Skin{
+		{ID: uuid.New(), Slug: "a", CurrentPrice: 1},
+		{ID: uuid.New(), Slug: "b", CurrentPrice: 2},
+		{ID: uuid.New(), Slug: "c", CurrentPrice: 3},
+	}
+
+	suite.mockCache.On("GetSkinList", suite.ctx, suite.service.generateCacheKey(filter)).
+		Return(nil, false)
+
+	suite.mockStorage.On("GetSkins", suite.ctx, pageQuery(filter)).
+		Return(skins, 5, nil)
+
+	suite.mockCache.On("SetSkinList", suite.ctx, suite.service.generateCacheKey(filter), mock.Anything, 2*time.Minute).
+		Return(nil)
+
+	result, err := suite.service.GetSkins(suite.ctx, filter)
+
+	suite.NoError(err)
+	suite.Len(result.Skins, 2)
+	suite.NotEmpty(result.NextPageToken)
+
+	next := &models.SkinFilter{Limit: 2, SortBy: "price", SortOrder: "asc", PageToken: result.NextPageToken}
+	cursor, err := models.DecodeSkinCursor(next)
+	suite.NoError(err)
+	suite.Equal(skins[1].ID, cursor.ID)
+	suite.Equal("2", cursor.Value)
+
+	next.SortOrder = "desc"
+	_, err = models.DecodeSkinCursor(next)
+	suite.ErrorIs(err, models.ErrInvalidPageToken)
+}
+
+func (suite *SkinServiceSuite) TestGetSkins_LastPageHasNoToken() {
+	filter := &models.SkinFilter{Limit: 2}
+
+	suite.mockCache.On("GetSkinList", suite.ctx, suite.service.generateCacheKey(filter)).
+		Return(nil, false)
+
+	suite.mockStorage.On("GetSkins", suite.ctx, pageQuery(filter)).
+		Return([]models.Skin{{ID: uuid.New()}, {ID: uuid.New()}}, 2, nil)
+
+	suite.mockCache.On("SetSkinList", suite.ctx, suite.service.generateCacheKey(filter), mock.Anything, 2*time.Minute).
+		Return(nil)
+
+	result, err := suite.service.GetSkins(suite.ctx, filter)
+
+	suite.NoError(err)
+	suite.Len(result.Skins, 2)
+	suite.Empty(result.NextPageToken)
+}
+
 func (suite *SkinServiceSuite) TestGetSkins_Success_CacheHit() {
 	filter := &models.SkinFilter{
 		Weapon: "AWP",
@@ -133,7 +195,7 @@ func (suite *SkinServiceSuite) TestGetSkins_StorageError() {
 	suite.mockCache.On("GetSkinList", suite.ctx, suite.service.generateCacheKey(filter)).
 		Return(nil, false)
 
-	suite.mockStorage.On("GetSkins", suite.ctx, filter).
+	suite.mockStorage.On("GetSkins", suite.ctx, pageQuery(filter)).
 		Return(nil, 0, errors.New("database connection failed"))
 
 	result, err := suite.service.GetSkins(suite.ctx, filter)
@@ -277,8 +339,7 @@ func (suite *SkinServiceSuite) TestSearchSkins_Success() {
 	suite.mockStorage.On("SearchSkins", suite.ctx, query, limit).
 		Return(expectedSkins, nil)
 
-	searchKey := "analytics:popular:search:" + query
-	suite.mockCache.On("Set", suite.ctx, searchKey, "1", 24*time.Hour).
+	suite.mockCache.On("RecordSearch", suite.ctx, query, false, mock.AnythingOfType("time.Time")).
 		Return(nil)
 
 	result, err := suite.service.SearchSkins(suite.ctx, query, limit)
@@ -289,6 +350,20 @@ func (suite *SkinServiceSuite) TestSearchSkins_Success() {
 	suite.Equal("awp-asiimov", result[0].Slug)
 }
 
+func (suite *SkinServiceSuite) TestSearchSkins_RecordsNormalizedZeroResultQuery() {
+	suite.mockStorage.On("SearchSkins", suite.ctx, "  AWP | Dragon-Lore ", 10).
+		Return([]models.Skin{}, nil)
+
+	suite.mockCache.On("RecordSearch", suite.ctx, "awp dragon lore", true, mock.AnythingOfType("time.Time")).
+		Return(errors.New("redis down"))
+
+	result, err := suite.service.SearchSkins(suite.ctx, "  AWP | Dragon-Lore ", 10)
+
+	suite.NoError(err)
+	suite.Empty(result)
+	suite.mockCache.AssertExpectations(suite.T())
+}
+
 func (suite *SkinServiceSuite) TestGetPopularSkins_Success() {
 	limit := 5
 
@@ -385,3 +460,552 @@ func (suite *SkinServiceSuite) TestCreateSkin_InvalidatesCache() {
 	suite.NoError(err)
 	suite.mockCache.AssertCalled(suite.T(), "Delete", suite.ctx, "skins:list:*")
 }
+
+func (suite *SkinServiceSuite) TestCreateSkin_DefaultsCurrency() {
+	skin := models.NewSkin("", "Redline", "AK-47", "Field-Tested")
+	skin.Currency = ""
+
+	suite.mockStorage.On("CreateSkin", suite.ctx, skin).
+		Return(nil)
+
+	suite.mockCache.On("Delete", suite.ctx, "skins:list:*").
+		Return(nil)
+
+	err := suite.service.CreateSkin(suite.ctx, skin)
+
+	suite.NoError(err)
+	suite.Equal("USD", skin.Currency)
+}
+
+func (suite *SkinServiceSuite) TestCreateSkin_InvalidQuality() {
+	skin := models.NewSkin("", "Redline", "AK-47", "Brand New")
+
+	err := suite.service.CreateSkin(suite.ctx, skin)
+
+	suite.ErrorIs(err, models.ErrInvalidSkin)
+	suite.Contains(err.Error(), "quality")
+	suite.mockStorage.AssertNotCalled(suite.T(), "CreateSkin", suite.ctx, skin)
+}
+
+func (suite *SkinServiceSuite) TestCreateSkin_InvalidRarity() {
+	skin := models.NewSkin("", "Redline", "AK-47", "Field-Tested")
+	skin.Rarity = "Legendary"
+
+	err := suite.service.CreateSkin(suite.ctx, skin)
+
+	suite.ErrorIs(err, models.ErrInvalidSkin)
+	suite.Contains(err.Error(), "rarity")
+}
+
+func (suite *SkinServiceSuite) TestCreateSkin_MissingName() {
+	skin := models.NewSkin("", "", "AK-47", "Field-Tested")
+
+	err := suite.service.CreateSkin(suite.ctx, skin)
+
+	suite.ErrorIs(err, models.ErrInvalidSkin)
+}
+
+func (suite *SkinServiceSuite) TestCreateSkin_NegativePrice() {
+	skin := models.NewSkin("", "Redline", "AK-47", "Field-Tested")
+	skin.CurrentPrice = -1
+
+	err := suite.service.CreateSkin(suite.ctx, skin)
+
+	suite.ErrorIs(err, models.ErrInvalidSkin)
+}
+
+func (suite *SkinServiceSuite) TestProcessSkinDiscovered_CreatesSkinWithInitialPrice() {
+	event := models.NewSkinDiscoveredEvent(
+		"StatTrak™ AK-47 | Redline (Field-Tested)",
+		"", "", "",
+		"Classified",
+		31.45,
+		"steam_market",
+		"https://example.com/img.jpg",
+	)
+
+	var createdSkin *models.Skin
+	suite.mockStorage.On("CreateDiscoveredSkin", suite.ctx, mock.MatchedBy(func(skin *models.Skin) bool {
+		createdSkin = skin
+		return skin.MarketHashName == event.MarketHashName &&
+			skin.Weapon == "AK-47" &&
+			skin.Name == "Redline" &&
+			skin.Quality == "Field-Tested" &&
+			skin.Rarity == "Classified" &&
+			skin.CurrentPrice == 31.45
+	})).
+		Return(true, nil)
+
+	suite.mockCache.On("Delete", suite.ctx, "skins:list:*").
+		Return(nil)
+
+	suite.mockStorage.On("CreateInitialPriceHistory", suite.ctx, "AK-47", mock.MatchedBy(func(history *models.PriceHistory) bool {
+		return history.SkinID == createdSkin.ID &&
+			history.Price == 31.45 &&
+			history.Source == "steam_market"
+	})).
+		Return(nil)
+
+	err := suite.service.ProcessSkinDiscovered(suite.ctx, event)
+
+	suite.NoError(err)
+}
+
+func (suite *SkinServiceSuite) TestProcessSkinDiscovered_WithoutPriceSkipsHistory() {
+	event := models.NewSkinDiscoveredEvent("Kilowatt Case", "", "", "", "Base Grade", 0, "steam_market", "")
+
+	suite.mockStorage.On("CreateDiscoveredSkin", suite.ctx, mock.MatchedBy(func(skin *models.Skin) bool {
+		return skin.Weapon == "Case" && skin.Name == "Kilowatt Case" && skin.Quality == "None"
+	})).
+		Return(true, nil)
+
+	suite.mockCache.On("Delete", suite.ctx, "skins:list:*").
+		Return(nil)
+
+	err := suite.service.ProcessSkinDiscovered(suite.ctx, event)
+
+	suite.NoError(err)
+	suite.mockStorage.AssertNotCalled(suite.T(), "CreateInitialPriceHistory", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *SkinServiceSuite) TestProcessSkinDiscovered_ExistingSkinKeepsPrices() {
+	event := models.NewSkinDiscoveredEvent("AK-47 | Redline (Field-Tested)", "", "", "", "Classified", 12, "steam_market", "")
+
+	suite.mockStorage.On("CreateDiscoveredSkin", suite.ctx, mock.AnythingOfType("*models.Skin")).
+		Return(false, nil)
+
+	suite.mockCache.On("Delete", suite.ctx, "skins:detail:ak_47_redline_ft").
+		Return(nil)
+
+	err := suite.service.ProcessSkinDiscovered(suite.ctx, event)
+
+	suite.NoError(err)
+	suite.mockStorage.AssertNotCalled(suite.T(), "CreateSkin", mock.Anything, mock.Anything)
+	suite.mockStorage.AssertNotCalled(suite.T(), "CreateInitialPriceHistory", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *SkinServiceSuite) TestProcessSkinDiscovered_MissingMarketHashName() {
+	err := suite.service.ProcessSkinDiscovered(suite.ctx, &models.SkinDiscoveredEvent{})
+
+	suite.Error(err)
+	suite.Contains(err.Error(), "market_hash_name")
+}
+
+func (suite *SkinServiceSuite) expectSkinCacheInvalidation(slug string) {
+	suite.mockCache.On("Delete", suite.ctx, "skins:detail:"+slug).Return(nil)
+	suite.mockCache.On("Delete", suite.ctx, "skins:list:*").Return(nil)
+	suite.mockCache.On("Delete", suite.ctx, "skins:popular:*").Return(nil)
+}
+
+func (suite *SkinServiceSuite) TestUpdateSkin_KeepsFieldsOutsideMask() {
+	existing := models.NewSkin("AK-47 | Redline (Field-Tested)", "Redline", "AK-47", "Field-Tested")
+	existing.CurrentPrice = 15.5
+	existing.Rarity = "Classified"
+
+	patch := &models.Skin{ImageURL: "https://example.com/new.jpg", CurrentPrice: 0}
+
+	suite.mockStorage.On("GetSkinBySlug", suite.ctx, existing.Slug).
+		Return(existing, nil)
+
+	suite.mockStorage.On("UpdateSkinFields", suite.ctx, mock.MatchedBy(func(skin *models.Skin) bool {
+		return skin.ImageURL == "https://example.com/new.jpg" && skin.CurrentPrice == 15.5
+	}), "AK-47", []string{"image_url"}).
+		Return(nil)
+
+	suite.expectSkinCacheInvalidation(existing.Slug)
+
+	skin, err := suite.service.UpdateSkin(suite.ctx, existing.Slug, patch, []string{"image_url"})
+
+	suite.NoError(err)
+	suite.Equal(15.5, skin.CurrentPrice)
+	suite.Equal("Classified", skin.Rarity)
+}
+
+func (suite *SkinServiceSuite) TestUpdateSkin_WeaponChangePassesPreviousWeapon() {
+	existing := models.NewSkin("Redline", "Redline", "AK-47", "Field-Tested")
+
+	suite.mockStorage.On("GetSkinBySlug", suite.ctx, existing.Slug).
+		Return(existing, nil)
+
+	suite.mockStorage.On("UpdateSkinFields", suite.ctx, mock.MatchedBy(func(skin *models.Skin) bool {
+		return skin.Weapon == "Glock-18"
+	}), "AK-47", []string{"weapon"}).
+		Return(nil)
+
+	suite.expectSkinCacheInvalidation(existing.Slug)
+
+	skin, err := suite.service.UpdateSkin(suite.ctx, existing.Slug, &models.Skin{Weapon: "Glock-18"}, []string{"weapon"})
+
+	suite.NoError(err)
+	suite.Equal("Glock-18", skin.Weapon)
+}
+
+func (suite *SkinServiceSuite) TestUpdateSkin_UnknownField() {
+	existing := models.NewSkin("", "Redline", "AK-47", "Field-Tested")
+
+	suite.mockStorage.On("GetSkinBySlug", suite.ctx, existing.Slug).
+		Return(existing, nil)
+
+	_, err := suite.service.UpdateSkin(suite.ctx, existing.Slug, &models.Skin{}, []string{"volume_24h"})
+
+	suite.ErrorIs(err, models.ErrInvalidSkin)
+	suite.mockStorage.AssertNotCalled(suite.T(), "UpdateSkinFields", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *SkinServiceSuite) TestUpdateSkin_EmptyMask() {
+	existing := models.NewSkin("", "Redline", "AK-47", "Field-Tested")
+
+	suite.mockStorage.On("GetSkinBySlug", suite.ctx, existing.Slug).
+		Return(existing, nil)
+
+	_, err := suite.service.UpdateSkin(suite.ctx, existing.Slug, &models.Skin{}, nil)
+
+	suite.ErrorIs(err, models.ErrInvalidSkin)
+}
+
+func (suite *SkinServiceSuite) TestUpdateSkin_InvalidQuality() {
+	existing := models.NewSkin("", "Redline", "AK-47", "Field-Tested")
+
+	suite.mockStorage.On("GetSkinBySlug", suite.ctx, existing.Slug).
+		Return(existing, nil)
+
+	_, err := suite.service.UpdateSkin(suite.ctx, existing.Slug, &models.Skin{Quality: "Mint"}, []string{"quality"})
+
+	suite.ErrorIs(err, models.ErrInvalidSkin)
+}
+
+func (suite *SkinServiceSuite) TestUpdateSkin_NotFound() {
+	suite.mockStorage.On("GetSkinBySlug", suite.ctx, "missing").
+		Return(nil, models.ErrSkinNotFound)
+
+	_, err := suite.service.UpdateSkin(suite.ctx, "missing", &models.Skin{Name: "x"}, []string{"name"})
+
+	suite.ErrorIs(err, models.ErrSkinNotFound)
+}
+
+func (suite *SkinServiceSuite) TestDeleteSkin_Success() {
+	existing := models.NewSkin("", "Redline", "AK-47", "Field-Tested")
+
+	suite.mockStorage.On("GetSkinBySlug", suite.ctx, existing.Slug).
+		Return(existing, nil)
+
+	suite.mockStorage.On("DeleteSkin", suite.ctx, existing).
+		Return(nil)
+
+	suite.expectSkinCacheInvalidation(existing.Slug)
+
+	err := suite.service.DeleteSkin(suite.ctx, existing.Slug)
+
+	suite.NoError(err)
+	suite.mockCache.AssertCalled(suite.T(), "Delete", suite.ctx, "skins:detail:"+existing.Slug)
+}
+
+func (suite *SkinServiceSuite) TestDeleteSkin_NotFound() {
+	suite.mockStorage.On("GetSkinBySlug", suite.ctx, "missing").
+		Return(nil, models.ErrSkinNotFound)
+
+	err := suite.service.DeleteSkin(suite.ctx, "missing")
+
+	suite.ErrorIs(err, models.ErrSkinNotFound)
+	suite.mockStorage.AssertNotCalled(suite.T(), "DeleteSkin", mock.Anything, mock.Anything)
+}
+
+func (suite *SkinServiceSuite) TestBatchGetSkins_KeepsRequestOrder() {
+	redline := models.NewSkin("", "Redline", "AK-47", "Field-Tested")
+	dragonLore := models.NewSkin("", "Dragon Lore", "AWP", "Factory New")
+
+	keys := []string{"missing", dragonLore.ID.String(), redline.Slug, redline.Slug}
+
+	suite.mockStorage.On("GetSkinsBySlugsOrIDs", suite.ctx,
+		[]string{"missing", redline.Slug}, []string{dragonLore.ID.String()}).
+		Return([]models.Skin{*redline, *dragonLore}, nil)
+
+	result, err := suite.service.BatchGetSkins(suite.ctx, keys)
+
+	suite.NoError(err)
+	suite.Len(result, 4)
+	suite.Equal("missing", result[0].Key)
+	suite.Nil(result[0].Skin)
+	suite.Equal(dragonLore.ID, result[1].Skin.ID)
+	suite.Equal(redline.ID, result[2].Skin.ID)
+	suite.Equal(redline.ID, result[3].Skin.ID)
+}
+
+func (suite *SkinServiceSuite) TestBatchGetSkins_NormalizesIDs() {
+	skin := models.NewSkin("", "Redline", "AK-47", "Field-Tested")
+	key := strings.ToUpper(skin.ID.String())
+
+	suite.mockStorage.On("GetSkinsBySlugsOrIDs", suite.ctx, []string(nil), []string{skin.ID.String()}).
+		Return([]models.Skin{*skin}, nil)
+
+	result, err := suite.service.BatchGetSkins(suite.ctx, []string{key})
+
+	suite.NoError(err)
+	suite.Equal(key, result[0].Key)
+	suite.NotNil(result[0].Skin)
+}
+
+func (suite *SkinServiceSuite) TestBatchGetSkins_StorageError() {
+	suite.mockStorage.On("GetSkinsBySlugsOrIDs", suite.ctx, []string{"ak_47_redline_ft"}, []string(nil)).
+		Return(nil, errors.New("database error"))
+
+	_, err := suite.service.BatchGetSkins(suite.ctx, []string{"ak_47_redline_ft"})
+
+	suite.Error(err)
+}
+
+func (suite *SkinServiceSuite) TestGetPriceChart_RawPointsByDefault() {
+	skin := &models.Skin{ID: uuid.New(), Slug: "awp_asiimov_ft", Weapon: "AWP"}
+	now := time.Now()
+
+	suite.mockStorage.On("GetSkinBySlug", suite.ctx, skin.Slug).
+		Return(skin, nil)
+
+	suite.mockStorage.On("GetPriceHistory", suite.ctx, skin.ID, models.Period7d).
+		Return([]models.PriceHistory{
+			{Price: 10, Volume: 1, RecordedAt: now.Add(-time.Hour)},
+			{Price: 20, Volume: 2, RecordedAt: now},
+		}, nil)
+
+	chart, err := suite.service.GetPriceChart(suite.ctx, skin.Slug, models.Period7d, "", "")
+
+	suite.NoError(err)
+	suite.Len(chart.DataPoints, 2)
+	suite.Empty(chart.Interval)
+	suite.Nil(chart.Candles)
+	suite.Equal(15.0, chart.AvgPrice)
+}
+
+func (suite *SkinServiceSuite) TestGetPriceChart_Candles() {
+	skin := &models.Skin{ID: uuid.New(), Slug: "awp_asiimov_ft", Weapon: "AWP"}
+	day := time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)
+
+	suite.mockStorage.On("GetSkinBySlug", suite.ctx, skin.Slug).
+		Return(skin, nil)
+
+	suite.mockStorage.On("GetPriceCandles", suite.ctx, skin, models.SourceSteamMarket, models.Period30d, models.Interval1d).
+		Return([]models.PriceCandle{
+			{Timestamp: day, Open: 10, High: 14, Low: 9, Close: 12, AvgPrice: 11, Volume: 5, Count: 3},
+			{Timestamp: day.Add(24 * time.Hour), Open: 12, High: 13, Low: 8, Close: 8, AvgPrice: 10, Volume: 7, Count: 1},
+		}, nil)
+
+	chart, err := suite.service.GetPriceChart(suite.ctx, skin.Slug, models.Period30d, models.Interval1d, "")
+
+	suite.NoError(err)
+	suite.Equal("1d", chart.Interval)
+	suite.Equal("steam_market", chart.Source)
+	suite.Len(chart.Candles, 2)
+	suite.Equal([]models.PriceChartData{
+		{Timestamp: day, Price: 12, Volume: 5},
+		{Timestamp: day.Add(24 * time.Hour), Price: 8, Volume: 7},
+	}, chart.DataPoints)
+	suite.Equal(8.0, chart.MinPrice)
+	suite.Equal(14.0, chart.MaxPrice)
+	// (11*3 + 10*1) / 4
+	suite.Equal(10.75, chart.AvgPrice)
+	// объем последней свечи, а не сумма снимков за 24 часа
+	suite.Equal(7, chart.TotalVolume)
+	suite.mockStorage.AssertNotCalled(suite.T(), "GetPriceHistory", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *SkinServiceSuite) TestGetPriceChart_CandlesOfRequestedSourceForAllHistory() {
+	skin := &models.Skin{ID: uuid.New(), Slug: "awp_asiimov_ft", Weapon: "AWP"}
+
+	suite.mockStorage.On("GetSkinBySlug", suite.ctx, skin.Slug).
+		Return(skin, nil)
+
+	suite.mockStorage.On("GetPriceCandles", suite.ctx, skin, models.SourceSkinport, models.PeriodAll, models.Interval1w).
+		Return([]models.PriceCandle{}, nil)
+
+	chart, err := suite.service.GetPriceChart(suite.ctx, skin.Slug, models.PeriodAll, models.Interval1w, models.SourceSkinport)
+
+	suite.NoError(err)
+	suite.Equal("all", chart.Period)
+	suite.Equal("skinport", chart.Source)
+}
+
+func (suite *SkinServiceSuite) TestGetPriceChart_RejectsTooManyCandles() {
+	_, err := suite.service.GetPriceChart(suite.ctx, "awp_asiimov_ft", models.Period1y, models.Interval5m, "")
+	suite.ErrorIs(err, models.ErrInvalidChartInterval)
+
+	_, err = suite.service.GetPriceChart(suite.ctx, "awp_asiimov_ft", models.PeriodAll, models.Interval1h, "")
+	suite.ErrorIs(err, models.ErrInvalidChartInterval)
+
+	suite.mockStorage.AssertNotCalled(suite.T(), "GetSkinBySlug", mock.Anything, mock.Anything)
+}
+
+func (suite *SkinServiceSuite) TestGetSkinFacets_MergesShardCounts() {
+	filter := &models.SkinFilter{Rarity: "Covert", Search: "asiimov"}
+	bounds := []float64{10, 100}
+	cacheKey := suite.service.facetsCacheKey(filter, bounds)
+
+	suite.mockCache.On("Get", suite.ctx, cacheKey).
+		Return("", errors.New("redis: nil"))
+
+	// по строке на значение фасета с каждого из двух шардов
+	suite.mockStorage.On("GetSkinFacets", suite.ctx, filter, bounds).
+		Return([]models.SkinFacetRow{
+			{Facet: models.FacetWeapon, Value: "AWP", Count: 3},
+			{Facet: models.FacetWeapon, Value: "M4A4", Count: 1},
+			{Facet: models.FacetQuality, Value: "Field-Tested", Count: 4},
+			{Facet: models.FacetRarity, Value: "Covert", Count: 4},
+			{Facet: models.FacetPrice, Value: "1", Count: 3},
+			{Facet: models.FacetPrice, Value: "2", Count: 1},
+			{Facet: models.FacetWeapon, Value: "AWP", Count: 2},
+			{Facet: models.FacetWeapon, Value: "AK-47", Count: 1},
+			{Facet: models.FacetQuality, Value: "Field-Tested", Count: 3},
+			{Facet: models.FacetRarity, Value: "Covert", Count: 3},
+			{Facet: models.FacetPrice, Value: "1", Count: 3},
+		}, nil)
+
+	suite.mockCache.On("Set", suite.ctx, cacheKey, mock.AnythingOfType("string"), 2*time.Minute).
+		Return(nil)
+
+	facets, err := suite.service.GetSkinFacets(suite.ctx, filter, bounds)
+
+	suite.NoError(err)
+	suite.Equal([]models.FacetCount{{Value: "AWP", Count: 5}, {Value: "AK-47", Count: 1}, {Value: "M4A4", Count: 1}}, facets.Weapons)
+	suite.Equal([]models.FacetCount{{Value: "Field-Tested", Count: 7}}, facets.Qualities)
+	suite.Equal([]models.FacetCount{{Value: "Covert", Count: 7}}, facets.Rarities)
+	suite.Equal([]models.FacetCount{{Value: "Sniper", Count: 5}, {Value: "Rifle", Count: 2}}, facets.Categories)
+	suite.Equal([]models.PriceBucketCount{
+		{Min: 0, Max: 10, Count: 0},
+		{Min: 10, Max: 100, Count: 6},
+		{Min: 100, Max: 0, Count: 1},
+	}, facets.PriceBuckets)
+}
+
+func (suite *SkinServiceSuite) TestGetSkinFacets_CacheHit() {
+	filter := &models.SkinFilter{Weapon: "AWP"}
+	cacheKey := suite.service.facetsCacheKey(filter, models.DefaultPriceBuckets)
+
+	suite.mockCache.On("Get", suite.ctx, cacheKey).
+		Return(`{"weapons":[{"value":"AWP","count":2}]}`, nil)
+
+	facets, err := suite.service.GetSkinFacets(suite.ctx, filter, nil)
+
+	suite.NoError(err)
+	suite.Equal([]models.FacetCount{{Value: "AWP", Count: 2}}, facets.Weapons)
+	suite.mockStorage.AssertNotCalled(suite.T(), "GetSkinFacets", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *SkinServiceSuite) TestGetSkinFacets_InvalidBuckets() {
+	_, err := suite.service.GetSkinFacets(suite.ctx, &models.SkinFilter{}, []float64{10, 5})
+
+	suite.ErrorIs(err, models.ErrInvalidPriceBuckets)
+}
+
+func (suite *SkinServiceSuite) TestGetSkins_InvalidFilter() {
+	minChange, maxChange := 10.0, -10.0
+	filter := &models.SkinFilter{Limit: 10, MinPriceChange24h: &minChange, MaxPriceChange24h: &maxChange}
+
+	_, err := suite.service.GetSkins(suite.ctx, filter)
+
+	suite.ErrorIs(err, models.ErrInvalidSkinFilter)
+	suite.mockStorage.AssertNotCalled(suite.T(), "GetSkins", mock.Anything, mock.Anything)
+}
+
+func (suite *SkinServiceSuite) TestGetSkins_UnknownCategory() {
+	filter := &models.SkinFilter{Limit: 10, Categories: []models.SkinCategory{"Grenade"}}
+
+	_, err := suite.service.GetSkins(suite.ctx, filter)
+
+	suite.ErrorIs(err, models.ErrInvalidSkinFilter)
+}
+
+func (suite *SkinServiceSuite) TestCreateSkin_IndexesAutocomplete() {
+	autocomplete := mocks.NewMockSkinAutocomplete(suite.T())
+	suite.service.WithAutocomplete(autocomplete)
+	skin := models.NewSkin("AK-47 | Redline (Field-Tested)", "Redline", "AK-47", "Field-Tested")
+
+	suite.mockStorage.On("CreateSkin", suite.ctx, skin).Return(nil)
+	suite.mockCache.On("Delete", suite.ctx, "skins:list:*").Return(nil)
+	// ошибка индекса не должна отменять создание скина
+	autocomplete.On("Add", suite.ctx, *skin).Return(errors.New("redis down"))
+
+	err := suite.service.CreateSkin(suite.ctx, skin)
+
+	suite.NoError(err)
+	autocomplete.AssertExpectations(suite.T())
+}
+
+func (suite *SkinServiceSuite) TestDeleteSkin_RemovesFromAutocomplete() {
+	autocomplete := mocks.NewMockSkinAutocomplete(suite.T())
+	suite.service.WithAutocomplete(autocomplete)
+	existing := models.NewSkin("", "Redline", "AK-47", "Field-Tested")
+
+	suite.mockStorage.On("GetSkinBySlug", suite.ctx, existing.Slug).Return(existing, nil)
+	suite.mockStorage.On("DeleteSkin", suite.ctx, existing).Return(nil)
+	suite.expectSkinCacheInvalidation(existing.Slug)
+	autocomplete.On("Remove", suite.ctx, existing.Slug).Return(nil)
+
+	err := suite.service.DeleteSkin(suite.ctx, existing.Slug)
+
+	suite.NoError(err)
+	autocomplete.AssertExpectations(suite.T())
+}
+
+func (suite *SkinServiceSuite) TestGetSkinBySlug_RecordsAutocompleteView() {
+	autocomplete := mocks.NewMockSkinAutocomplete(suite.T())
+	suite.service.WithAutocomplete(autocomplete)
+	cached := &models.SkinDetailResponse{Skin: models.Skin{ID: uuid.New(), Slug: "awp_asiimov_ft"}}
+
+	suite.mockCache.On("GetSkinDetail", suite.ctx, "awp_asiimov_ft").Return(cached, true)
+	suite.mockCache.On("Set", suite.ctx, mock.Anything, "1", 24*time.Hour).Return(nil)
+	autocomplete.On("RecordView", suite.ctx, "awp_asiimov_ft").Return(nil)
+
+	_, err := suite.service.GetSkinBySlug(suite.ctx, "awp_asiimov_ft", models.Period7d)
+
+	suite.NoError(err)
+	autocomplete.AssertExpectations(suite.T())
+}
+
+func (suite *SkinServiceSuite) TestAutocomplete_Success() {
+	autocomplete := mocks.NewMockSkinAutocomplete(suite.T())
+	suite.service.WithAutocomplete(autocomplete)
+	suggestions := []models.AutocompleteSuggestion{
+		{Slug: "ak_47_redline_ft", MarketHashName: "AK-47 | Redline (Field-Tested)", Score: 120},
+	}
+
+	autocomplete.On("Suggest", suite.ctx, "ak red", 5).Return(suggestions, nil)
+
+	result, err := suite.service.Autocomplete(suite.ctx, "ak red", 5)
+
+	suite.NoError(err)
+	suite.Equal(suggestions, result)
+}
+
+func (suite *SkinServiceSuite) TestAutocomplete_NotConfigured() {
+	_, err := suite.service.Autocomplete(suite.ctx, "ak", 5)
+
+	suite.ErrorIs(err, models.ErrAutocompleteUnavailable)
+}
+
+func (suite *SkinServiceSuite) TestRebuildAutocomplete_IndexesAllSkins() {
+	autocomplete := mocks.NewMockSkinAutocomplete(suite.T())
+	suite.service.WithAutocomplete(autocomplete)
+	skins := []models.Skin{
+		*models.NewSkin("AK-47 | Redline (Field-Tested)", "Redline", "AK-47", "Field-Tested"),
+		*models.NewSkin("AWP | Asiimov (Field-Tested)", "Asiimov", "AWP", "Field-Tested"),
+	}
+
+	suite.mockStorage.On("GetAllSkins", suite.ctx).Return(skins, nil)
+	autocomplete.On("Rebuild", suite.ctx, skins).Return(nil)
+
+	indexed, err := suite.service.RebuildAutocomplete(suite.ctx)
+
+	suite.NoError(err)
+	suite.Equal(2, indexed)
+}
+
+func (suite *SkinServiceSuite) TestRebuildAutocomplete_StorageError() {
+	autocomplete := mocks.NewMockSkinAutocomplete(suite.T())
+	suite.service.WithAutocomplete(autocomplete)
+
+	suite.mockStorage.On("GetAllSkins", suite.ctx).Return(nil, errors.New("database error"))
+
+	_, err := suite.service.RebuildAutocomplete(suite.ctx)
+
+	suite.Error(err)
+	autocomplete.AssertNotCalled(suite.T(), "Rebuild", mock.Anything, mock.Anything)
+}
@@ -58,101 +58,6 @@ func (s *Storage) GetTrendingSkins(ctx context.Context, period string, limit int
 	return s.scanSkins(rows)
 }
 
-func (s *Storage) GetTotalSkinsCount(ctx context.Context) (int, error) {
-	qb := s.builder.Select("COUNT(*)").From("skins")
-	queryText, args, err := qb.ToSql()
-	if err != nil {
-		return 0, fmt.Errorf("build query: %w", err)
-	}
-
-	if s.HasSharding() {
-		var total int
-		for _, shard := range s.shards.AllShards() {
-			var count int
-			err := shard.QueryRow(ctx, queryText, args...).Scan(&count)
-			if err != nil {
-				continue
-			}
-			total += count
-		}
-		return total, nil
-	}
-
-	var count int
-	err = s.pg.Pool.QueryRow(ctx, queryText, args...).Scan(&count)
-	return count, err
-}
-
-func (s *Storage) GetAveragePrice(ctx context.Context) (float64, error) {
-	if s.HasSharding() {
-		qb := s.builder.
-			Select("COALESCE(SUM(current_price), 0)", "COUNT(*)").
-			From("skins").
-			Where(squirrel.Gt{"current_price": 0})
-
-		queryText, args, err := qb.ToSql()
-		if err != nil {
-			return 0, fmt.Errorf("build query: %w", err)
-		}
-
-		var totalSum float64
-		var totalCount int
-		for _, shard := range s.shards.AllShards() {
-			var sum float64
-			var count int
-			err := shard.QueryRow(ctx, queryText, args...).Scan(&sum, &count)
-			if err != nil {
-				continue
-			}
-			totalSum += sum
-			totalCount += count
-		}
-		if totalCount == 0 {
-			return 0, nil
-		}
-		return totalSum / float64(totalCount), nil
-	}
-
-	qb := s.builder.
-		Select("COALESCE(AVG(current_price), 0)").
-		From("skins").
-		Where(squirrel.Gt{"current_price": 0})
-
-	queryText, args, err := qb.ToSql()
-	if err != nil {
-		return 0, fmt.Errorf("build query: %w", err)
-	}
-
-	var avg float64
-	err = s.pg.Pool.QueryRow(ctx, queryText, args...).Scan(&avg)
-	return avg, err
-}
-
-func (s *Storage) GetTotalVolume24h(ctx context.Context) (int, error) {
-	qb := s.builder.Select("COALESCE(SUM(volume_24h), 0)").From("skins")
-	queryText, args, err := qb.ToSql()
-	if err != nil {
-		return 0, fmt.Errorf("build query: %w", err)
-	}
-
-	if s.HasSharding() {
-		var total int
-		for _, shard := range s.shards.AllShards() {
-			var volume int
-			err := shard.QueryRow(ctx, queryText, args...).Scan(&volume)
-			if err != nil {
-				continue
-			}
-			total += volume
-		}
-		return total, nil
-	}
-
-	var volume int
-	err = s.pg.Pool.QueryRow(ctx, queryText, args...).Scan(&volume)
-	return volume, err
-}
-
 func (s *Storage) GetTopGainers(ctx context.Context, limit int) ([]models.Skin, error) {
 	qb := s.builder.
 		Select(
@@ -164,7 +69,7 @@ func (s *Storage) GetTopGainers(ctx context.Context, limit int) ([]models.Skin,
 		).
 		From("skins").
 		Where(squirrel.Gt{"price_change_24h": 0}).
-		OrderBy("price_change_24h DESC").
+		OrderBy("price_change_24h DESC", "id DESC").
 		Limit(uint64(limit))
 
 	queryText, args, err := qb.ToSql()
@@ -173,19 +78,7 @@ func (s *Storage) GetTopGainers(ctx context.Context, limit int) ([]models.Skin,
 	}
 
 	if s.HasSharding() {
-		var allSkins []models.Skin
-		for _, shard := range s.shards.AllShards() {
-			rows, err := shard.Query(ctx, queryText, args...)
-			if err != nil {
-				continue
-			}
-			skins, _ := s.scanSkins(rows)
-			allSkins = append(allSkins, skins...)
-		}
-		if len(allSkins) > limit {
-			return allSkins[:limit], nil
-		}
-		return allSkins, nil
+		return s.topSkinsAcrossShards(ctx, queryText, args, skinOrder(func(skin *models.Skin) float64 { return skin.PriceChange24h }, true), limit)
 	}
 
 	rows, err := s.pg.Pool.Query(ctx, queryText, args...)
@@ -208,7 +101,7 @@ func (s *Storage) GetTopLosers(ctx context.Context, limit int) ([]models.Skin, e
 		).
 		From("skins").
 		Where(squirrel.Lt{"price_change_24h": 0}).
-		OrderBy("price_change_24h ASC").
+		OrderBy("price_change_24h ASC", "id ASC").
 		Limit(uint64(limit))
 
 	queryText, args, err := qb.ToSql()
@@ -217,19 +110,7 @@ func (s *Storage) GetTopLosers(ctx context.Context, limit int) ([]models.Skin, e
 	}
 
 	if s.HasSharding() {
-		var allSkins []models.Skin
-		for _, shard := range s.shards.AllShards() {
-			rows, err := shard.Query(ctx, queryText, args...)
-			if err != nil {
-				continue
-			}
-			skins, _ := s.scanSkins(rows)
-			allSkins = append(allSkins, skins...)
-		}
-		if len(allSkins) > limit {
-			return allSkins[:limit], nil
-		}
-		return allSkins, nil
+		return s.topSkinsAcrossShards(ctx, queryText, args, skinOrder(func(skin *models.Skin) float64 { return skin.PriceChange24h }, false), limit)
 	}
 
 	rows, err := s.pg.Pool.Query(ctx, queryText, args...)
@@ -252,7 +133,7 @@ func (s *Storage) GetMostPopularSkins(ctx context.Context, limit int) ([]models.
 		).
 		From("skins").
 		Where(squirrel.Gt{"volume_24h": 0}).
-		OrderBy("volume_24h DESC").
+		OrderBy("volume_24h DESC", "id DESC").
 		Limit(uint64(limit))
 
 	queryText, args, err := qb.ToSql()
@@ -261,19 +142,7 @@ func (s *Storage) GetMostPopularSkins(ctx context.Context, limit int) ([]models.
 	}
 
 	if s.HasSharding() {
-		var allSkins []models.Skin
-		for _, shard := range s.shards.AllShards() {
-			rows, err := shard.Query(ctx, queryText, args...)
-			if err != nil {
-				continue
-			}
-			skins, _ := s.scanSkins(rows)
-			allSkins = append(allSkins, skins...)
-		}
-		if len(allSkins) > limit {
-			return allSkins[:limit], nil
-		}
-		return allSkins, nil
+		return s.topSkinsAcrossShards(ctx, queryText, args, skinOrder(func(skin *models.Skin) int { return skin.Volume24h }, true), limit)
 	}
 
 	rows, err := s.pg.Pool.Query(ctx, queryText, args...)
@@ -296,7 +165,7 @@ func (s *Storage) GetRecentlyUpdatedSkins(ctx context.Context, limit int) ([]mod
 		).
 		From("skins").
 		Where(squirrel.NotEq{"last_updated": nil}).
-		OrderBy("last_updated DESC").
+		OrderBy("last_updated DESC", "id DESC").
 		Limit(uint64(limit))
 
 	queryText, args, err := qb.ToSql()
@@ -305,19 +174,7 @@ func (s *Storage) GetRecentlyUpdatedSkins(ctx context.Context, limit int) ([]mod
 	}
 
 	if s.HasSharding() {
-		var allSkins []models.Skin
-		for _, shard := range s.shards.AllShards() {
-			rows, err := shard.Query(ctx, queryText, args...)
-			if err != nil {
-				continue
-			}
-			skins, _ := s.scanSkins(rows)
-			allSkins = append(allSkins, skins...)
-		}
-		if len(allSkins) > limit {
-			return allSkins[:limit], nil
-		}
-		return allSkins, nil
+		return s.topSkinsAcrossShards(ctx, queryText, args, skinOrder(func(skin *models.Skin) int64 { return skin.LastUpdated.UnixMicro() }, true), limit)
 	}
 
 	rows, err := s.pg.Pool.Query(ctx, queryText, args...)
@@ -375,3 +232,19 @@ func (s *Storage) GetPriceStatsByPeriod(ctx context.Context, skinID uuid.UUID, p
 
 	return &stats, nil
 }
+
+// topSkinsAcrossShards сливает топы шардов по тому же порядку, что и в запросе:
+// простая склейка с обрезкой отдавала бы в основном скины первого шарда.
+func (s *Storage) topSkinsAcrossShards(
+	ctx context.Context,
+	queryText string,
+	args []interface{},
+	less func(a, b *models.Skin) bool,
+	limit int,
+) ([]models.Skin, error) {
+	streams, err := queryEachShard(ctx, s.shards.AllShards(), queryText, args, s.scanShardSkins)
+	if err != nil {
+		return nil, err
+	}
+	return mergeSortedSkins(streams, less, limit), nil
+}
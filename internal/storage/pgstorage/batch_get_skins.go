@@ -0,0 +1,42 @@
+package pgstorage
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kedr891/cs-parser/internal/models"
+)
+
+// GetSkinsBySlugsOrIDs находит скины по набору slug и id одним запросом на шард.
+// По slug и id нельзя определить оружие, поэтому запрос уходит на все шарды параллельно,
+// и каждый шард возвращает те скины, которые хранит. Порядок результата не определен.
+func (s *Storage) GetSkinsBySlugsOrIDs(ctx context.Context, slugs, ids []string) ([]models.Skin, error) {
+	if len(slugs) == 0 && len(ids) == 0 {
+		return []models.Skin{}, nil
+	}
+
+	queryText, args, err := s.builder.
+		Select(
+			"id", "slug", "market_hash_name", "name", "weapon", "quality", "rarity",
+			"current_price", "currency", "image_url", "volume_24h",
+			"price_change_24h", "price_change_7d",
+			"lowest_price", "highest_price",
+			"last_updated", "created_at", "updated_at",
+		).
+		From("skins").
+		Where("(slug = ANY(?) OR id = ANY(?::uuid[]))", slugs, ids).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build query: %w", err)
+	}
+
+	if !s.HasSharding() {
+		rows, err := s.pg.Pool.Query(ctx, queryText, args...)
+		if err != nil {
+			return nil, fmt.Errorf("query skins: %w", err)
+		}
+		return s.scanSkins(rows)
+	}
+
+	return queryShardsParallel(ctx, s.shards.AllShards(), queryText, args, s.scanShardSkins)
+}
@@ -3,6 +3,7 @@ package pgstorage
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 
 	"github.com/Masterminds/squirrel"
@@ -12,22 +13,7 @@ import (
 )
 
 func (s *Storage) CreateSkin(ctx context.Context, skin *models.Skin) error {
-	qb := s.builder.
-		Insert("skins").
-		Columns(
-			"id", "slug", "market_hash_name", "name", "weapon", "quality", "rarity",
-			"current_price", "currency", "image_url", "volume_24h",
-			"price_change_24h", "price_change_7d",
-			"lowest_price", "highest_price",
-			"last_updated", "created_at", "updated_at",
-		).
-		Values(
-			skin.ID, skin.Slug, skin.MarketHashName, skin.Name, skin.Weapon, skin.Quality, skin.Rarity,
-			skin.CurrentPrice, skin.Currency, skin.ImageURL, skin.Volume24h,
-			skin.PriceChange24h, skin.PriceChange7d,
-			skin.LowestPrice, skin.HighestPrice,
-			skin.LastUpdated, skin.CreatedAt, skin.UpdatedAt,
-		)
+	qb := s.insertSkinQuery(skin)
 
 	queryText, args, err := qb.ToSql()
 	if err != nil {
@@ -67,6 +53,75 @@ func (s *Storage) CreateSkin(ctx context.Context, skin *models.Skin) error {
 	return nil
 }
 
+// CreateDiscoveredSkin добавляет найденный на маркетплейсе скин, только если его еще нет в каталоге,
+// и возвращает true, если скин создан. У существующего скина заполняются лишь пустые rarity и image_url:
+// цены и объем ведут обновления цен, и повторное обнаружение не должно их сбрасывать.
+func (s *Storage) CreateDiscoveredSkin(ctx context.Context, skin *models.Skin) (bool, error) {
+	existing, err := s.GetSkinBySlug(ctx, skin.Slug)
+	if err == nil {
+		return false, s.fillEmptyCatalogFields(ctx, existing, skin)
+	}
+	if !errors.Is(err, models.ErrSkinNotFound) {
+		return false, fmt.Errorf("get skin by slug: %w", err)
+	}
+
+	queryText, args, err := s.insertSkinQuery(skin).Suffix("ON CONFLICT DO NOTHING").ToSql()
+	if err != nil {
+		return false, fmt.Errorf("build query: %w", err)
+	}
+
+	tag, err := s.poolForWeapon(skin.Weapon).Exec(ctx, queryText, args...)
+	if err != nil {
+		return false, fmt.Errorf("create discovered skin: %w", err)
+	}
+	if tag.RowsAffected() == 1 {
+		return true, nil
+	}
+
+	// скин успели создать между проверкой и вставкой
+	existing, err = s.GetSkinBySlug(ctx, skin.Slug)
+	if err != nil {
+		return false, fmt.Errorf("get skin by slug: %w", err)
+	}
+	return false, s.fillEmptyCatalogFields(ctx, existing, skin)
+}
+
+func (s *Storage) fillEmptyCatalogFields(ctx context.Context, existing, discovered *models.Skin) error {
+	query := `
+		UPDATE skins SET
+			rarity = COALESCE(NULLIF(rarity, ''), NULLIF($2::varchar, '')),
+			image_url = COALESCE(NULLIF(image_url, ''), NULLIF($3::text, '')),
+			updated_at = NOW()
+		WHERE id = $1
+			AND ((COALESCE(rarity, '') = '' AND $2::varchar <> '')
+				OR (COALESCE(image_url, '') = '' AND $3::text <> ''))
+	`
+
+	if _, err := s.poolForWeapon(existing.Weapon).Exec(ctx, query, existing.ID, discovered.Rarity, discovered.ImageURL); err != nil {
+		return fmt.Errorf("fill skin catalog fields: %w", err)
+	}
+	return nil
+}
+
+func (s *Storage) insertSkinQuery(skin *models.Skin) squirrel.InsertBuilder {
+	return s.builder.
+		Insert("skins").
+		Columns(
+			"id", "slug", "market_hash_name", "name", "weapon", "quality", "rarity",
+			"current_price", "currency", "image_url", "volume_24h",
+			"price_change_24h", "price_change_7d",
+			"lowest_price", "highest_price",
+			"last_updated", "created_at", "updated_at",
+		).
+		Values(
+			skin.ID, skin.Slug, skin.MarketHashName, skin.Name, skin.Weapon, skin.Quality, skin.Rarity,
+			skin.CurrentPrice, skin.Currency, skin.ImageURL, skin.Volume24h,
+			skin.PriceChange24h, skin.PriceChange7d,
+			skin.LowestPrice, skin.HighestPrice,
+			skin.LastUpdated, skin.CreatedAt, skin.UpdatedAt,
+		)
+}
+
 func (s *Storage) UpdateSkin(ctx context.Context, skin *models.Skin) error {
 	whereClause := squirrel.Eq{"slug": skin.Slug}
 	if skin.ID != uuid.Nil {
@@ -0,0 +1,41 @@
+package pgstorage
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/kedr891/cs-parser/internal/models"
+)
+
+// DeleteSkin удаляет скин с шарда его оружия. История цен и правила уведомлений
+// удаляются каскадно.
+func (s *Storage) DeleteSkin(ctx context.Context, skin *models.Skin) error {
+	queryText, args, err := s.builder.
+		Delete("skins").
+		Where(squirrel.Eq{"id": skin.ID}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("build query: %w", err)
+	}
+
+	if s.HasSharding() {
+		result, err := s.shards.GetShardByWeapon(skin.Weapon).Exec(ctx, queryText, args...)
+		if err != nil {
+			return fmt.Errorf("delete skin in shard: %w", err)
+		}
+		if result.RowsAffected() == 0 {
+			return models.ErrSkinNotFound
+		}
+		return nil
+	}
+
+	result, err := s.pg.Pool.Exec(ctx, queryText, args...)
+	if err != nil {
+		return fmt.Errorf("delete skin: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return models.ErrSkinNotFound
+	}
+	return nil
+}
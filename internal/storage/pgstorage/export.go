@@ -0,0 +1,143 @@
+package pgstorage
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/kedr891/cs-parser/internal/models"
+)
+
+// StreamSkins передает скины каталога в fn по мере чтения, шард за шардом,
+// не загружая выборку в память целиком.
+func (s *Storage) StreamSkins(ctx context.Context, filter *models.ExportFilter, fn func(*models.Skin) error) error {
+	qb := s.builder.
+		Select(
+			"id", "slug", "market_hash_name", "name", "weapon", "quality", "rarity",
+			"current_price", "currency", "image_url", "volume_24h",
+			"price_change_24h", "price_change_7d",
+			"lowest_price", "highest_price",
+			"last_updated", "created_at", "updated_at",
+		).
+		From("skins").
+		OrderBy("id")
+
+	if filter.Weapon != "" {
+		qb = qb.Where(squirrel.Eq{"weapon": filter.Weapon})
+	}
+	if !filter.From.IsZero() {
+		qb = qb.Where(squirrel.GtOrEq{"updated_at": filter.From.UTC()})
+	}
+	if !filter.To.IsZero() {
+		qb = qb.Where(squirrel.Lt{"updated_at": filter.To.UTC()})
+	}
+
+	queryText, args, err := qb.ToSql()
+	if err != nil {
+		return fmt.Errorf("build query: %w", err)
+	}
+
+	for i, pool := range s.exportPools(filter.Weapon) {
+		rows, err := pool.Query(ctx, queryText, args...)
+		if err != nil {
+			return fmt.Errorf("query skins on shard %d: %w", i, err)
+		}
+
+		for rows.Next() {
+			var skin models.Skin
+			err := rows.Scan(
+				&skin.ID, &skin.Slug, &skin.MarketHashName, &skin.Name, &skin.Weapon, &skin.Quality, &skin.Rarity,
+				&skin.CurrentPrice, &skin.Currency, &skin.ImageURL, &skin.Volume24h,
+				&skin.PriceChange24h, &skin.PriceChange7d,
+				&skin.LowestPrice, &skin.HighestPrice,
+				&skin.LastUpdated, &skin.CreatedAt, &skin.UpdatedAt,
+			)
+			if err != nil {
+				rows.Close()
+				return fmt.Errorf("scan skin: %w", err)
+			}
+			if err := fn(&skin); err != nil {
+				rows.Close()
+				return err
+			}
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return fmt.Errorf("read skins on shard %d: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+// StreamPriceHistory передает точки истории цен в fn по мере чтения, шард за шардом.
+// Внутри шарда точки упорядочены по скину и времени (idx_price_history_skin_recorded).
+func (s *Storage) StreamPriceHistory(ctx context.Context, filter *models.ExportFilter, fn func(*models.PriceHistoryExport) error) error {
+	qb := s.builder.
+		Select(
+			"ph.skin_id", "s.slug", "s.market_hash_name", "s.weapon",
+			"ph.price", "ph.currency", "ph.source", "ph.volume", "ph.recorded_at",
+		).
+		From("price_history ph").
+		Join("skins s ON s.id = ph.skin_id").
+		OrderBy("ph.skin_id", "ph.recorded_at")
+
+	if filter.Weapon != "" {
+		qb = qb.Where(squirrel.Eq{"s.weapon": filter.Weapon})
+	}
+	if filter.Source != "" {
+		qb = qb.Where(squirrel.Eq{"ph.source": filter.Source})
+	}
+	if !filter.From.IsZero() {
+		qb = qb.Where(squirrel.GtOrEq{"ph.recorded_at": filter.From.UTC()})
+	}
+	if !filter.To.IsZero() {
+		qb = qb.Where(squirrel.Lt{"ph.recorded_at": filter.To.UTC()})
+	}
+
+	queryText, args, err := qb.ToSql()
+	if err != nil {
+		return fmt.Errorf("build query: %w", err)
+	}
+
+	for i, pool := range s.exportPools(filter.Weapon) {
+		rows, err := pool.Query(ctx, queryText, args...)
+		if err != nil {
+			return fmt.Errorf("query price history on shard %d: %w", i, err)
+		}
+
+		for rows.Next() {
+			var h models.PriceHistoryExport
+			err := rows.Scan(
+				&h.SkinID, &h.Slug, &h.MarketHashName, &h.Weapon,
+				&h.Price, &h.Currency, &h.Source, &h.Volume, &h.RecordedAt,
+			)
+			if err != nil {
+				rows.Close()
+				return fmt.Errorf("scan price history: %w", err)
+			}
+			if err := fn(&h); err != nil {
+				rows.Close()
+				return err
+			}
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return fmt.Errorf("read price history on shard %d: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+// exportPools - пулы, которые нужно обойти: при фильтре по оружию достаточно его шарда.
+func (s *Storage) exportPools(weapon string) []*pgxpool.Pool {
+	if !s.HasSharding() {
+		return []*pgxpool.Pool{s.pg.Pool}
+	}
+	if weapon != "" {
+		return s.shardsForWeapons([]string{weapon})
+	}
+	return s.shards.AllShards()
+}
@@ -0,0 +1,55 @@
+package pgstorage
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/kedr891/cs-parser/internal/models"
+)
+
+// GetMarketStats возвращает агрегаты каталога по оружию и редкости с каждого шарда.
+// Категория вычисляется по оружию в Go, поэтому в SQL группируем по weapon.
+func (s *Storage) GetMarketStats(ctx context.Context) ([]models.MarketStatsRow, error) {
+	qb := s.builder.
+		Select(
+			"weapon",
+			"COALESCE(rarity, '')",
+			"COUNT(*)",
+			"COUNT(*) FILTER (WHERE current_price > 0)",
+			"COALESCE(SUM(current_price) FILTER (WHERE current_price > 0), 0)",
+			"COALESCE(SUM(volume_24h), 0)",
+		).
+		From("skins").
+		GroupBy("weapon", "COALESCE(rarity, '')")
+
+	queryText, args, err := qb.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build query: %w", err)
+	}
+
+	if !s.HasSharding() {
+		rows, err := s.pg.Pool.Query(ctx, queryText, args...)
+		if err != nil {
+			return nil, fmt.Errorf("query market stats: %w", err)
+		}
+		return scanMarketStats(rows, 0)
+	}
+
+	return queryShardsParallel(ctx, s.shards.AllShards(), queryText, args, scanMarketStats)
+}
+
+func scanMarketStats(rows pgx.Rows, shard int) ([]models.MarketStatsRow, error) {
+	defer rows.Close()
+
+	var stats []models.MarketStatsRow
+	for rows.Next() {
+		row := models.MarketStatsRow{Shard: shard}
+		if err := rows.Scan(&row.Weapon, &row.Rarity, &row.SkinCount, &row.PricedCount, &row.PriceSum, &row.Volume24h); err != nil {
+			return nil, fmt.Errorf("scan market stats: %w", err)
+		}
+		stats = append(stats, row)
+	}
+
+	return stats, rows.Err()
+}
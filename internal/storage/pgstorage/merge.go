@@ -0,0 +1,162 @@
+package pgstorage
+
+import (
+	"bytes"
+	"cmp"
+	"container/heap"
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/kedr891/cs-parser/internal/models"
+)
+
+// queryEachShard выполняет запрос на переданных шардах параллельно, результат i-го шарда - в i-м элементе.
+// scan получает номер шарда и должен закрыть rows.
+func queryEachShard[T any](
+	ctx context.Context,
+	shards []*pgxpool.Pool,
+	queryText string,
+	args []interface{},
+	scan func(rows pgx.Rows, shard int) ([]T, error),
+) ([][]T, error) {
+	results := make([][]T, len(shards))
+	errs := make([]error, len(shards))
+
+	var wg sync.WaitGroup
+	for i, shard := range shards {
+		wg.Add(1)
+		go func(i int, shard *pgxpool.Pool) {
+			defer wg.Done()
+			rows, err := shard.Query(ctx, queryText, args...)
+			if err != nil {
+				errs[i] = fmt.Errorf("query shard %d: %w", i, err)
+				return
+			}
+			results[i], errs[i] = scan(rows, i)
+		}(i, shard)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return results, nil
+}
+
+// queryShardsParallel выполняет запрос на шардах параллельно и склеивает результаты в порядке шардов.
+func queryShardsParallel[T any](
+	ctx context.Context,
+	shards []*pgxpool.Pool,
+	queryText string,
+	args []interface{},
+	scan func(rows pgx.Rows, shard int) ([]T, error),
+) ([]T, error) {
+	results, err := queryEachShard(ctx, shards, queryText, args, scan)
+	if err != nil {
+		return nil, err
+	}
+
+	var merged []T
+	for _, result := range results {
+		merged = append(merged, result...)
+	}
+	return merged, nil
+}
+
+// scanShardSkins - scan для queryEachShard и queryShardsParallel по выборкам скинов.
+func (s *Storage) scanShardSkins(rows pgx.Rows, _ int) ([]models.Skin, error) {
+	return s.scanSkins(rows)
+}
+
+// skinLess повторяет ORDER BY из skinsQuery для sort_by.
+func skinLess(sortBy string, desc bool) func(a, b *models.Skin) bool {
+	switch sortBy {
+	case "price":
+		return skinOrder(func(skin *models.Skin) float64 { return skin.CurrentPrice }, desc)
+	case "volume":
+		return skinOrder(func(skin *models.Skin) int { return skin.Volume24h }, desc)
+	case "name":
+		return skinOrder(func(skin *models.Skin) string { return skin.Name }, desc)
+	case "weapon":
+		return skinOrder(func(skin *models.Skin) string { return skin.Weapon }, desc)
+	case "created":
+		return skinOrder(func(skin *models.Skin) int64 { return skin.CreatedAt.UnixMicro() }, desc)
+	default:
+		return skinOrder(func(skin *models.Skin) int64 { return skin.UpdatedAt.UnixMicro() }, desc)
+	}
+}
+
+// skinOrder повторяет ORDER BY <key>, id с одним направлением для обоих полей.
+// UUID в PostgreSQL сравниваются побайтно, текст - в COLLATE "C", время хранится в микросекундах.
+func skinOrder[K cmp.Ordered](key func(*models.Skin) K, desc bool) func(a, b *models.Skin) bool {
+	return func(a, b *models.Skin) bool {
+		c := cmp.Compare(key(a), key(b))
+		if c == 0 {
+			c = bytes.Compare(a.ID[:], b.ID[:])
+		}
+		if desc {
+			return c > 0
+		}
+		return c < 0
+	}
+}
+
+// mergeSortedSkins сливает упорядоченные по less выборки шардов и возвращает первые limit скинов.
+func mergeSortedSkins(streams [][]models.Skin, less func(a, b *models.Skin) bool, limit int) []models.Skin {
+	h := &skinHeap{less: less}
+	for i, stream := range streams {
+		if len(stream) > 0 {
+			h.items = append(h.items, skinHeapItem{stream: i})
+		}
+	}
+	h.streams = streams
+	heap.Init(h)
+
+	result := make([]models.Skin, 0, limit)
+	for h.Len() > 0 && len(result) < limit {
+		item := &h.items[0]
+		result = append(result, streams[item.stream][item.pos])
+		item.pos++
+		if item.pos < len(streams[item.stream]) {
+			heap.Fix(h, 0)
+		} else {
+			heap.Pop(h)
+		}
+	}
+
+	return result
+}
+
+type skinHeapItem struct {
+	stream int
+	pos    int
+}
+
+type skinHeap struct {
+	items   []skinHeapItem
+	streams [][]models.Skin
+	less    func(a, b *models.Skin) bool
+}
+
+func (h *skinHeap) Len() int { return len(h.items) }
+
+func (h *skinHeap) Less(i, j int) bool {
+	a, b := h.items[i], h.items[j]
+	return h.less(&h.streams[a.stream][a.pos], &h.streams[b.stream][b.pos])
+}
+
+func (h *skinHeap) Swap(i, j int) { h.items[i], h.items[j] = h.items[j], h.items[i] }
+
+func (h *skinHeap) Push(x any) { h.items = append(h.items, x.(skinHeapItem)) }
+
+func (h *skinHeap) Pop() any {
+	last := h.items[len(h.items)-1]
+	h.items = h.items[:len(h.items)-1]
+	return last
+}
@@ -0,0 +1,71 @@
+package pgstorage
+
+import (
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kedr891/cs-parser/internal/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMergeSortedSkins_GlobalOrderAcrossShards(t *testing.T) {
+	less := skinLess("price", false)
+
+	shard := func(prices ...float64) []models.Skin {
+		skins := make([]models.Skin, len(prices))
+		for i, price := range prices {
+			skins[i] = models.Skin{ID: uuid.New(), CurrentPrice: price}
+		}
+		sort.Slice(skins, func(i, j int) bool { return less(&skins[i], &skins[j]) })
+		return skins
+	}
+
+	streams := [][]models.Skin{shard(1, 4, 9), shard(2, 3, 10), shard(), shard(5, 6, 7)}
+
+	merged := mergeSortedSkins(streams, less, 6)
+
+	prices := make([]float64, len(merged))
+	for i := range merged {
+		prices[i] = merged[i].CurrentPrice
+	}
+	assert.Equal(t, []float64{1, 2, 3, 4, 5, 6}, prices)
+}
+
+func TestMergeSortedSkins_TieBreakByID(t *testing.T) {
+	less := skinLess("price", true)
+
+	low := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	mid := uuid.MustParse("00000000-0000-0000-0000-000000000002")
+	high := uuid.MustParse("00000000-0000-0000-0000-000000000003")
+
+	streams := [][]models.Skin{
+		{{ID: high, CurrentPrice: 5}, {ID: low, CurrentPrice: 5}},
+		{{ID: mid, CurrentPrice: 5}},
+	}
+
+	merged := mergeSortedSkins(streams, less, 10)
+
+	assert.Len(t, merged, 3)
+	assert.Equal(t, []uuid.UUID{high, mid, low}, []uuid.UUID{merged[0].ID, merged[1].ID, merged[2].ID})
+}
+
+func TestSkinLess_TextUsesByteOrder(t *testing.T) {
+	less := skinLess("name", false)
+
+	upper := &models.Skin{ID: uuid.New(), Name: "Zeus"}
+	lower := &models.Skin{ID: uuid.New(), Name: "asiimov"}
+
+	assert.True(t, less(upper, lower))
+}
+
+func TestSkinLess_DefaultsToUpdatedAt(t *testing.T) {
+	less := skinLess("", true)
+
+	now := time.Now()
+	newer := &models.Skin{ID: uuid.New(), UpdatedAt: now}
+	older := &models.Skin{ID: uuid.New(), UpdatedAt: now.Add(-time.Minute)}
+
+	assert.True(t, less(newer, older))
+}
@@ -0,0 +1,19 @@
+-- Ключ идемпотентности для price_history: повторно доставленное событие
+-- (skin_id, source, recorded_at) не создает дубликат точки.
+DO $$
+BEGIN
+    IF NOT EXISTS (
+        SELECT 1 FROM pg_indexes WHERE indexname = 'uq_price_history_skin_source_recorded'
+    ) THEN
+        DELETE FROM price_history ph
+        USING price_history dup
+        WHERE ph.skin_id = dup.skin_id
+          AND ph.source = dup.source
+          AND ph.recorded_at = dup.recorded_at
+          AND ph.id > dup.id;
+
+        CREATE UNIQUE INDEX uq_price_history_skin_source_recorded
+            ON price_history(skin_id, source, recorded_at);
+    END IF;
+END
+$$;
@@ -0,0 +1,18 @@
+-- Правила уведомлений о цене. Хранятся на шарде скина.
+CREATE TABLE IF NOT EXISTS price_alerts (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    skin_id UUID NOT NULL REFERENCES skins(id) ON DELETE CASCADE,
+    slug VARCHAR(255) NOT NULL,
+    weapon VARCHAR(100) NOT NULL,
+    condition VARCHAR(20) NOT NULL CHECK (condition IN ('above', 'below', 'change_percent')),
+    target_price DECIMAL(10,2) NOT NULL CHECK (target_price > 0),
+    base_price DECIMAL(10,2) NOT NULL DEFAULT 0,
+    triggered BOOLEAN NOT NULL DEFAULT FALSE,
+    last_triggered_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_price_alerts_skin_id ON price_alerts(skin_id);
+CREATE INDEX IF NOT EXISTS idx_price_alerts_slug ON price_alerts(slug);
+
+COMMENT ON TABLE price_alerts IS 'Price alert rules evaluated on every price update';
@@ -0,0 +1,9 @@
+-- Нечеткий поиск скинов. slug строится generate_skin_slug по тем же правилам, что models.GenerateSlug,
+-- поэтому запрос нормализуется в Go так же и сравнивается со slug по триграммам.
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+-- slug со словами через "_": word_similarity находит "ak_redlin" в "ak_47_redline_ft"
+CREATE INDEX IF NOT EXISTS idx_skins_slug_trgm ON skins USING gin (slug gin_trgm_ops);
+
+-- slug без разделителей: подстрока и префикс совпадают для "ak47", "ak-47" и "ak_47"
+CREATE INDEX IF NOT EXISTS idx_skins_slug_compact_trgm ON skins USING gin ((REPLACE(slug, '_', '')) gin_trgm_ops);
@@ -0,0 +1,5 @@
+-- Правило проверяется по цене одной площадки: тики разных площадок по разные стороны
+-- порога иначе повторно срабатывают и сбрасывают правило.
+ALTER TABLE price_alerts ADD COLUMN IF NOT EXISTS source VARCHAR(50) NOT NULL DEFAULT 'steam_market';
+
+CREATE INDEX IF NOT EXISTS idx_price_alerts_skin_source ON price_alerts(skin_id, source);
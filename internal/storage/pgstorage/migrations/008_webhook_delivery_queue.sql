@@ -0,0 +1,8 @@
+-- Доставка сохраняется в статусе pending вместе с телом уведомления до коммита оффсета
+-- topicPriceAlert, поэтому после остановки или падения API недоставленные уведомления
+-- забираются из таблицы и отправляются повторно. next_attempt_at - до какого момента
+-- доставка закреплена за воркером, после него ее может забрать любая реплика.
+ALTER TABLE webhook_deliveries ADD COLUMN IF NOT EXISTS payload JSONB;
+ALTER TABLE webhook_deliveries ADD COLUMN IF NOT EXISTS next_attempt_at TIMESTAMP;
+
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_pending ON webhook_deliveries(next_attempt_at) WHERE status = 'pending';
@@ -8,6 +8,7 @@ import (
 
 	"github.com/Masterminds/squirrel"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/pkg/errors"
 
 	"github.com/kedr891/cs-parser/internal/storage/db"
@@ -70,6 +71,14 @@ func (s *Storage) HasSharding() bool {
 	return s.shards != nil
 }
 
+// poolForWeapon - шард оружия или единственная база без шардирования.
+func (s *Storage) poolForWeapon(weapon string) *pgxpool.Pool {
+	if s.HasSharding() {
+		return s.shards.GetShardByWeapon(weapon)
+	}
+	return s.pg.Pool
+}
+
 func (s *Storage) GetPG() *db.Postgres {
 	return s.pg
 }
@@ -0,0 +1,191 @@
+package pgstorage
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/kedr891/cs-parser/internal/models"
+)
+
+var priceAlertColumns = []string{
+	"id", "skin_id", "slug", "weapon", "condition", "target_price", "base_price",
+	"triggered", "last_triggered_at", "created_at", "source",
+}
+
+// CreatePriceAlert сохраняет правило на шарде скина.
+func (s *Storage) CreatePriceAlert(ctx context.Context, alert *models.PriceAlert) error {
+	queryText, args, err := s.builder.
+		Insert("price_alerts").
+		Columns(priceAlertColumns...).
+		Values(
+			alert.ID, alert.SkinID, alert.Slug, alert.Weapon, alert.Condition, alert.TargetPrice, alert.BasePrice,
+			alert.Triggered, alert.LastTriggeredAt, alert.CreatedAt, alert.Source,
+		).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("build query: %w", err)
+	}
+
+	if s.HasSharding() {
+		if _, err := s.shards.GetShardByWeapon(alert.Weapon).Exec(ctx, queryText, args...); err != nil {
+			return fmt.Errorf("create price alert in shard: %w", err)
+		}
+		return nil
+	}
+
+	if _, err := s.pg.Pool.Exec(ctx, queryText, args...); err != nil {
+		return fmt.Errorf("create price alert: %w", err)
+	}
+	return nil
+}
+
+// ListPriceAlerts возвращает правила, новые первыми. Пустой slug - все правила.
+func (s *Storage) ListPriceAlerts(ctx context.Context, slug string) ([]models.PriceAlert, error) {
+	qb := s.builder.
+		Select(priceAlertColumns...).
+		From("price_alerts").
+		OrderBy("created_at DESC")
+	if slug != "" {
+		qb = qb.Where(squirrel.Eq{"slug": slug})
+	}
+
+	alerts, err := s.queryPriceAlerts(ctx, qb)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(alerts, func(i, j int) bool {
+		return alerts[i].CreatedAt.After(alerts[j].CreatedAt)
+	})
+
+	return alerts, nil
+}
+
+// GetSkinPriceAlerts возвращает правила скина на площадке source для проверки при обновлении цены.
+func (s *Storage) GetSkinPriceAlerts(ctx context.Context, skinID uuid.UUID, source string) ([]models.PriceAlert, error) {
+	qb := s.builder.
+		Select(priceAlertColumns...).
+		From("price_alerts").
+		Where(squirrel.Eq{"skin_id": skinID, "source": source})
+
+	return s.queryPriceAlerts(ctx, qb)
+}
+
+func (s *Storage) DeletePriceAlert(ctx context.Context, id uuid.UUID) error {
+	queryText, args, err := s.builder.
+		Delete("price_alerts").
+		Where(squirrel.Eq{"id": id}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("build query: %w", err)
+	}
+
+	if s.HasSharding() {
+		for _, shard := range s.shards.AllShards() {
+			result, err := shard.Exec(ctx, queryText, args...)
+			if err != nil {
+				return fmt.Errorf("delete price alert in shard: %w", err)
+			}
+			if result.RowsAffected() > 0 {
+				return nil
+			}
+		}
+		return models.ErrAlertNotFound
+	}
+
+	result, err := s.pg.Pool.Exec(ctx, queryText, args...)
+	if err != nil {
+		return fmt.Errorf("delete price alert: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return models.ErrAlertNotFound
+	}
+	return nil
+}
+
+// SetPriceAlertTriggered переключает флаг срабатывания, только если он еще не равен triggered.
+// Возвращает false, если флаг уже был изменен - так правило не срабатывает повторно.
+func (s *Storage) SetPriceAlertTriggered(ctx context.Context, alert *models.PriceAlert, triggered bool) (bool, error) {
+	qb := s.builder.
+		Update("price_alerts").
+		Set("triggered", triggered).
+		Where(squirrel.Eq{"id": alert.ID, "triggered": !triggered})
+	if triggered {
+		qb = qb.Set("last_triggered_at", time.Now().UTC())
+	}
+
+	queryText, args, err := qb.ToSql()
+	if err != nil {
+		return false, fmt.Errorf("build query: %w", err)
+	}
+
+	if s.HasSharding() {
+		result, err := s.shards.GetShardByWeapon(alert.Weapon).Exec(ctx, queryText, args...)
+		if err != nil {
+			return false, fmt.Errorf("update price alert in shard: %w", err)
+		}
+		return result.RowsAffected() > 0, nil
+	}
+
+	result, err := s.pg.Pool.Exec(ctx, queryText, args...)
+	if err != nil {
+		return false, fmt.Errorf("update price alert: %w", err)
+	}
+	return result.RowsAffected() > 0, nil
+}
+
+func (s *Storage) queryPriceAlerts(ctx context.Context, qb squirrel.SelectBuilder) ([]models.PriceAlert, error) {
+	queryText, args, err := qb.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build query: %w", err)
+	}
+
+	if s.HasSharding() {
+		var allAlerts []models.PriceAlert
+		for _, shard := range s.shards.AllShards() {
+			rows, err := shard.Query(ctx, queryText, args...)
+			if err != nil {
+				return nil, fmt.Errorf("query price alerts in shard: %w", err)
+			}
+			alerts, err := scanPriceAlerts(rows)
+			if err != nil {
+				return nil, err
+			}
+			allAlerts = append(allAlerts, alerts...)
+		}
+		return allAlerts, nil
+	}
+
+	rows, err := s.pg.Pool.Query(ctx, queryText, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query price alerts: %w", err)
+	}
+	return scanPriceAlerts(rows)
+}
+
+func scanPriceAlerts(rows pgx.Rows) ([]models.PriceAlert, error) {
+	defer rows.Close()
+
+	var alerts []models.PriceAlert
+	for rows.Next() {
+		var alert models.PriceAlert
+		if err := rows.Scan(
+			&alert.ID, &alert.SkinID, &alert.Slug, &alert.Weapon, &alert.Condition, &alert.TargetPrice, &alert.BasePrice,
+			&alert.Triggered, &alert.LastTriggeredAt, &alert.CreatedAt, &alert.Source,
+		); err != nil {
+			return nil, fmt.Errorf("scan price alert: %w", err)
+		}
+		alerts = append(alerts, alert)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return alerts, nil
+}
@@ -0,0 +1,60 @@
+package pgstorage
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/kedr891/cs-parser/internal/models"
+)
+
+// GetPriceCandles агрегирует историю цен скина на площадке source в OHLCV-свечи на шарде его оружия.
+// Начало отсчета date_bin - понедельник, поэтому недельные свечи начинаются с понедельника,
+// а дневные - с полуночи. Open, close и volume берутся из первой и последней точки интервала
+// (id различает точки с одним временем). За period all история не ограничивается по времени.
+func (s *Storage) GetPriceCandles(ctx context.Context, skin *models.Skin, source models.PriceSource, period models.PriceStatsPeriod, interval models.ChartInterval) ([]models.PriceCandle, error) {
+	qb := s.builder.
+		Select().
+		Column(squirrel.Expr("date_bin(?::interval, recorded_at, TIMESTAMP '2000-01-03 00:00:00') AS bucket", interval.Duration())).
+		Columns(
+			"(array_agg(price ORDER BY recorded_at ASC, id ASC))[1] AS open",
+			"MAX(price) AS high",
+			"MIN(price) AS low",
+			"(array_agg(price ORDER BY recorded_at DESC, id DESC))[1] AS close",
+			"AVG(price) AS avg_price",
+			"COALESCE((array_agg(volume ORDER BY recorded_at DESC, id DESC))[1], 0) AS volume",
+			"COUNT(*) AS points",
+		).
+		From("price_history").
+		Where(squirrel.Eq{"skin_id": skin.ID, "source": string(source)}).
+		GroupBy("bucket").
+		OrderBy("bucket")
+	if period != models.PeriodAll {
+		qb = qb.Where("recorded_at >= NOW() - ?::interval", period.GetDuration())
+	}
+
+	queryText, args, err := qb.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build query: %w", err)
+	}
+
+	rows, err := s.poolForWeapon(skin.Weapon).Query(ctx, queryText, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query price candles: %w", err)
+	}
+	defer rows.Close()
+
+	var candles []models.PriceCandle
+	for rows.Next() {
+		var c models.PriceCandle
+		if err := rows.Scan(&c.Timestamp, &c.Open, &c.High, &c.Low, &c.Close, &c.AvgPrice, &c.Volume, &c.Count); err != nil {
+			return nil, fmt.Errorf("scan price candle: %w", err)
+		}
+		candles = append(candles, c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return candles, nil
+}
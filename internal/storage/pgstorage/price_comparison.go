@@ -0,0 +1,133 @@
+package pgstorage
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/kedr891/cs-parser/internal/models"
+)
+
+// GetLatestSourcePrices возвращает последнюю цену каждой площадки не старше maxAge
+// и время самой свежей из них. История скина лежит на шарде его оружия.
+func (s *Storage) GetLatestSourcePrices(ctx context.Context, skin *models.Skin, maxAge time.Duration) (map[string]float64, time.Time, error) {
+	qb := s.builder.
+		Select("DISTINCT ON (source) source", "price", "recorded_at").
+		From("price_history").
+		Where(squirrel.Eq{"skin_id": skin.ID}).
+		Where("recorded_at >= NOW() - ?::interval", maxAge).
+		OrderBy("source", "recorded_at DESC")
+
+	queryText, args, err := qb.ToSql()
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("build query: %w", err)
+	}
+
+	rows, err := s.poolForWeapon(skin.Weapon).Query(ctx, queryText, args...)
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("query source prices: %w", err)
+	}
+	defer rows.Close()
+
+	prices := make(map[string]float64)
+	var updatedAt time.Time
+	for rows.Next() {
+		var (
+			source     string
+			price      float64
+			recordedAt time.Time
+		)
+		if err := rows.Scan(&source, &price, &recordedAt); err != nil {
+			return nil, time.Time{}, fmt.Errorf("scan source price: %w", err)
+		}
+		prices[source] = price
+		if recordedAt.After(updatedAt) {
+			updatedAt = recordedAt
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, time.Time{}, fmt.Errorf("rows error: %w", err)
+	}
+
+	return prices, updatedAt, nil
+}
+
+// priceSpreadsQuery выбирает скины с ценами минимум на двух площадках и упорядочивает их
+// по разнице между самой дорогой и самой дешевой площадкой в процентах.
+// DISTINCT ON (skin_id, source) читает uq_price_history_skin_source_recorded.
+const priceSpreadsQuery = `
+	WITH latest AS (
+		SELECT DISTINCT ON (skin_id, source) skin_id, source, price, recorded_at
+		FROM price_history
+		WHERE recorded_at >= NOW() - $1::interval AND price > 0
+		ORDER BY skin_id, source, recorded_at DESC
+	), spreads AS (
+		SELECT skin_id,
+			jsonb_object_agg(source, price) AS prices,
+			(MAX(price) - MIN(price)) / MIN(price) AS spread,
+			MAX(recorded_at) AS updated_at
+		FROM latest
+		GROUP BY skin_id
+		HAVING COUNT(*) >= 2 AND MIN(price) >= $2
+	)
+	SELECT s.id, s.slug, s.market_hash_name, sp.prices, sp.updated_at
+	FROM spreads sp
+	JOIN skins s ON s.id = sp.skin_id
+	ORDER BY sp.spread DESC, s.id
+	LIMIT $3
+`
+
+// GetPriceSpreads возвращает limit скинов с наибольшим спредом между площадками.
+// minPrice отсекает дешевые предметы, у которых разница в центы дает огромный процент.
+func (s *Storage) GetPriceSpreads(ctx context.Context, maxAge time.Duration, minPrice float64, limit int) ([]models.PriceComparison, error) {
+	args := []interface{}{maxAge, minPrice, limit}
+
+	if !s.HasSharding() {
+		rows, err := s.pg.Pool.Query(ctx, priceSpreadsQuery, args...)
+		if err != nil {
+			return nil, fmt.Errorf("query price spreads: %w", err)
+		}
+		return scanPriceSpreads(rows, 0)
+	}
+
+	all, err := queryShardsParallel(ctx, s.shards.AllShards(), priceSpreadsQuery, args, scanPriceSpreads)
+	if err != nil {
+		return nil, err
+	}
+
+	// каждый шард отдал свои первые limit, общий порядок тот же, что в ORDER BY
+	sort.Slice(all, func(i, j int) bool {
+		if all[i].SpreadPercent != all[j].SpreadPercent {
+			return all[i].SpreadPercent > all[j].SpreadPercent
+		}
+		return bytes.Compare(all[i].SkinID[:], all[j].SkinID[:]) < 0
+	})
+	if len(all) > limit {
+		all = all[:limit]
+	}
+
+	return all, nil
+}
+
+func scanPriceSpreads(rows pgx.Rows, _ int) ([]models.PriceComparison, error) {
+	defer rows.Close()
+
+	var comparisons []models.PriceComparison
+	for rows.Next() {
+		var (
+			skin      models.Skin
+			prices    map[string]float64
+			updatedAt time.Time
+		)
+		if err := rows.Scan(&skin.ID, &skin.Slug, &skin.MarketHashName, &prices, &updatedAt); err != nil {
+			return nil, fmt.Errorf("scan price spread: %w", err)
+		}
+		comparisons = append(comparisons, *models.NewPriceComparison(&skin, prices, updatedAt))
+	}
+
+	return comparisons, rows.Err()
+}
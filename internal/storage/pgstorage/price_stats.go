@@ -0,0 +1,92 @@
+package pgstorage
+
+import (
+	"context"
+	"fmt"
+)
+
+// Цена "на начало окна" - последняя точка до границы окна, а если истории старше нет,
+// то первая точка внутри окна. Точки берутся только с площадки последней цены скина:
+// current_price пишет та площадка, что прислала обновление последней, и сравнение с чужой
+// историей давало бы вместо изменения цены спред между площадками. Экстремумы считаются по всем площадкам.
+// Строки без изменений не обновляются, чтобы не трогать updated_at.
+const recomputePriceStatsQuery = `
+	WITH latest AS (
+		SELECT
+			s.id,
+			(SELECT ph.source FROM price_history ph
+			 WHERE ph.skin_id = s.id
+			 ORDER BY ph.recorded_at DESC LIMIT 1) AS source
+		FROM skins s
+	),
+	stats AS (
+		SELECT
+			l.id,
+			COALESCE(
+				(SELECT ph.price FROM price_history ph
+				 WHERE ph.skin_id = l.id AND ph.source = l.source AND ph.recorded_at <= NOW() - INTERVAL '24 hours'
+				 ORDER BY ph.recorded_at DESC LIMIT 1),
+				(SELECT ph.price FROM price_history ph
+				 WHERE ph.skin_id = l.id AND ph.source = l.source AND ph.recorded_at > NOW() - INTERVAL '24 hours'
+				 ORDER BY ph.recorded_at ASC LIMIT 1)
+			) AS price_24h_ago,
+			COALESCE(
+				(SELECT ph.price FROM price_history ph
+				 WHERE ph.skin_id = l.id AND ph.source = l.source AND ph.recorded_at <= NOW() - INTERVAL '7 days'
+				 ORDER BY ph.recorded_at DESC LIMIT 1),
+				(SELECT ph.price FROM price_history ph
+				 WHERE ph.skin_id = l.id AND ph.source = l.source AND ph.recorded_at > NOW() - INTERVAL '7 days'
+				 ORDER BY ph.recorded_at ASC LIMIT 1)
+			) AS price_7d_ago,
+			(SELECT MIN(ph.price) FROM price_history ph WHERE ph.skin_id = l.id) AS min_price,
+			(SELECT MAX(ph.price) FROM price_history ph WHERE ph.skin_id = l.id) AS max_price
+		FROM latest l
+	),
+	computed AS (
+		SELECT
+			stats.id,
+			CASE WHEN stats.price_24h_ago > 0
+				THEN ROUND((s.current_price - stats.price_24h_ago) / stats.price_24h_ago * 100, 2)
+				ELSE 0 END AS price_change_24h,
+			CASE WHEN stats.price_7d_ago > 0
+				THEN ROUND((s.current_price - stats.price_7d_ago) / stats.price_7d_ago * 100, 2)
+				ELSE 0 END AS price_change_7d,
+			COALESCE(stats.min_price, s.lowest_price) AS lowest_price,
+			COALESCE(stats.max_price, s.highest_price) AS highest_price
+		FROM stats
+		JOIN skins s ON s.id = stats.id
+	)
+	UPDATE skins SET
+		price_change_24h = computed.price_change_24h,
+		price_change_7d = computed.price_change_7d,
+		lowest_price = computed.lowest_price,
+		highest_price = computed.highest_price
+	FROM computed
+	WHERE skins.id = computed.id
+		AND (skins.price_change_24h IS DISTINCT FROM computed.price_change_24h
+			OR skins.price_change_7d IS DISTINCT FROM computed.price_change_7d
+			OR skins.lowest_price IS DISTINCT FROM computed.lowest_price
+			OR skins.highest_price IS DISTINCT FROM computed.highest_price)
+`
+
+// RecomputePriceStats пересчитывает price_change_24h, price_change_7d, lowest_price и highest_price
+// по price_history на каждом шарде и возвращает количество обновленных скинов.
+func (s *Storage) RecomputePriceStats(ctx context.Context) (int, error) {
+	if s.HasSharding() {
+		total := 0
+		for i, shard := range s.shards.AllShards() {
+			result, err := shard.Exec(ctx, recomputePriceStatsQuery)
+			if err != nil {
+				return total, fmt.Errorf("recompute price stats on shard %d: %w", i, err)
+			}
+			total += int(result.RowsAffected())
+		}
+		return total, nil
+	}
+
+	result, err := s.pg.Pool.Exec(ctx, recomputePriceStatsQuery)
+	if err != nil {
+		return 0, fmt.Errorf("recompute price stats: %w", err)
+	}
+	return int(result.RowsAffected()), nil
+}
@@ -0,0 +1,184 @@
+package pgstorage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/kedr891/cs-parser/internal/models"
+)
+
+// RecordPriceUpdate пишет точку в price_history и обновляет цену скина в одной транзакции
+// на шарде, где хранится скин. Запись идемпотентна по (skin_id, source, timestamp события):
+// для повторно доставленного события возвращается false, и скин не меняется.
+// Пустой Timestamp заполняется временем записи, чтобы точку можно было найти в ForgetPriceUpdate.
+func (s *Storage) RecordPriceUpdate(ctx context.Context, event *models.PriceUpdateEvent) (bool, error) {
+	if event.Timestamp.IsZero() {
+		event.Timestamp = time.Now()
+	}
+	recordedAt := priceUpdateRecordedAt(event)
+
+	var recorded bool
+	fn := func(tx pgx.Tx) (err error) {
+		recorded, err = s.recordPriceUpdateTx(ctx, tx, event, recordedAt)
+		return err
+	}
+
+	if s.HasSharding() {
+		weapon, err := s.getSkinWeapon(ctx, event.SkinID)
+		if err != nil {
+			return false, err
+		}
+		if err := s.shards.Transaction(ctx, weapon, fn); err != nil {
+			return false, fmt.Errorf("record price update in shard: %w", err)
+		}
+		return recorded, nil
+	}
+
+	if err := s.pg.Transaction(ctx, fn); err != nil {
+		return false, fmt.Errorf("record price update: %w", err)
+	}
+	return recorded, nil
+}
+
+// ForgetPriceUpdate удаляет точку истории события, чтобы повторная обработка не считала его дубликатом.
+// Цена скина не откатывается: повторная обработка запишет ее заново.
+func (s *Storage) ForgetPriceUpdate(ctx context.Context, event *models.PriceUpdateEvent) error {
+	queryText, args, err := s.builder.
+		Delete("price_history").
+		Where(squirrel.Eq{
+			"skin_id":     event.SkinID,
+			"source":      event.Source,
+			"recorded_at": priceUpdateRecordedAt(event),
+		}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("build query: %w", err)
+	}
+
+	if s.HasSharding() {
+		weapon, err := s.getSkinWeapon(ctx, event.SkinID)
+		if err != nil {
+			return err
+		}
+		if _, err := s.shards.GetShardByWeapon(weapon).Exec(ctx, queryText, args...); err != nil {
+			return fmt.Errorf("forget price update in shard: %w", err)
+		}
+		return nil
+	}
+
+	if _, err := s.pg.Pool.Exec(ctx, queryText, args...); err != nil {
+		return fmt.Errorf("forget price update: %w", err)
+	}
+	return nil
+}
+
+// price_history хранит микросекунды, ключ дедупликации должен совпадать при повторной доставке
+func priceUpdateRecordedAt(event *models.PriceUpdateEvent) time.Time {
+	return event.Timestamp.UTC().Truncate(time.Microsecond)
+}
+
+// recordPriceUpdateTx сначала пишет точку истории: если такая точка уже есть
+// (повторная доставка события), скин не обновляется.
+func (s *Storage) recordPriceUpdateTx(ctx context.Context, tx pgx.Tx, event *models.PriceUpdateEvent, recordedAt time.Time) (bool, error) {
+	currency := event.Currency
+	if currency == "" {
+		currency = "USD"
+	}
+
+	insertQuery := s.builder.
+		Insert("price_history").
+		Columns("skin_id", "price", "currency", "source", "volume", "recorded_at").
+		Values(event.SkinID, event.NewPrice, currency, event.Source, event.Volume24h, recordedAt).
+		Suffix("ON CONFLICT (skin_id, source, recorded_at) DO NOTHING")
+
+	queryText, args, err := insertQuery.ToSql()
+	if err != nil {
+		return false, fmt.Errorf("build insert query: %w", err)
+	}
+
+	result, err := tx.Exec(ctx, queryText, args...)
+	if err != nil {
+		return false, fmt.Errorf("insert price history: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return false, nil
+	}
+
+	updateQuery := s.builder.
+		Update("skins").
+		Set("current_price", event.NewPrice).
+		Set("volume_24h", event.Volume24h).
+		Set("lowest_price", squirrel.Expr("CASE WHEN lowest_price = 0 OR ? < lowest_price THEN ? ELSE lowest_price END", event.NewPrice, event.NewPrice)).
+		Set("highest_price", squirrel.Expr("GREATEST(highest_price, ?)", event.NewPrice)).
+		Set("last_updated", recordedAt).
+		Where(squirrel.Eq{"id": event.SkinID})
+
+	queryText, args, err = updateQuery.ToSql()
+	if err != nil {
+		return false, fmt.Errorf("build update query: %w", err)
+	}
+
+	result, err = tx.Exec(ctx, queryText, args...)
+	if err != nil {
+		return false, fmt.Errorf("update skin price: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return false, fmt.Errorf("skin %s not found", event.SkinID)
+	}
+
+	return true, nil
+}
+
+// getSkinWeapon определяет оружие скина, по которому выбирается шард.
+func (s *Storage) getSkinWeapon(ctx context.Context, skinID uuid.UUID) (string, error) {
+	queryText, args, err := s.builder.
+		Select("weapon").
+		From("skins").
+		Where(squirrel.Eq{"id": skinID}).
+		ToSql()
+	if err != nil {
+		return "", fmt.Errorf("build query: %w", err)
+	}
+
+	for _, shard := range s.shards.AllShards() {
+		var weapon string
+		err := shard.QueryRow(ctx, queryText, args...).Scan(&weapon)
+		if err == nil {
+			return weapon, nil
+		}
+		if err != pgx.ErrNoRows {
+			return "", fmt.Errorf("query shard: %w", err)
+		}
+	}
+
+	return "", fmt.Errorf("skin %s not found", skinID)
+}
+
+// CreateInitialPriceHistory записывает первую точку истории цен, если у скина ее еще нет.
+func (s *Storage) CreateInitialPriceHistory(ctx context.Context, weapon string, history *models.PriceHistory) error {
+	query := `
+		INSERT INTO price_history (skin_id, price, currency, source, volume, recorded_at)
+		SELECT $1::uuid, $2::numeric, $3::varchar, $4::varchar, $5::int, $6::timestamp
+		WHERE NOT EXISTS (SELECT 1 FROM price_history WHERE skin_id = $1::uuid)
+	`
+
+	args := []interface{}{
+		history.SkinID, history.Price, history.Currency, history.Source, history.Volume, history.RecordedAt.UTC(),
+	}
+
+	if s.HasSharding() {
+		if _, err := s.shards.GetShardByWeapon(weapon).Exec(ctx, query, args...); err != nil {
+			return fmt.Errorf("insert initial price history in shard: %w", err)
+		}
+		return nil
+	}
+
+	if _, err := s.pg.Pool.Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("insert initial price history: %w", err)
+	}
+	return nil
+}
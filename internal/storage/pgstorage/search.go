@@ -0,0 +1,134 @@
+package pgstorage
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/kedr891/cs-parser/internal/models"
+)
+
+// compactSlug - slug без разделителей, по нему построен индекс idx_skins_slug_compact_trgm.
+const compactSlug = "REPLACE(slug, '_', '')"
+
+// searchCondition отбирает скины, где запрос похож на часть slug по словам (оператор <% из pg_trgm,
+// порог pg_trgm.word_similarity_threshold) или входит в slug без разделителей.
+// Запрос, в котором не осталось букв и цифр, ничего не находит.
+func searchCondition(query string) squirrel.Sqlizer {
+	words, compact := models.NormalizeSearchQuery(query)
+	if compact == "" {
+		return squirrel.Expr("FALSE")
+	}
+	return squirrel.Expr("(? <% slug OR "+compactSlug+" LIKE ?)", words, "%"+compact+"%")
+}
+
+// searchScore - релевантность: похожесть по словам (0..1), бонус за префикс (0.5) или подстроку (0.25)
+// и до 0.1 за объем торгов, чтобы среди равных по тексту выше были ликвидные скины.
+func searchScore(query string) squirrel.Sqlizer {
+	words, compact := models.NormalizeSearchQuery(query)
+	return squirrel.Expr(
+		"word_similarity(?, slug)"+
+			" + CASE WHEN "+compactSlug+" LIKE ? THEN 0.5 WHEN "+compactSlug+" LIKE ? THEN 0.25 ELSE 0 END"+
+			" + 0.1 * LEAST(LN(1 + volume_24h) / LN(10001), 1) AS score",
+		words, compact+"%", "%"+compact+"%",
+	)
+}
+
+type scoredSkin struct {
+	skin  models.Skin
+	score float64
+}
+
+// SearchSkins ищет скины с учетом опечаток и разного написания и сортирует по релевантности.
+// Каждый шард отдает свои лучшие limit скинов, общий порядок строится по score, а не по порядку шардов.
+func (s *Storage) SearchSkins(ctx context.Context, query string, limit int) ([]models.Skin, error) {
+	if _, compact := models.NormalizeSearchQuery(query); compact == "" {
+		return []models.Skin{}, nil
+	}
+
+	qb := s.builder.
+		Select(
+			"id", "slug", "market_hash_name", "name", "weapon", "quality", "rarity",
+			"current_price", "currency", "image_url", "volume_24h",
+			"price_change_24h", "price_change_7d",
+			"lowest_price", "highest_price",
+			"last_updated", "created_at", "updated_at",
+		).
+		Column(searchScore(query)).
+		From("skins").
+		Where(searchCondition(query)).
+		OrderBy("score DESC", "volume_24h DESC", "id ASC").
+		Limit(uint64(limit))
+
+	queryText, args, err := qb.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build query: %w", err)
+	}
+
+	var scored []scoredSkin
+	if s.HasSharding() {
+		scored, err = queryShardsParallel(ctx, s.shards.AllShards(), queryText, args, scanScoredSkins)
+	} else {
+		var rows pgx.Rows
+		rows, err = s.pg.Pool.Query(ctx, queryText, args...)
+		if err == nil {
+			scored, err = scanScoredSkins(rows, 0)
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("query search: %w", err)
+	}
+
+	return rankScoredSkins(scored, limit), nil
+}
+
+// rankScoredSkins повторяет ORDER BY запроса поиска для строк всех шардов и оставляет первые limit.
+func rankScoredSkins(scored []scoredSkin, limit int) []models.Skin {
+	sort.Slice(scored, func(i, j int) bool {
+		a, b := &scored[i], &scored[j]
+		if a.score != b.score {
+			return a.score > b.score
+		}
+		if a.skin.Volume24h != b.skin.Volume24h {
+			return a.skin.Volume24h > b.skin.Volume24h
+		}
+		return bytes.Compare(a.skin.ID[:], b.skin.ID[:]) < 0
+	})
+
+	if len(scored) > limit {
+		scored = scored[:limit]
+	}
+
+	skins := make([]models.Skin, len(scored))
+	for i := range scored {
+		skins[i] = scored[i].skin
+	}
+	return skins
+}
+
+func scanScoredSkins(rows pgx.Rows, _ int) ([]scoredSkin, error) {
+	defer rows.Close()
+
+	var result []scoredSkin
+	for rows.Next() {
+		var item scoredSkin
+		skin := &item.skin
+		err := rows.Scan(
+			&skin.ID, &skin.Slug, &skin.MarketHashName, &skin.Name, &skin.Weapon, &skin.Quality, &skin.Rarity,
+			&skin.CurrentPrice, &skin.Currency, &skin.ImageURL, &skin.Volume24h,
+			&skin.PriceChange24h, &skin.PriceChange7d,
+			&skin.LowestPrice, &skin.HighestPrice,
+			&skin.LastUpdated, &skin.CreatedAt, &skin.UpdatedAt,
+			&item.score,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("scan skin: %w", err)
+		}
+		result = append(result, item)
+	}
+
+	return result, rows.Err()
+}
@@ -0,0 +1,52 @@
+package pgstorage
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/kedr891/cs-parser/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSearchCondition_NormalizesSpellings(t *testing.T) {
+	for _, query := range []string{"ak47", "AK-47", "ak_47", " ak 47 "} {
+		_, args, err := searchCondition(query).ToSql()
+		require.NoError(t, err)
+		assert.Equal(t, "%ak47%", args[1], query)
+	}
+
+	_, args, err := searchCondition("m4a1s nitro").ToSql()
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{"m4a1s_nitro", "%m4a1snitro%"}, args)
+}
+
+func TestSearchCondition_EmptyQueryMatchesNothing(t *testing.T) {
+	query, args, err := searchCondition(" | ").ToSql()
+	require.NoError(t, err)
+
+	assert.Equal(t, "FALSE", query)
+	assert.Empty(t, args)
+}
+
+func TestRankScoredSkins_MergesShardsByScore(t *testing.T) {
+	low := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	high := uuid.MustParse("00000000-0000-0000-0000-000000000002")
+
+	// строки двух шардов, каждая часть упорядочена, но склейка - нет
+	scored := []scoredSkin{
+		{skin: models.Skin{ID: uuid.New(), Slug: "pistol_best"}, score: 0.9},
+		{skin: models.Skin{ID: uuid.New(), Slug: "pistol_weak"}, score: 0.3},
+		{skin: models.Skin{ID: uuid.New(), Slug: "rifle_best", Volume24h: 5}, score: 1.2},
+		{skin: models.Skin{ID: high, Slug: "rifle_tie_high_id", Volume24h: 5}, score: 0.5},
+		{skin: models.Skin{ID: low, Slug: "rifle_tie_low_id", Volume24h: 5}, score: 0.5},
+	}
+
+	skins := rankScoredSkins(scored, 4)
+
+	slugs := make([]string, len(skins))
+	for i := range skins {
+		slugs[i] = skins[i].Slug
+	}
+	assert.Equal(t, []string{"rifle_best", "pistol_best", "rifle_tie_low_id", "rifle_tie_high_id"}, slugs)
+}
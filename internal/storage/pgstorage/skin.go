@@ -3,230 +3,269 @@ package pgstorage
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/Masterminds/squirrel"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/kedr891/cs-parser/internal/models"
 )
 
+// skinSortColumns - колонка и тип значения курсора для каждого ключа сортировки GetSkins.
+// Текст сравнивается в COLLATE "C", чтобы порядок в PostgreSQL совпадал с побайтовым
+// сравнением при слиянии выдачи шардов.
+var skinSortColumns = map[string]struct{ column, cast string }{
+	"price":   {"current_price", "numeric"},
+	"volume":  {"volume_24h", "int"},
+	"name":    {`name COLLATE "C"`, "text"},
+	"weapon":  {`weapon COLLATE "C"`, "text"},
+	"updated": {"updated_at", "timestamp"},
+	"created": {"created_at", "timestamp"},
+}
+
+// GetSkins отдает страницу скинов, упорядоченную по ключу сортировки и id.
+// Страница задается либо Offset, либо курсором filter.PageToken (keyset-пагинация).
 func (s *Storage) GetSkins(ctx context.Context, filter *models.SkinFilter) ([]models.Skin, int, error) {
+	cursor, err := models.DecodeSkinCursor(filter)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	qb, countQb := s.skinsQuery(filter, cursor)
+
 	if s.HasSharding() {
-		return s.getSkinsSharded(ctx, filter)
+		return s.getSkinsSharded(ctx, filter, cursor, qb, countQb)
 	}
-	return s.getSkinsSingle(ctx, filter)
+	return s.getSkinsSingle(ctx, filter, cursor, qb, countQb)
 }
 
-func (s *Storage) getSkinsSingle(ctx context.Context, filter *models.SkinFilter) ([]models.Skin, int, error) {
-	qb := s.builder.
-		Select(
-			"id", "slug", "market_hash_name", "name", "weapon", "quality", "rarity",
-			"current_price", "currency", "image_url", "volume_24h",
-			"price_change_24h", "price_change_7d",
-			"lowest_price", "highest_price",
-			"last_updated", "created_at", "updated_at",
-		).
-		From("skins")
-
-	countQb := s.builder.Select("COUNT(*)").From("skins")
+func (s *Storage) getSkinsSingle(ctx context.Context, filter *models.SkinFilter, cursor *models.SkinCursor, qb, countQb squirrel.SelectBuilder) ([]models.Skin, int, error) {
+	countQuery, countArgs, err := countQb.ToSql()
+	if err != nil {
+		return nil, 0, fmt.Errorf("build count query: %w", err)
+	}
 
-	// Apply filters to both queries
-	if filter.Weapon != "" {
-		qb = qb.Where(squirrel.Eq{"weapon": filter.Weapon})
-		countQb = countQb.Where(squirrel.Eq{"weapon": filter.Weapon})
+	var total int
+	if err := s.pg.Pool.QueryRow(ctx, countQuery, countArgs...).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("count skins: %w", err)
 	}
-	if filter.Quality != "" {
-		qb = qb.Where(squirrel.Eq{"quality": filter.Quality})
-		countQb = countQb.Where(squirrel.Eq{"quality": filter.Quality})
+
+	if total == 0 {
+		return []models.Skin{}, 0, nil
 	}
-	if filter.Search != "" {
-		searchPattern := "%" + filter.Search + "%"
-		qb = qb.Where("name ILIKE ? OR market_hash_name ILIKE ?", searchPattern, searchPattern)
-		countQb = countQb.Where("name ILIKE ? OR market_hash_name ILIKE ?", searchPattern, searchPattern)
+
+	qb = qb.Limit(uint64(filter.Limit))
+	if cursor == nil {
+		qb = qb.Offset(uint64(filter.Offset))
 	}
-	if filter.MinPrice > 0 {
-		qb = qb.Where(squirrel.GtOrEq{"current_price": filter.MinPrice})
-		countQb = countQb.Where(squirrel.GtOrEq{"current_price": filter.MinPrice})
+
+	rows, err := s.query(ctx, qb)
+	if err != nil {
+		return nil, 0, fmt.Errorf("query skins: %w", err)
 	}
-	if filter.MaxPrice > 0 {
-		qb = qb.Where(squirrel.LtOrEq{"current_price": filter.MaxPrice})
-		countQb = countQb.Where(squirrel.LtOrEq{"current_price": filter.MaxPrice})
+
+	skins, err := s.scanSkins(rows)
+	if err != nil {
+		return nil, 0, err
 	}
 
+	return skins, total, nil
+}
+
+// getSkinsSharded берет с каждого шарда первые offset+limit строк в общем порядке
+// и сливает их k-way merge, поэтому страница упорядочена глобально, а не внутри шарда.
+func (s *Storage) getSkinsSharded(ctx context.Context, filter *models.SkinFilter, cursor *models.SkinCursor, qb, countQb squirrel.SelectBuilder) ([]models.Skin, int, error) {
 	countQuery, countArgs, err := countQb.ToSql()
 	if err != nil {
 		return nil, 0, fmt.Errorf("build count query: %w", err)
 	}
 
+	shards := s.filterShards(filter)
+
 	var total int
-	if err := s.pg.Pool.QueryRow(ctx, countQuery, countArgs...).Scan(&total); err != nil {
-		return nil, 0, fmt.Errorf("count skins: %w", err)
+	for i, shard := range shards {
+		var shardCount int
+		if err := shard.QueryRow(ctx, countQuery, countArgs...).Scan(&shardCount); err != nil {
+			return nil, 0, fmt.Errorf("count skins on shard %d: %w", i, err)
+		}
+		total += shardCount
 	}
 
 	if total == 0 {
 		return []models.Skin{}, 0, nil
 	}
 
-	// Apply sorting
-	sortBy := "updated_at"
-	switch filter.SortBy {
-	case "price":
-		sortBy = "current_price"
-	case "volume":
-		sortBy = "volume_24h"
-	case "name":
-		sortBy = "name"
-	case "updated":
-		sortBy = "updated_at"
-	case "created":
-		sortBy = "created_at"
-	case "weapon":
-		sortBy = "weapon"
+	offset := 0
+	if cursor == nil {
+		offset = filter.Offset
 	}
 
-	if strings.ToUpper(filter.SortOrder) == "ASC" {
-		qb = qb.OrderBy(sortBy + " ASC")
-	} else {
-		qb = qb.OrderBy(sortBy + " DESC")
+	queryText, args, err := qb.Limit(uint64(offset + filter.Limit)).ToSql()
+	if err != nil {
+		return nil, 0, fmt.Errorf("build query: %w", err)
 	}
 
-	qb = qb.Limit(uint64(filter.Limit)).Offset(uint64(filter.Offset))
-
-	// Execute query
-	rows, err := s.query(ctx, qb)
+	streams, err := queryEachShard(ctx, shards, queryText, args, s.scanShardSkins)
 	if err != nil {
-		return nil, 0, fmt.Errorf("query skins: %w", err)
+		return nil, 0, err
 	}
-	defer rows.Close()
 
-	var skins []models.Skin
-	for rows.Next() {
-		var skin models.Skin
-		err := rows.Scan(
-			&skin.ID, &skin.Slug, &skin.MarketHashName, &skin.Name, &skin.Weapon, &skin.Quality, &skin.Rarity,
-			&skin.CurrentPrice, &skin.Currency, &skin.ImageURL, &skin.Volume24h,
-			&skin.PriceChange24h, &skin.PriceChange7d,
-			&skin.LowestPrice, &skin.HighestPrice,
-			&skin.LastUpdated, &skin.CreatedAt, &skin.UpdatedAt,
-		)
-		if err != nil {
-			return nil, 0, fmt.Errorf("scan skin: %w", err)
-		}
-		skins = append(skins, skin)
+	sortBy, desc := filter.SortKey()
+	skins := mergeSortedSkins(streams, skinLess(sortBy, desc), offset+filter.Limit)
+	if offset >= len(skins) {
+		return []models.Skin{}, total, nil
 	}
 
-	return skins, total, nil
+	return skins[offset:], total, nil
 }
 
-func (s *Storage) getSkinsSharded(ctx context.Context, filter *models.SkinFilter) ([]models.Skin, int, error) {
-	var allSkins []models.Skin
-	var totalCount int
+// skinsQuery строит запрос страницы и запрос количества с общими фильтрами.
+// Курсор ограничивает только выборку страницы: total считается по всем скинам фильтра.
+func (s *Storage) skinsQuery(filter *models.SkinFilter, cursor *models.SkinCursor) (qb, countQb squirrel.SelectBuilder) {
+	qb = s.builder.
+		Select(
+			"id", "slug", "market_hash_name", "name", "weapon", "quality", "rarity",
+			"current_price", "currency", "image_url", "volume_24h",
+			"price_change_24h", "price_change_7d",
+			"lowest_price", "highest_price",
+			"last_updated", "created_at", "updated_at",
+		).
+		From("skins")
+
+	countQb = s.builder.Select("COUNT(*)").From("skins")
 
-	for _, shard := range s.shards.AllShards() {
-		// Build query using squirrel
-		qb := s.builder.
-			Select(
-				"id", "slug", "market_hash_name", "name", "weapon", "quality", "rarity",
-				"current_price", "currency", "image_url", "volume_24h",
-				"price_change_24h", "price_change_7d",
-				"lowest_price", "highest_price",
-				"last_updated", "created_at", "updated_at",
-			).
-			From("skins")
-
-		countQb := s.builder.Select("COUNT(*)").From("skins")
-
-		// Apply filters
-		if filter.Weapon != "" {
-			qb = qb.Where(squirrel.Eq{"weapon": filter.Weapon})
-			countQb = countQb.Where(squirrel.Eq{"weapon": filter.Weapon})
-		}
-		if filter.Quality != "" {
-			qb = qb.Where(squirrel.Eq{"quality": filter.Quality})
-			countQb = countQb.Where(squirrel.Eq{"quality": filter.Quality})
-		}
-		if filter.Search != "" {
-			searchPattern := "%" + filter.Search + "%"
-			qb = qb.Where("name ILIKE ? OR market_hash_name ILIKE ?", searchPattern, searchPattern)
-			countQb = countQb.Where("name ILIKE ? OR market_hash_name ILIKE ?", searchPattern, searchPattern)
-		}
-		if filter.MinPrice > 0 {
-			qb = qb.Where(squirrel.GtOrEq{"current_price": filter.MinPrice})
-			countQb = countQb.Where(squirrel.GtOrEq{"current_price": filter.MinPrice})
-		}
-		if filter.MaxPrice > 0 {
-			qb = qb.Where(squirrel.LtOrEq{"current_price": filter.MaxPrice})
-			countQb = countQb.Where(squirrel.LtOrEq{"current_price": filter.MaxPrice})
-		}
+	qb = applySkinFilter(qb, filter)
+	countQb = applySkinFilter(countQb, filter)
 
-		// Count on this shard
-		countQuery, countArgs, err := countQb.ToSql()
-		if err != nil {
-			continue
-		}
+	sortBy, desc := filter.SortKey()
+	sort := skinSortColumns[sortBy]
+	direction, op := "ASC", ">"
+	if desc {
+		direction, op = "DESC", "<"
+	}
 
-		var shardCount int
-		if err := shard.QueryRow(ctx, countQuery, countArgs...).Scan(&shardCount); err != nil {
-			continue
-		}
-		totalCount += shardCount
-
-		// Apply sorting
-		sortBy := "updated_at"
-		switch filter.SortBy {
-		case "price":
-			sortBy = "current_price"
-		case "volume":
-			sortBy = "volume_24h"
-		case "name":
-			sortBy = "name"
-		case "updated":
-			sortBy = "updated_at"
-		case "created":
-			sortBy = "created_at"
-		case "weapon":
-			sortBy = "weapon"
-		}
+	if cursor != nil {
+		qb = qb.Where(fmt.Sprintf("(%s, id) %s (?::%s, ?)", sort.column, op, sort.cast), cursor.Value, cursor.ID)
+	}
 
-		if strings.ToUpper(filter.SortOrder) == "ASC" {
-			qb = qb.OrderBy(sortBy + " ASC")
-		} else {
-			qb = qb.OrderBy(sortBy + " DESC")
-		}
+	qb = qb.OrderBy(sort.column+" "+direction, "id "+direction)
 
-		qb = qb.Limit(uint64(filter.Limit)).Offset(uint64(filter.Offset))
+	return qb, countQb
+}
 
-		// Execute query
-		queryText, args, err := qb.ToSql()
-		if err != nil {
-			continue
-		}
+// applySkinFilter добавляет условия фильтра каталога; общий для выборки, количества и фасетов.
+func applySkinFilter(qb squirrel.SelectBuilder, filter *models.SkinFilter) squirrel.SelectBuilder {
+	if weapons := filter.WeaponValues(); len(weapons) > 0 {
+		qb = qb.Where(squirrel.Eq{"weapon": weapons})
+	}
+	if qualities := filter.QualityValues(); len(qualities) > 0 {
+		qb = qb.Where(squirrel.Eq{"quality": qualities})
+	}
+	if rarities := filter.RarityValues(); len(rarities) > 0 {
+		qb = qb.Where(squirrel.Eq{"rarity": rarities})
+	}
+	if len(filter.Categories) > 0 {
+		qb = qb.Where(categoryCondition(filter.Categories))
+	}
+	if filter.Search != "" {
+		qb = qb.Where(searchCondition(filter.Search))
+	}
+	if filter.MinPrice > 0 {
+		qb = qb.Where(squirrel.GtOrEq{"current_price": filter.MinPrice})
+	}
+	if filter.MaxPrice > 0 {
+		qb = qb.Where(squirrel.LtOrEq{"current_price": filter.MaxPrice})
+	}
+	if filter.MinPriceChange24h != nil {
+		qb = qb.Where(squirrel.GtOrEq{"price_change_24h": *filter.MinPriceChange24h})
+	}
+	if filter.MaxPriceChange24h != nil {
+		qb = qb.Where(squirrel.LtOrEq{"price_change_24h": *filter.MaxPriceChange24h})
+	}
+	if filter.MinPriceChange7d != nil {
+		qb = qb.Where(squirrel.GtOrEq{"price_change_7d": *filter.MinPriceChange7d})
+	}
+	if filter.MaxPriceChange7d != nil {
+		qb = qb.Where(squirrel.LtOrEq{"price_change_7d": *filter.MaxPriceChange7d})
+	}
+	if filter.MinVolume24h != nil {
+		qb = qb.Where(squirrel.GtOrEq{"volume_24h": *filter.MinVolume24h})
+	}
+	if filter.MaxVolume24h != nil {
+		qb = qb.Where(squirrel.LtOrEq{"volume_24h": *filter.MaxVolume24h})
+	}
+	if !filter.UpdatedAfter.IsZero() {
+		qb = qb.Where(squirrel.GtOrEq{"last_updated": filter.UpdatedAfter})
+	}
+	if !filter.UpdatedBefore.IsZero() {
+		qb = qb.Where(squirrel.LtOrEq{"last_updated": filter.UpdatedBefore})
+	}
+	if filter.StatTrak != nil {
+		qb = qb.Where(likeFlag("market_hash_name", "%"+models.StatTrakPrefix+"%", *filter.StatTrak))
+	}
+	if filter.Souvenir != nil {
+		qb = qb.Where(likeFlag("market_hash_name", models.SouvenirPrefix+"%", *filter.Souvenir))
+	}
+	return qb
+}
 
-		rows, err := shard.Query(ctx, queryText, args...)
-		if err != nil {
-			continue
-		}
+func likeFlag(column, pattern string, want bool) squirrel.Sqlizer {
+	if want {
+		return squirrel.Like{column: pattern}
+	}
+	return squirrel.NotLike{column: pattern}
+}
 
-		for rows.Next() {
-			var skin models.Skin
-			err := rows.Scan(
-				&skin.ID, &skin.Slug, &skin.MarketHashName, &skin.Name, &skin.Weapon, &skin.Quality, &skin.Rarity,
-				&skin.CurrentPrice, &skin.Currency, &skin.ImageURL, &skin.Volume24h,
-				&skin.PriceChange24h, &skin.PriceChange7d,
-				&skin.LowestPrice, &skin.HighestPrice,
-				&skin.LastUpdated, &skin.CreatedAt, &skin.UpdatedAt,
-			)
-			if err != nil {
-				rows.Close()
-				continue
-			}
-			allSkins = append(allSkins, skin)
+// categoryCondition повторяет models.WeaponCategory в SQL: сначала справочник оружия,
+// затем перчатки и ножи по названию, все остальное относится к винтовкам.
+func categoryCondition(categories []models.SkinCategory) squirrel.Sqlizer {
+	unknown := squirrel.NotEq{"weapon": models.KnownWeapons()}
+	gloves := squirrel.Like{"weapon": "Gloves_%"}
+	knife := squirrel.Or{squirrel.Like{"weapon": "_%Knife"}, squirrel.Like{"weapon": "Knife_%"}}
+	notGloves := squirrel.NotLike{"weapon": "Gloves_%"}
+	notKnife := squirrel.And{squirrel.NotLike{"weapon": "_%Knife"}, squirrel.NotLike{"weapon": "Knife_%"}}
+
+	condition := squirrel.Or{}
+	for _, category := range categories {
+		if weapons, _ := models.CategoryWeapons(category); len(weapons) > 0 {
+			condition = append(condition, squirrel.Eq{"weapon": weapons})
+		}
+		switch category {
+		case models.CategoryGloves:
+			condition = append(condition, squirrel.And{unknown, gloves})
+		case models.CategoryKnife:
+			condition = append(condition, squirrel.And{unknown, notGloves, knife})
+		case models.CategoryRifle:
+			condition = append(condition, squirrel.And{unknown, notGloves, notKnife})
 		}
-		rows.Close()
 	}
+	return condition
+}
 
-	return allSkins, totalCount, nil
+// filterShards возвращает шарды, где могут лежать скины фильтра: если фильтры weapon и category
+// сводятся к конечному списку оружия, достаточно шардов этого оружия.
+func (s *Storage) filterShards(filter *models.SkinFilter) []*pgxpool.Pool {
+	weapons, bounded := filter.CandidateWeapons()
+	if !bounded {
+		return s.shards.AllShards()
+	}
+	return s.shardsForWeapons(weapons)
+}
+
+// shardsForWeapons возвращает шарды оружия без повторов в порядке AllShards.
+func (s *Storage) shardsForWeapons(weapons []string) []*pgxpool.Pool {
+	needed := make(map[*pgxpool.Pool]bool, len(weapons))
+	for _, weapon := range weapons {
+		needed[s.shards.GetShardByWeapon(weapon)] = true
+	}
+
+	var pools []*pgxpool.Pool
+	for _, pool := range s.shards.AllShards() {
+		if needed[pool] {
+			pools = append(pools, pool)
+		}
+	}
+	return pools
 }
 
 func (s *Storage) GetSkinBySlug(ctx context.Context, slug string) (*models.Skin, error) {
@@ -263,7 +302,7 @@ func (s *Storage) getSkinBySlugSingle(ctx context.Context, slug string) (*models
 	)
 
 	if err == pgx.ErrNoRows {
-		return nil, fmt.Errorf("skin not found")
+		return nil, models.ErrSkinNotFound
 	}
 	if err != nil {
 		return nil, fmt.Errorf("query skin: %w", err)
@@ -307,7 +346,7 @@ func (s *Storage) getSkinBySlugSharded(ctx context.Context, slug string) (*model
 		}
 	}
 
-	return nil, fmt.Errorf("skin not found")
+	return nil, models.ErrSkinNotFound
 }
 
 func (s *Storage) GetPriceHistory(ctx context.Context, skinID uuid.UUID, period models.PriceStatsPeriod) ([]models.PriceHistory, error) {
@@ -410,8 +449,7 @@ func (s *Storage) GetSkinStatistics(ctx context.Context, skinID uuid.UUID) (*mod
 	return &stats, nil
 }
 
-func (s *Storage) SearchSkins(ctx context.Context, query string, limit int) ([]models.Skin, error) {
-	searchPattern := "%" + query + "%"
+func (s *Storage) GetPopularSkins(ctx context.Context, limit int) ([]models.Skin, error) {
 	qb := s.builder.
 		Select(
 			"id", "slug", "market_hash_name", "name", "weapon", "quality", "rarity",
@@ -421,7 +459,6 @@ func (s *Storage) SearchSkins(ctx context.Context, query string, limit int) ([]m
 			"last_updated", "created_at", "updated_at",
 		).
 		From("skins").
-		Where("name ILIKE ? OR market_hash_name ILIKE ?", searchPattern, searchPattern).
 		OrderBy("volume_24h DESC").
 		Limit(uint64(limit))
 
@@ -448,14 +485,14 @@ func (s *Storage) SearchSkins(ctx context.Context, query string, limit int) ([]m
 
 	rows, err := s.pg.Pool.Query(ctx, queryText, args...)
 	if err != nil {
-		return nil, fmt.Errorf("query search: %w", err)
+		return nil, fmt.Errorf("query popular skins: %w", err)
 	}
 	defer rows.Close()
 
 	return s.scanSkins(rows)
 }
 
-func (s *Storage) GetPopularSkins(ctx context.Context, limit int) ([]models.Skin, error) {
+func (s *Storage) GetAllSkins(ctx context.Context) ([]models.Skin, error) {
 	qb := s.builder.
 		Select(
 			"id", "slug", "market_hash_name", "name", "weapon", "quality", "rarity",
@@ -465,8 +502,7 @@ func (s *Storage) GetPopularSkins(ctx context.Context, limit int) ([]models.Skin
 			"last_updated", "created_at", "updated_at",
 		).
 		From("skins").
-		OrderBy("volume_24h DESC").
-		Limit(uint64(limit))
+		OrderBy("last_updated ASC")
 
 	queryText, args, err := qb.ToSql()
 	if err != nil {
@@ -475,25 +511,24 @@ func (s *Storage) GetPopularSkins(ctx context.Context, limit int) ([]models.Skin
 
 	if s.HasSharding() {
 		var allSkins []models.Skin
-		for _, shard := range s.shards.AllShards() {
+		for i, shard := range s.shards.AllShards() {
 			rows, err := shard.Query(ctx, queryText, args...)
 			if err != nil {
-				continue
+				return nil, fmt.Errorf("query all skins on shard %d: %w", i, err)
+			}
+			skins, err := s.scanSkins(rows)
+			if err != nil {
+				return nil, fmt.Errorf("scan all skins on shard %d: %w", i, err)
 			}
-			skins, _ := s.scanSkins(rows)
 			allSkins = append(allSkins, skins...)
 		}
-		if len(allSkins) > limit {
-			allSkins = allSkins[:limit]
-		}
 		return allSkins, nil
 	}
 
 	rows, err := s.pg.Pool.Query(ctx, queryText, args...)
 	if err != nil {
-		return nil, fmt.Errorf("query popular skins: %w", err)
+		return nil, fmt.Errorf("query all skins: %w", err)
 	}
-	defer rows.Close()
 
 	return s.scanSkins(rows)
 }
@@ -0,0 +1,62 @@
+package pgstorage
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/kedr891/cs-parser/internal/models"
+)
+
+// GetSkinFacets считает скины фильтра по оружию, качеству, редкости и ценовым диапазонам
+// одним проходом через GROUPING SETS. Шарды опрашиваются параллельно, счетчики складывает вызывающий.
+func (s *Storage) GetSkinFacets(ctx context.Context, filter *models.SkinFilter, bounds []float64) ([]models.SkinFacetRow, error) {
+	inner := applySkinFilter(
+		s.builder.
+			Select("weapon", "quality", "COALESCE(rarity, '') AS rarity").
+			Column("width_bucket(current_price, ?::numeric[]) AS bucket", bounds).
+			From("skins"),
+		filter,
+	)
+
+	qb := s.builder.
+		Select(
+			fmt.Sprintf(`CASE WHEN GROUPING(weapon) = 0 THEN '%s' WHEN GROUPING(quality) = 0 THEN '%s'
+				WHEN GROUPING(rarity) = 0 THEN '%s' ELSE '%s' END`,
+				models.FacetWeapon, models.FacetQuality, models.FacetRarity, models.FacetPrice),
+			"COALESCE(weapon, quality, rarity, bucket::text)",
+			"COUNT(*)",
+		).
+		FromSelect(inner, "filtered").
+		GroupBy("GROUPING SETS ((weapon), (quality), (rarity), (bucket))")
+
+	queryText, args, err := qb.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build query: %w", err)
+	}
+
+	if s.HasSharding() {
+		return queryShardsParallel(ctx, s.filterShards(filter), queryText, args, scanSkinFacets)
+	}
+
+	rows, err := s.pg.Pool.Query(ctx, queryText, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query skin facets: %w", err)
+	}
+	return scanSkinFacets(rows, 0)
+}
+
+func scanSkinFacets(rows pgx.Rows, _ int) ([]models.SkinFacetRow, error) {
+	defer rows.Close()
+
+	var facets []models.SkinFacetRow
+	for rows.Next() {
+		var row models.SkinFacetRow
+		if err := rows.Scan(&row.Facet, &row.Value, &row.Count); err != nil {
+			return nil, fmt.Errorf("scan skin facets: %w", err)
+		}
+		facets = append(facets, row)
+	}
+
+	return facets, rows.Err()
+}
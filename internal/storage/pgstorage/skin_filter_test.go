@@ -0,0 +1,87 @@
+package pgstorage
+
+import (
+	"testing"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/kedr891/cs-parser/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplySkinFilter_ListsRangesAndFlags(t *testing.T) {
+	minChange, minVolume, stattrak := -5.0, 10, false
+	filter := &models.SkinFilter{
+		Weapon:            "AWP",
+		Weapons:           []string{"AK-47", "AWP"},
+		Qualities:         []string{"Factory New"},
+		MinPriceChange24h: &minChange,
+		MinVolume24h:      &minVolume,
+		StatTrak:          &stattrak,
+	}
+
+	query, args, err := applySkinFilter(squirrel.Select("id").From("skins"), filter).ToSql()
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		"SELECT id FROM skins WHERE weapon IN (?,?) AND quality IN (?) AND price_change_24h >= ? AND volume_24h >= ? AND market_hash_name NOT LIKE ?",
+		query)
+	assert.Equal(t, []interface{}{"AWP", "AK-47", "Factory New", -5.0, 10, "%StatTrak™ %"}, args)
+}
+
+func TestCategoryCondition_GlovesMatchOnlyUnknownWeaponsByName(t *testing.T) {
+	query, args, err := categoryCondition([]models.SkinCategory{models.CategoryGloves}).ToSql()
+	require.NoError(t, err)
+
+	// в справочнике нет перчаток, поэтому только условие по названию
+	assert.Contains(t, query, "weapon NOT IN (")
+	assert.Contains(t, query, "weapon LIKE ?")
+	assert.Equal(t, "Gloves_%", args[len(args)-1])
+}
+
+func TestCandidateWeapons_ShardPruning(t *testing.T) {
+	pistols, _ := models.CategoryWeapons(models.CategoryPistol)
+
+	tests := []struct {
+		name    string
+		filter  *models.SkinFilter
+		weapons []string
+		bounded bool
+	}{
+		{
+			name:   "no weapon filters",
+			filter: &models.SkinFilter{},
+		},
+		{
+			name:    "pistols only",
+			filter:  &models.SkinFilter{Categories: []models.SkinCategory{models.CategoryPistol}},
+			weapons: pistols,
+			bounded: true,
+		},
+		{
+			name:    "weapons intersected with category",
+			filter:  &models.SkinFilter{Weapons: []string{"AWP", "P250"}, Categories: []models.SkinCategory{models.CategoryPistol}},
+			weapons: []string{"P250"},
+			bounded: true,
+		},
+		{
+			// винтовкой считается любое неизвестное оружие, поэтому категория не сужает шарды
+			name:   "open category",
+			filter: &models.SkinFilter{Categories: []models.SkinCategory{models.CategoryPistol, models.CategoryRifle}},
+		},
+		{
+			name:    "open category keeps weapon list",
+			filter:  &models.SkinFilter{Weapon: "AK-47", Categories: []models.SkinCategory{models.CategoryKnife}},
+			weapons: []string{"AK-47"},
+			bounded: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			weapons, bounded := tt.filter.CandidateWeapons()
+			assert.Equal(t, tt.bounded, bounded)
+			assert.ElementsMatch(t, tt.weapons, weapons)
+		})
+	}
+}
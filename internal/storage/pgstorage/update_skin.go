@@ -0,0 +1,285 @@
+package pgstorage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/kedr891/cs-parser/internal/models"
+)
+
+// UpdateSkinFields сохраняет только поля скина из fields, остальные колонки (в том числе цену,
+// если ее нет в маске) не трогает. previousWeapon - оружие до изменения: если новое оружие
+// относится к другому шарду, скин переносится вместе с историей цен и правилами уведомлений.
+// После сохранения skin содержит актуальную строку из БД.
+func (s *Storage) UpdateSkinFields(ctx context.Context, skin *models.Skin, previousWeapon string, fields []string) error {
+	fn := func(tx pgx.Tx) error {
+		return s.updateSkinFieldsTx(ctx, tx, skin, fields)
+	}
+
+	if s.HasSharding() {
+		if s.shards.GetShardByWeapon(skin.Weapon) != s.shards.GetShardByWeapon(previousWeapon) {
+			return s.moveSkin(ctx, skin, previousWeapon, fields)
+		}
+		if err := s.shards.Transaction(ctx, skin.Weapon, fn); err != nil {
+			return fmt.Errorf("update skin in shard: %w", err)
+		}
+		return nil
+	}
+
+	if err := s.pg.Transaction(ctx, fn); err != nil {
+		return fmt.Errorf("update skin: %w", err)
+	}
+	return nil
+}
+
+func (s *Storage) updateSkinFieldsTx(ctx context.Context, tx pgx.Tx, skin *models.Skin, fields []string) error {
+	queryText, args, err := s.builder.
+		Update("skins").
+		SetMap(skinColumnValues(skin, fields)).
+		Where(squirrel.Eq{"id": skin.ID}).
+		Suffix(`RETURNING id, slug, market_hash_name, name, weapon, quality, rarity,
+			current_price, currency, image_url, volume_24h,
+			price_change_24h, price_change_7d,
+			lowest_price, highest_price,
+			last_updated, created_at, updated_at`).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("build update query: %w", err)
+	}
+
+	err = tx.QueryRow(ctx, queryText, args...).Scan(
+		&skin.ID, &skin.Slug, &skin.MarketHashName, &skin.Name, &skin.Weapon, &skin.Quality, &skin.Rarity,
+		&skin.CurrentPrice, &skin.Currency, &skin.ImageURL, &skin.Volume24h,
+		&skin.PriceChange24h, &skin.PriceChange7d,
+		&skin.LowestPrice, &skin.HighestPrice,
+		&skin.LastUpdated, &skin.CreatedAt, &skin.UpdatedAt,
+	)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return models.ErrSkinNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("update skin: %w", err)
+	}
+
+	// price_alerts хранит оружие для выбора шарда
+	queryText, args, err = s.builder.
+		Update("price_alerts").
+		Set("weapon", skin.Weapon).
+		Where(squirrel.Eq{"skin_id": skin.ID}).
+		Where(squirrel.NotEq{"weapon": skin.Weapon}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("build query: %w", err)
+	}
+	if _, err := tx.Exec(ctx, queryText, args...); err != nil {
+		return fmt.Errorf("update price alerts weapon: %w", err)
+	}
+
+	return nil
+}
+
+// moveSkin переносит скин на шард нового оружия. Строка на старом шарде блокируется до конца
+// переноса, поэтому параллельные обновления цены ждут и после удаления уходят на повтор
+// (consumer найдет скин уже на новом шарде). Если удаление со старого шарда не удалось,
+// повторный вызов перезапишет копию на новом шарде.
+func (s *Storage) moveSkin(ctx context.Context, skin *models.Skin, previousWeapon string, fields []string) error {
+	sourceTx, err := s.shards.GetShardByWeapon(previousWeapon).Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	defer sourceTx.Rollback(ctx)
+
+	current, err := s.lockSkin(ctx, sourceTx, skin)
+	if err != nil {
+		return err
+	}
+	if err := current.ApplyUpdate(skin, fields); err != nil {
+		return err
+	}
+
+	history, err := s.selectRows(ctx, sourceTx, s.builder.
+		Select("skin_id", "price", "currency", "source", "volume", "recorded_at").
+		From("price_history").
+		Where(squirrel.Eq{"skin_id": current.ID}))
+	if err != nil {
+		return fmt.Errorf("read price history: %w", err)
+	}
+
+	alerts, err := s.selectRows(ctx, sourceTx, s.builder.
+		Select(priceAlertColumns...).
+		From("price_alerts").
+		Where(squirrel.Eq{"skin_id": current.ID}))
+	if err != nil {
+		return fmt.Errorf("read price alerts: %w", err)
+	}
+	for _, alert := range alerts {
+		alert[3] = current.Weapon
+	}
+
+	err = s.shards.Transaction(ctx, current.Weapon, func(tx pgx.Tx) error {
+		return s.insertMovedSkinTx(ctx, tx, current, history, alerts)
+	})
+	if err != nil {
+		return fmt.Errorf("copy skin to shard: %w", err)
+	}
+
+	queryText, args, err := s.builder.
+		Delete("skins").
+		Where(squirrel.Eq{"id": current.ID}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("build query: %w", err)
+	}
+	if _, err := sourceTx.Exec(ctx, queryText, args...); err != nil {
+		return fmt.Errorf("delete skin from previous shard: %w", err)
+	}
+	if err := sourceTx.Commit(ctx); err != nil {
+		return fmt.Errorf("delete skin from previous shard: %w", err)
+	}
+
+	*skin = *current
+	return nil
+}
+
+func (s *Storage) lockSkin(ctx context.Context, tx pgx.Tx, skin *models.Skin) (*models.Skin, error) {
+	queryText, args, err := s.builder.
+		Select(
+			"id", "slug", "market_hash_name", "name", "weapon", "quality", "rarity",
+			"current_price", "currency", "image_url", "volume_24h",
+			"price_change_24h", "price_change_7d",
+			"lowest_price", "highest_price",
+			"last_updated", "created_at", "updated_at",
+		).
+		From("skins").
+		Where(squirrel.Eq{"id": skin.ID}).
+		Suffix("FOR UPDATE").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build query: %w", err)
+	}
+
+	var current models.Skin
+	err = tx.QueryRow(ctx, queryText, args...).Scan(
+		&current.ID, &current.Slug, &current.MarketHashName, &current.Name, &current.Weapon, &current.Quality, &current.Rarity,
+		&current.CurrentPrice, &current.Currency, &current.ImageURL, &current.Volume24h,
+		&current.PriceChange24h, &current.PriceChange7d,
+		&current.LowestPrice, &current.HighestPrice,
+		&current.LastUpdated, &current.CreatedAt, &current.UpdatedAt,
+	)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, models.ErrSkinNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("lock skin: %w", err)
+	}
+
+	return &current, nil
+}
+
+func (s *Storage) insertMovedSkinTx(ctx context.Context, tx pgx.Tx, skin *models.Skin, history, alerts [][]interface{}) error {
+	// копия могла остаться от прошлой неудачной попытки переноса
+	queryText, args, err := s.builder.
+		Delete("skins").
+		Where(squirrel.Eq{"id": skin.ID}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("build query: %w", err)
+	}
+	if _, err := tx.Exec(ctx, queryText, args...); err != nil {
+		return fmt.Errorf("delete stale copy: %w", err)
+	}
+
+	queryText, args, err = s.builder.
+		Insert("skins").
+		Columns(
+			"id", "slug", "market_hash_name", "name", "weapon", "quality", "rarity",
+			"current_price", "currency", "image_url", "volume_24h",
+			"price_change_24h", "price_change_7d",
+			"lowest_price", "highest_price",
+			"last_updated", "created_at", "updated_at",
+		).
+		Values(
+			skin.ID, skin.Slug, skin.MarketHashName, skin.Name, skin.Weapon, skin.Quality, skin.Rarity,
+			skin.CurrentPrice, skin.Currency, skin.ImageURL, skin.Volume24h,
+			skin.PriceChange24h, skin.PriceChange7d,
+			skin.LowestPrice, skin.HighestPrice,
+			skin.LastUpdated, skin.CreatedAt, skin.UpdatedAt,
+		).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("build query: %w", err)
+	}
+	if _, err := tx.Exec(ctx, queryText, args...); err != nil {
+		return fmt.Errorf("insert skin: %w", err)
+	}
+
+	_, err = tx.CopyFrom(ctx,
+		pgx.Identifier{"price_history"},
+		// id истории - BIGSERIAL своего шарда, поэтому не переносится
+		[]string{"skin_id", "price", "currency", "source", "volume", "recorded_at"},
+		pgx.CopyFromRows(history),
+	)
+	if err != nil {
+		return fmt.Errorf("copy price history: %w", err)
+	}
+
+	_, err = tx.CopyFrom(ctx,
+		pgx.Identifier{"price_alerts"},
+		priceAlertColumns,
+		pgx.CopyFromRows(alerts),
+	)
+	if err != nil {
+		return fmt.Errorf("copy price alerts: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Storage) selectRows(ctx context.Context, tx pgx.Tx, qb squirrel.SelectBuilder) ([][]interface{}, error) {
+	queryText, args, err := qb.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build query: %w", err)
+	}
+
+	rows, err := tx.Query(ctx, queryText, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result [][]interface{}
+	for rows.Next() {
+		values, err := rows.Values()
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, values)
+	}
+
+	return result, rows.Err()
+}
+
+// skinColumnValues - значения колонок для полей из маски обновления (имена полей совпадают с колонками).
+func skinColumnValues(skin *models.Skin, fields []string) map[string]interface{} {
+	all := map[string]interface{}{
+		"market_hash_name": skin.MarketHashName,
+		"name":             skin.Name,
+		"weapon":           skin.Weapon,
+		"quality":          skin.Quality,
+		"rarity":           skin.Rarity,
+		"current_price":    skin.CurrentPrice,
+		"currency":         skin.Currency,
+		"image_url":        skin.ImageURL,
+	}
+
+	values := map[string]interface{}{"updated_at": skin.UpdatedAt}
+	for _, field := range fields {
+		if value, ok := all[field]; ok {
+			values[field] = value
+		}
+	}
+	return values
+}
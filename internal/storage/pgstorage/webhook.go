@@ -0,0 +1,212 @@
+package pgstorage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/kedr891/cs-parser/internal/models"
+)
+
+// metaPool возвращает базу для данных, не привязанных к оружию: при шардировании это основной шард.
+func (s *Storage) metaPool() *pgxpool.Pool {
+	if s.HasSharding() {
+		return s.shards.Primary()
+	}
+	return s.pg.Pool
+}
+
+func (s *Storage) CreateWebhook(ctx context.Context, webhook *models.Webhook) error {
+	queryText, args, err := s.builder.
+		Insert("webhooks").
+		Columns("id", "url", "secret", "active", "created_at").
+		Values(webhook.ID, webhook.URL, webhook.Secret, webhook.Active, webhook.CreatedAt).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("build query: %w", err)
+	}
+
+	if _, err := s.metaPool().Exec(ctx, queryText, args...); err != nil {
+		return fmt.Errorf("create webhook: %w", err)
+	}
+	return nil
+}
+
+func (s *Storage) ListActiveWebhooks(ctx context.Context) ([]models.Webhook, error) {
+	queryText, args, err := s.builder.
+		Select("id", "url", "secret", "active", "created_at").
+		From("webhooks").
+		Where(squirrel.Eq{"active": true}).
+		OrderBy("created_at").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build query: %w", err)
+	}
+
+	rows, err := s.metaPool().Query(ctx, queryText, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query webhooks: %w", err)
+	}
+	defer rows.Close()
+
+	var webhooks []models.Webhook
+	for rows.Next() {
+		var webhook models.Webhook
+		if err := rows.Scan(&webhook.ID, &webhook.URL, &webhook.Secret, &webhook.Active, &webhook.CreatedAt); err != nil {
+			return nil, fmt.Errorf("scan webhook: %w", err)
+		}
+		webhooks = append(webhooks, webhook)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return webhooks, nil
+}
+
+// CreateWebhookDeliveries сохраняет доставки в статусе pending. До истечения lease их не вернет
+// ClaimWebhookDeliveries: все это время доставки отправляет воркер процесса, который их создал.
+func (s *Storage) CreateWebhookDeliveries(ctx context.Context, deliveries []models.WebhookDelivery, lease time.Duration) error {
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	qb := s.builder.
+		Insert("webhook_deliveries").
+		Columns(
+			"id", "webhook_id", "alert_id", "url", "status", "attempts",
+			"response_code", "error", "created_at", "payload", "next_attempt_at",
+		)
+	for _, d := range deliveries {
+		qb = qb.Values(
+			d.ID, d.WebhookID, d.AlertID, d.URL, d.Status, d.Attempts,
+			d.ResponseCode, d.Error, d.CreatedAt, d.Payload, squirrel.Expr("NOW() + make_interval(secs => ?)", lease.Seconds()),
+		)
+	}
+
+	queryText, args, err := qb.ToSql()
+	if err != nil {
+		return fmt.Errorf("build query: %w", err)
+	}
+
+	if _, err := s.metaPool().Exec(ctx, queryText, args...); err != nil {
+		return fmt.Errorf("create webhook deliveries: %w", err)
+	}
+	return nil
+}
+
+// ClaimWebhookDeliveries забирает до limit доставок в статусе pending, срок закрепления которых истек,
+// и закрепляет их на lease. Строки, которые сейчас забирает другая реплика, пропускаются.
+func (s *Storage) ClaimWebhookDeliveries(ctx context.Context, limit int, lease time.Duration) ([]models.PendingDelivery, error) {
+	query := `
+		UPDATE webhook_deliveries d
+		SET next_attempt_at = NOW() + make_interval(secs => $1)
+		FROM webhooks w
+		WHERE w.id = d.webhook_id AND d.id IN (
+			SELECT id FROM webhook_deliveries
+			WHERE status = $2 AND next_attempt_at <= NOW()
+			ORDER BY next_attempt_at
+			LIMIT $3
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING d.id, d.webhook_id, d.alert_id, d.url, d.status, d.attempts, d.response_code, d.error,
+			d.created_at, d.payload, w.url, w.secret, w.active, w.created_at
+	`
+
+	rows, err := s.metaPool().Query(ctx, query, lease.Seconds(), models.DeliveryStatusPending, limit)
+	if err != nil {
+		return nil, fmt.Errorf("claim webhook deliveries: %w", err)
+	}
+	defer rows.Close()
+
+	var pending []models.PendingDelivery
+	for rows.Next() {
+		var p models.PendingDelivery
+		d := &p.Delivery
+		if err := rows.Scan(
+			&d.ID, &d.WebhookID, &d.AlertID, &d.URL, &d.Status, &d.Attempts, &d.ResponseCode, &d.Error,
+			&d.CreatedAt, &d.Payload, &p.Webhook.URL, &p.Webhook.Secret, &p.Webhook.Active, &p.Webhook.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("scan webhook delivery: %w", err)
+		}
+		p.Webhook.ID = d.WebhookID
+		pending = append(pending, p)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return pending, nil
+}
+
+// UpdateWebhookDelivery сохраняет результат попыток. Доставка, оставленная в статусе pending,
+// сразу снова доступна ClaimWebhookDeliveries.
+func (s *Storage) UpdateWebhookDelivery(ctx context.Context, delivery *models.WebhookDelivery) error {
+	queryText, args, err := s.builder.
+		Update("webhook_deliveries").
+		Set("status", delivery.Status).
+		Set("attempts", delivery.Attempts).
+		Set("response_code", delivery.ResponseCode).
+		Set("error", delivery.Error).
+		Set("delivered_at", delivery.DeliveredAt).
+		Set("next_attempt_at", squirrel.Expr("NOW()")).
+		Where(squirrel.Eq{"id": delivery.ID}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("build query: %w", err)
+	}
+
+	if _, err := s.metaPool().Exec(ctx, queryText, args...); err != nil {
+		return fmt.Errorf("update webhook delivery: %w", err)
+	}
+	return nil
+}
+
+// ListWebhookDeliveries возвращает последние доставки, новые первыми. webhookID == uuid.Nil - по всем webhook-ам.
+func (s *Storage) ListWebhookDeliveries(ctx context.Context, webhookID uuid.UUID, limit int) ([]models.WebhookDelivery, error) {
+	qb := s.builder.
+		Select(
+			"id", "webhook_id", "alert_id", "url", "status", "attempts",
+			"response_code", "error", "created_at", "delivered_at",
+		).
+		From("webhook_deliveries").
+		OrderBy("created_at DESC").
+		Limit(uint64(limit))
+	if webhookID != uuid.Nil {
+		qb = qb.Where(squirrel.Eq{"webhook_id": webhookID})
+	}
+
+	queryText, args, err := qb.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build query: %w", err)
+	}
+
+	rows, err := s.metaPool().Query(ctx, queryText, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query webhook deliveries: %w", err)
+	}
+	defer rows.Close()
+
+	var deliveries []models.WebhookDelivery
+	for rows.Next() {
+		var d models.WebhookDelivery
+		if err := rows.Scan(
+			&d.ID, &d.WebhookID, &d.AlertID, &d.URL, &d.Status, &d.Attempts,
+			&d.ResponseCode, &d.Error, &d.CreatedAt, &d.DeliveredAt,
+		); err != nil {
+			return nil, fmt.Errorf("scan webhook delivery: %w", err)
+		}
+		deliveries = append(deliveries, d)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return deliveries, nil
+}
@@ -57,6 +57,15 @@ func New(addr, password string, db int, opts ...Option) (*Redis, error) {
 	return r, nil
 }
 
+// FromClient оборачивает уже подключенный клиент, не проверяя соединение.
+func FromClient(client *redis.Client) *Redis {
+	return &Redis{
+		connAttempts: _defaultConnAttempts,
+		connTimeout:  _defaultConnTimeout,
+		Client:       client,
+	}
+}
+
 func (r *Redis) Close() error {
 	if r.Client != nil {
 		return r.Client.Close()
@@ -21,6 +21,17 @@ kafka-topics --bootstrap-server $KAFKA_BROKER --create --if-not-exists \
   --partitions 3 \
   --replication-factor 1
 
+# Сообщения, которые не удалось обработать после всех попыток
+kafka-topics --bootstrap-server $KAFKA_BROKER --create --if-not-exists \
+  --topic skin.price.updated.dlq \
+  --partitions 3 \
+  --replication-factor 1
+
+kafka-topics --bootstrap-server $KAFKA_BROKER --create --if-not-exists \
+  --topic notification.price_alert.dlq \
+  --partitions 3 \
+  --replication-factor 1
+
 echo "Topics created successfully!"
 kafka-topics --bootstrap-server $KAFKA_BROKER --list
 